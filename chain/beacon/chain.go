@@ -28,6 +28,7 @@ type chainStore struct {
 	verifier    *chain.Verifier
 	crypto      *cryptoStore
 	ticker      *ticker
+	part        *participation
 	done        chan bool
 	newPartials chan partialInfo
 	// catchupBeacons is used to notify the Handler when a node has aggregated a
@@ -38,7 +39,8 @@ type chainStore struct {
 	beaconStoredAgg chan *chain.Beacon
 }
 
-func newChainStore(l log.Logger, cf *Config, cl net.ProtocolClient, c *cryptoStore, store chain.Store, t *ticker) *chainStore {
+func newChainStore(l log.Logger, cf *Config, cl net.ProtocolClient, c *cryptoStore, store chain.Store, t *ticker,
+	part *participation) *chainStore {
 	// we make sure the chain is increasing monotonically
 	as := newAppendStore(store)
 
@@ -74,6 +76,7 @@ func newChainStore(l log.Logger, cf *Config, cl net.ProtocolClient, c *cryptoSto
 		verifier:        verifier,
 		crypto:          c,
 		ticker:          t,
+		part:            part,
 		done:            make(chan bool, 1),
 		newPartials:     make(chan partialInfo, defaultPartialChanBuffer),
 		catchupBeacons:  make(chan *chain.Beacon, 1),
@@ -167,6 +170,7 @@ func (c *chainStore) runAggregator() {
 				break
 			}
 			cache.FlushRounds(partial.p.GetRound())
+			c.part.Aggregated(roundCache.round)
 
 			newBeacon := &chain.Beacon{
 				Round:       roundCache.round,
@@ -13,6 +13,19 @@ var MaxSyncWaitTime = 2 * time.Second
 // fast nodes and these are valid.
 const MaxPartialsPerNode = 100
 
+// PartialStreamBuffer is the maximum number of partial beacons queued for a
+// peer, and of partial beacons sent over its stream and not acknowledged yet.
+const PartialStreamBuffer = 16
+
+// PartialStreamBackoff is the delay before reopening a broken stream of
+// partial beacons, doubled after each failure up to MaxPartialStreamBackoff.
+// The partial beacons are sent with unary calls in the meantime.
+var PartialStreamBackoff = time.Second
+
+// MaxPartialStreamBackoff is the maximum delay before reopening a broken stream
+// of partial beacons.
+var MaxPartialStreamBackoff = time.Minute
+
 // MaxCatchupBuffer is the maximum size of the channel that receives beacon from
 // a sync mechanism.
 const MaxCatchupBuffer = 1000
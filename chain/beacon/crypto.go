@@ -1,26 +1,33 @@
 package beacon
 
 import (
+	"context"
+	"fmt"
 	"sync"
 
 	"github.com/drand/drand/chain"
 	"github.com/drand/drand/key"
+	"github.com/drand/kyber"
 	"github.com/drand/kyber/share"
 )
 
-// CryptoSafe holds the cryptographic information to generate a partial beacon
-type CryptoSafe interface {
-	// SignPartial returns the partial signature
-	SignPartial(msg []byte) ([]byte, error)
+// PartialSigner signs partial beacons on behalf of the node. It lets the share
+// live outside of the daemon, for example in a separate signer process.
+type PartialSigner interface {
+	// SignPartial returns the partial signature over the message of the given
+	// round, made with the share whose public key is given.
+	SignPartial(ctx context.Context, round uint64, msg []byte, sharePub kyber.Point) ([]byte, error)
 }
 
 // cryptoStore stores the information necessary to validate partial beacon, full
-// beacons and to sign new partial beacons (it implements CryptoSafe interface).
-// cryptoStore is thread safe when using the methods.
+// beacons and to sign new partial beacons. cryptoStore is thread safe when
+// using the methods.
 type cryptoStore struct {
 	sync.Mutex
 	// current share of the node
 	share *key.Share
+	// signs with the share instead of the node if set
+	signer PartialSigner
 	// public polynomial to verify a partial beacon
 	pub *share.PubPoly
 	// chian info to verify final random beacon
@@ -29,12 +36,13 @@ type cryptoStore struct {
 	group *key.Group
 }
 
-func newCryptoStore(currentGroup *key.Group, ks *key.Share) *cryptoStore {
+func newCryptoStore(currentGroup *key.Group, ks *key.Share, signer PartialSigner) *cryptoStore {
 	return &cryptoStore{
-		chain: chain.NewChainInfo(currentGroup),
-		share: ks,
-		pub:   currentGroup.PublicKey.PubPoly(),
-		group: currentGroup,
+		chain:  chain.NewChainInfo(currentGroup),
+		share:  ks,
+		signer: signer,
+		pub:    currentGroup.PublicKey.PubPoly(),
+		group:  currentGroup,
 	}
 }
 
@@ -51,11 +59,24 @@ func (c *cryptoStore) GetPub() *share.PubPoly {
 	return c.pub
 }
 
-// SignPartial implemements the CryptoSafe interface
-func (c *cryptoStore) SignPartial(msg []byte) ([]byte, error) {
+// SignPartial returns the partial signature of the node over the message of
+// the given round. If the node has a signer, the signature is checked before
+// being returned.
+func (c *cryptoStore) SignPartial(ctx context.Context, round uint64, msg []byte) ([]byte, error) {
 	c.Lock()
-	defer c.Unlock()
-	return key.Scheme.Sign(c.share.PrivateShare(), msg)
+	ks, pub, signer := c.share, c.pub, c.signer
+	c.Unlock()
+	if signer == nil {
+		return key.Scheme.Sign(ks.PrivateShare(), msg)
+	}
+	sig, err := signer.SignPartial(ctx, round, msg, pub.Eval(ks.Share.I).V)
+	if err != nil {
+		return nil, err
+	}
+	if err := key.Scheme.VerifyPartial(pub, msg, sig); err != nil {
+		return nil, fmt.Errorf("invalid partial signature from the signer: %w", err)
+	}
+	return sig, nil
 }
 
 // Index returns the index of the share
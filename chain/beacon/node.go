@@ -15,6 +15,7 @@ import (
 	commonutils "github.com/drand/drand/common"
 	"github.com/drand/drand/key"
 	"github.com/drand/drand/log"
+	"github.com/drand/drand/metrics"
 	"github.com/drand/drand/net"
 	"github.com/drand/drand/protobuf/common"
 	proto "github.com/drand/drand/protobuf/drand"
@@ -31,6 +32,19 @@ type Config struct {
 	Group *key.Group
 	// Clock to use - useful to testing
 	Clock clock.Clock
+	// Signer signs the partial beacons with the share, if set. Otherwise the
+	// share is used directly.
+	Signer PartialSigner
+	// Protection refuses to sign partial beacons conflicting with the ones
+	// signed before, if set. The handler closes it when stopped.
+	Protection *Protection
+	// OverrideProtection signs the partial beacons the protection refuses,
+	// after reporting the conflict.
+	OverrideProtection bool
+	// StreamPartials sends the partial beacons to each peer over a long-lived
+	// stream, instead of a call per partial beacon. Calls are still used for
+	// the peers that don't support streams.
+	StreamPartials bool
 }
 
 // Handler holds the logic to initiate, and react to the tBLS protocol. Each time
@@ -48,6 +62,12 @@ type Handler struct {
 	chain    *chainStore
 	ticker   *ticker
 	verifier *chain.Verifier
+	// records which nodes sent partials for the last rounds
+	participation *participation
+	// streams of partials to the peers, if conf.StreamPartials is set
+	senders *partialSenders
+	// endRound is the last round of the chain once it is halted
+	endRound uint64
 
 	close   chan bool
 	addr    string
@@ -71,27 +91,33 @@ func NewHandler(c net.ProtocolClient, s chain.Store, conf *Config, l log.Logger,
 		return nil, errors.New("beacon: keypair not included in the given group")
 	}
 	addr := conf.Public.Address()
-	crypto := newCryptoStore(conf.Group, conf.Share)
+	crypto := newCryptoStore(conf.Group, conf.Share, conf.Signer)
 	// insert genesis beacon
 	if err := s.Put(chain.GenesisBeacon(crypto.chain)); err != nil {
 		return nil, err
 	}
 
 	ticker := newTicker(conf.Clock, conf.Group.Period, conf.Group.GenesisTime)
-	store := newChainStore(l, conf, c, crypto, s, ticker)
+	part := newParticipation(conf.Clock, conf.Group.Period, conf.Group.GenesisTime,
+		commonutils.GetCanonicalBeaconID(conf.Group.ID))
+	store := newChainStore(l, conf, c, crypto, s, ticker, part)
 	verifier := chain.NewVerifier(conf.Group.Scheme)
 
 	handler := &Handler{
-		conf:     conf,
-		client:   c,
-		crypto:   crypto,
-		chain:    store,
-		verifier: verifier,
-		ticker:   ticker,
-		addr:     addr,
-		close:    make(chan bool),
-		l:        l,
-		version:  version,
+		conf:          conf,
+		client:        c,
+		crypto:        crypto,
+		chain:         store,
+		verifier:      verifier,
+		ticker:        ticker,
+		participation: part,
+		addr:          addr,
+		close:         make(chan bool),
+		l:             l,
+		version:       version,
+	}
+	if conf.StreamPartials {
+		handler.senders = newPartialSenders(c, conf.Clock, conf.Group.Period, l)
 	}
 	return handler, nil
 }
@@ -104,7 +130,7 @@ func (h *Handler) ProcessPartialBeacon(c context.Context, p *proto.PartialBeacon
 	addr := net.RemoteAddress(c)
 	h.l.Debugw("", "received", "request", "from", addr, "round", p.GetRound())
 
-	nextRound, _ := chain.NextRound(h.conf.Clock.Now().Unix(), h.conf.Group.Period, h.conf.Group.GenesisTime)
+	nextRound, _ := chain.NextRoundAt(h.conf.Clock.Now(), h.conf.Group.Period, h.conf.Group.GenesisTime)
 	currentRound := nextRound - 1
 
 	// we allow one round off in the future because of small clock drifts
@@ -114,6 +140,9 @@ func (h *Handler) ProcessPartialBeacon(c context.Context, p *proto.PartialBeacon
 		h.l.Errorw("", "process_partial", addr, "invalid_future_round", p.GetRound(), "current_round", currentRound)
 		return nil, fmt.Errorf("invalid round: %d instead of %d", p.GetRound(), currentRound)
 	}
+	if end := h.EndRound(); end != 0 && p.GetRound() > end {
+		return nil, fmt.Errorf("invalid round: %d after the end of the chain at round %d", p.GetRound(), end)
+	}
 
 	msg := h.verifier.DigestMessage(p.GetRound(), p.GetPreviousSig())
 
@@ -138,6 +167,7 @@ func (h *Handler) ProcessPartialBeacon(c context.Context, p *proto.PartialBeacon
 			"msg_sign", shortSigStr(msg),
 			"from_idx", idx,
 			"from_node", nodeName)
+		h.participation.Invalid(p.GetRound())
 		return nil, err
 	}
 	h.l.Debugw("",
@@ -157,10 +187,40 @@ func (h *Handler) ProcessPartialBeacon(c context.Context, p *proto.PartialBeacon
 		// XXX error or not ?
 		return new(proto.Empty), nil
 	}
+	h.participation.Valid(uint32(idx), p.GetRound())
 	h.chain.NewValidPartial(addr, p)
 	return new(proto.Empty), nil
 }
 
+// Participation returns the participation records of the last n rounds, or of
+// all the rounds kept if n is not positive, sorted by round.
+func (h *Handler) Participation(n int) []*RoundParticipation {
+	return h.participation.Last(n)
+}
+
+// HaltAt ends the chain at the given round: the partial beacons of the
+// following rounds are neither signed nor aggregated, and the chain stored is
+// still served.
+func (h *Handler) HaltAt(round uint64) {
+	h.Lock()
+	h.endRound = round
+	h.Unlock()
+	h.l.Infow("", "chain_halt", "scheduled", "end_round", round)
+	h.chain.AddCallback("halt", func(b *chain.Beacon) {
+		if b.Round == round {
+			h.l.Infow("", "chain_halt", "chain ended", "end_round", round)
+		}
+	})
+}
+
+// EndRound returns the last round of the chain if it is halted, zero
+// otherwise.
+func (h *Handler) EndRound() uint64 {
+	h.Lock()
+	defer h.Unlock()
+	return h.endRound
+}
+
 // Store returns the store associated with this beacon handler
 func (h *Handler) Store() CallbackStore {
 	return h.chain
@@ -184,7 +244,7 @@ func (h *Handler) Start() error {
 	h.started = true
 	h.Unlock()
 
-	_, tTime := chain.NextRound(h.conf.Clock.Now().Unix(), h.conf.Group.Period, h.conf.Group.GenesisTime)
+	_, tTime := chain.NextRoundAt(h.conf.Clock.Now(), h.conf.Group.Period, h.conf.Group.GenesisTime)
 	h.l.Infow("", "beacon", "start")
 	go h.run(tTime)
 
@@ -201,7 +261,7 @@ func (h *Handler) Catchup() {
 	h.started = true
 	h.Unlock()
 
-	nRound, tTime := chain.NextRound(h.conf.Clock.Now().Unix(), h.conf.Group.Period, h.conf.Group.GenesisTime)
+	nRound, tTime := chain.NextRoundAt(h.conf.Clock.Now(), h.conf.Group.Period, h.conf.Group.GenesisTime)
 	go h.run(tTime)
 	h.chain.RunSync(nRound, nil)
 }
@@ -213,8 +273,8 @@ func (h *Handler) Catchup() {
 func (h *Handler) Transition(prevGroup *key.Group) error {
 	targetTime := h.conf.Group.TransitionTime
 	tRound := chain.CurrentRound(targetTime, h.conf.Group.Period, h.conf.Group.GenesisTime)
-	tTime := chain.TimeOfRound(h.conf.Group.Period, h.conf.Group.GenesisTime, tRound)
-	if tTime != targetTime {
+	tTime := chain.RoundTime(h.conf.Group.Period, h.conf.Group.GenesisTime, tRound)
+	if !tTime.Equal(time.Unix(targetTime, 0)) {
 		h.l.Fatalw("", "transition_time", "invalid_offset", "expected_time", tTime, "got_time", targetTime)
 		return nil
 	}
@@ -223,7 +283,7 @@ func (h *Handler) Transition(prevGroup *key.Group) error {
 	h.started = true
 	h.Unlock()
 
-	go h.run(targetTime)
+	go h.run(time.Unix(targetTime, 0))
 
 	// we run the sync up until (inclusive) one round before the transition
 	h.l.Debugw("", "new_node", "following chain", "to_round", tRound-1)
@@ -236,8 +296,8 @@ func (h *Handler) Transition(prevGroup *key.Group) error {
 func (h *Handler) TransitionNewGroup(newShare *key.Share, newGroup *key.Group) {
 	targetTime := newGroup.TransitionTime
 	tRound := chain.CurrentRound(targetTime, h.conf.Group.Period, h.conf.Group.GenesisTime)
-	tTime := chain.TimeOfRound(h.conf.Group.Period, h.conf.Group.GenesisTime, tRound)
-	if tTime != targetTime {
+	tTime := chain.RoundTime(h.conf.Group.Period, h.conf.Group.GenesisTime, tRound)
+	if !tTime.Equal(time.Unix(targetTime, 0)) {
 		h.l.Fatalw("", "transition_time", "invalid_offset", "expected_time", tTime, "got_time", targetTime)
 		return
 	}
@@ -293,7 +353,7 @@ func (h *Handler) Reset() {
 }
 
 // run will wait until it is supposed to start
-func (h *Handler) run(startTime int64) {
+func (h *Handler) run(startTime time.Time) {
 	chanTick := h.ticker.ChannelAt(startTime)
 	h.l.Debugw("", "run_round", "wait", "until", startTime)
 
@@ -321,6 +381,11 @@ func (h *Handler) run(startTime int64) {
 				break
 			}
 			h.l.Debugw("", "beacon_loop", "new_round", "round", current.round, "lastbeacon", lastBeacon.Round)
+			end := h.EndRound()
+			if end != 0 && lastBeacon.Round >= end {
+				// the chain ended
+				break
+			}
 			h.broadcastNextPartial(current, lastBeacon)
 			// if the next round of the last beacon we generated is not the round we
 			// are now, that means there is a gap between the two rounds. In other
@@ -334,7 +399,11 @@ func (h *Handler) run(startTime int64) {
 				// XXX find a way to start the catchup as soon as the runsync is
 				// done. Not critical but leads to faster network recovery.
 				h.l.Debugw("", "beacon_loop", "run_sync_catchup", "last_is", lastBeacon, "should_be", current.round)
-				h.chain.RunSync(current.round, nil)
+				upTo := current.round
+				if end != 0 && end < upTo {
+					upTo = end
+				}
+				h.chain.RunSync(upTo, nil)
 			}
 		case b := <-h.chain.AppendedBeaconNoSync():
 			h.l.Debugw("", "beacon_loop", "catchupmode", "last_is", b.Round, "current", current.round, "catchup_launch", b.Round < current.round)
@@ -380,11 +449,22 @@ func (h *Handler) broadcastNextPartial(current roundInfo, upon *chain.Beacon) {
 		round = current.round
 	}
 
+	if end := h.EndRound(); end != 0 && round > end {
+		h.l.Debugw("", "beacon_round", round, "chain_halt", "not signing after the end of the chain", "end_round", end)
+		return
+	}
+
 	msg := h.verifier.DigestMessage(round, previousSig)
+	if err := h.checkProtection(round, msg); err != nil {
+		return
+	}
 
-	currSig, err := h.crypto.SignPartial(msg)
+	// a remote signer must answer before the round is over
+	signCtx, cancel := context.WithTimeout(ctx, h.conf.Group.Period)
+	currSig, err := h.crypto.SignPartial(signCtx, round, msg)
+	cancel()
 	if err != nil {
-		h.l.Fatal("beacon_round", "err creating signature", "err", err, "round", round)
+		h.l.Errorw("", "beacon_round", round, "err creating signature", err)
 		return
 	}
 	h.l.Debugw("", "broadcast_partial", round, "from_prev_sig", shortSigStr(previousSig), "msg_sign", shortSigStr(msg))
@@ -398,14 +478,19 @@ func (h *Handler) broadcastNextPartial(current roundInfo, upon *chain.Beacon) {
 		Metadata:    metadata,
 	}
 
+	h.participation.Valid(uint32(h.crypto.Index()), round)
 	h.chain.NewValidPartial(h.addr, packet)
 	for _, id := range h.crypto.GetGroup().Nodes {
 		if h.addr == id.Address() {
 			continue
 		}
+		if h.senders != nil {
+			h.senders.Send(id.Identity, packet)
+			continue
+		}
 		go func(i *key.Identity) {
 			h.l.Debugw("", "beacon_round", round, "send_to", i.Address())
-			err := h.client.PartialBeacon(ctx, i, packet)
+			_, err := h.client.PartialBeacon(ctx, i, packet)
 			if err != nil {
 				h.l.Errorw("", "beacon_round", round, "err_request", err, "from", i.Address())
 				if strings.Contains(err.Error(), errOutOfRound) {
@@ -417,6 +502,31 @@ func (h *Handler) broadcastNextPartial(current roundInfo, upon *chain.Beacon) {
 	}
 }
 
+// checkProtection returns an error if the partial beacon of the round must not
+// be signed, as it conflicts with a partial beacon signed before.
+func (h *Handler) checkProtection(round uint64, msg []byte) error {
+	if h.conf.Protection == nil {
+		return nil
+	}
+	err := h.conf.Protection.Check(round, msg)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, ErrConflict) {
+		h.l.Errorw("", "beacon_round", round, "signing_protection", "unavailable", "err", err)
+		return err
+	}
+	metrics.SigningConflicts.WithLabelValues(commonutils.GetCanonicalBeaconID(h.conf.Group.ID)).Inc()
+	if !h.conf.OverrideProtection {
+		h.l.Errorw("", "beacon_round", round, "signing_protection", "refused", "err", err,
+			"msg_sign", shortSigStr(msg))
+		return err
+	}
+	h.l.Warnw("", "beacon_round", round, "signing_protection", "overridden", "err", err,
+		"msg_sign", shortSigStr(msg))
+	return h.conf.Protection.Record(round, msg)
+}
+
 // Stop the beacon loop from aggregating  further randomness, but it
 // finishes the one it is aggregating currently.
 func (h *Handler) Stop() {
@@ -429,6 +539,14 @@ func (h *Handler) Stop() {
 
 	h.chain.Stop()
 	h.ticker.Stop()
+	if h.senders != nil {
+		h.senders.Stop()
+	}
+	if h.conf.Protection != nil {
+		if err := h.conf.Protection.Close(); err != nil {
+			h.l.Errorw("", "signing_protection", "close", "err", err)
+		}
+	}
 
 	h.stopped = true
 	h.running = false
@@ -23,6 +23,7 @@ import (
 	pbCommon "github.com/drand/drand/protobuf/common"
 	"github.com/drand/drand/protobuf/drand"
 	"github.com/drand/drand/test"
+	"github.com/drand/drand/test/mock"
 	testnet "github.com/drand/drand/test/net"
 	"github.com/drand/kyber"
 	"github.com/drand/kyber/share"
@@ -532,6 +533,44 @@ func TestBeaconSimple(t *testing.T) {
 	checkWait(counter)
 }
 
+func TestBeaconSubSecond(t *testing.T) {
+	n := 3
+	thr := n/2 + 1
+	period := 500 * time.Millisecond
+
+	genesisTime := clock.NewFakeClock().Now().Unix() + 1
+	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
+
+	bt := NewBeaconTest(t, n, thr, period, genesisTime, sch, beaconID)
+	defer bt.CleanUp()
+
+	var counter = &sync.WaitGroup{}
+	rounds := make(chan uint64, 10)
+	for i := 0; i < n; i++ {
+		i := i
+		bt.CallbackFor(i, func(b *chain.Beacon) {
+			if i == 0 {
+				rounds <- b.Round
+			}
+			counter.Done()
+		})
+		bt.ServeBeacon(t, i)
+	}
+	bt.StartBeacons(t, n)
+
+	// the rounds happen at genesis, then every half second
+	counter.Add(n)
+	bt.MoveTime(t, 1*time.Second)
+	checkWait(counter)
+	require.Equal(t, uint64(1), <-rounds)
+	for round := uint64(2); round <= 4; round++ {
+		counter.Add(n)
+		bt.MoveTime(t, period)
+		checkWait(counter)
+		require.Equal(t, round, <-rounds)
+	}
+}
+
 func TestBeaconThreshold(t *testing.T) {
 	n := 3
 	thr := n/2 + 1
@@ -659,3 +698,74 @@ func (b *BeaconTest) CallbackFor(i int, fn func(*chain.Beacon)) {
 	j := b.searchNode(i)
 	b.nodes[j].handler.AddCallback(b.nodes[j].private.Public.Address(), fn)
 }
+
+func TestBeaconSigner(t *testing.T) {
+	n := 3
+	thr := n/2 + 1
+	period := 2 * time.Second
+
+	genesisTime := clock.NewFakeClock().Now().Unix() + 2
+	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
+
+	bt := NewBeaconTest(t, n, thr, period, genesisTime, sch, beaconID)
+	defer bt.CleanUp()
+
+	signer := mock.NewSigner(bt.nodes[0].shares)
+	bt.nodes[0].handler.crypto.signer = signer
+
+	var counter = &sync.WaitGroup{}
+	counter.Add(n)
+	for i := 0; i < n; i++ {
+		bt.CallbackFor(i, func(b *chain.Beacon) { counter.Done() })
+		bt.ServeBeacon(t, i)
+	}
+	bt.StartBeacons(t, n)
+
+	bt.MoveTime(t, 2*time.Second)
+	checkWait(counter)
+	require.Equal(t, []uint64{1}, signer.Rounds())
+
+	// the node can't sign without its signer but still follows the chain
+	signer.SetError(errors.New("signer unavailable"))
+	counter.Add(n)
+	bt.MoveTime(t, period)
+	checkWait(counter)
+	require.Equal(t, []uint64{1, 2}, signer.Rounds())
+}
+
+func TestBeaconProtection(t *testing.T) {
+	n := 3
+	thr := n/2 + 1
+	period := 2 * time.Second
+
+	genesisTime := clock.NewFakeClock().Now().Unix() + 2
+	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
+
+	bt := NewBeaconTest(t, n, thr, period, genesisTime, sch, beaconID)
+	defer bt.CleanUp()
+
+	// both nodes signed another message for the first round before, only the
+	// second one overrides the protection
+	signers := make([]*mock.Signer, 2)
+	for i := range signers {
+		protection := NewProtection()
+		require.NoError(t, protection.Check(1, []byte("another message")))
+		signers[i] = mock.NewSigner(bt.nodes[i].shares)
+		bt.nodes[i].handler.crypto.signer = signers[i]
+		bt.nodes[i].handler.conf.Protection = protection
+	}
+	bt.nodes[1].handler.conf.OverrideProtection = true
+
+	var counter = &sync.WaitGroup{}
+	counter.Add(n)
+	for i := 0; i < n; i++ {
+		bt.CallbackFor(i, func(b *chain.Beacon) { counter.Done() })
+		bt.ServeBeacon(t, i)
+	}
+	bt.StartBeacons(t, n)
+
+	bt.MoveTime(t, 2*time.Second)
+	checkWait(counter)
+	require.Empty(t, signers[0].Rounds())
+	require.Equal(t, []uint64{1}, signers[1].Rounds())
+}
@@ -0,0 +1,274 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	clock "github.com/jonboulle/clockwork"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/drand/drand/log"
+	"github.com/drand/drand/net"
+	"github.com/drand/drand/protobuf/common"
+	proto "github.com/drand/drand/protobuf/drand"
+)
+
+// partialSenders delivers the partial beacons of this node over a long-lived
+// stream to each peer, opened on the first partial beacon sent to it. Each
+// partial beacon must be delivered within a period.
+type partialSenders struct {
+	sync.Mutex
+	client  net.ProtocolClient
+	clock   clock.Clock
+	period  time.Duration
+	l       log.Logger
+	ctx     context.Context
+	cancel  context.CancelFunc
+	senders map[string]*partialSender
+	stopped bool
+}
+
+func newPartialSenders(c net.ProtocolClient, cl clock.Clock, period time.Duration, l log.Logger) *partialSenders {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &partialSenders{
+		client:  c,
+		clock:   cl,
+		period:  period,
+		l:       l,
+		ctx:     ctx,
+		cancel:  cancel,
+		senders: make(map[string]*partialSender),
+	}
+}
+
+// Send queues the partial beacon for the peer. Partial beacons are delivered
+// to a peer in the order they are queued.
+func (s *partialSenders) Send(peer net.Peer, p *proto.PartialBeaconPacket) {
+	s.Lock()
+	defer s.Unlock()
+	if s.stopped {
+		return
+	}
+	sender, ok := s.senders[peer.Address()]
+	if !ok {
+		sender = newPartialSender(s.ctx, s.client, peer, s.clock, s.period, s.l)
+		s.senders[peer.Address()] = sender
+		go sender.run()
+	}
+	sender.queue(p)
+}
+
+// Stop closes the streams to all the peers, interrupting the partial beacons
+// being sent.
+func (s *partialSenders) Stop() {
+	s.Lock()
+	defer s.Unlock()
+	if s.stopped {
+		return
+	}
+	s.stopped = true
+	s.cancel()
+	for _, sender := range s.senders {
+		close(sender.done)
+	}
+}
+
+// partialSender delivers the partial beacons to a single peer. The partial
+// beacons are sent over a stream while the peer acknowledges them, and with
+// unary calls while the stream is broken or if the version the peer runs
+// doesn't support it.
+type partialSender struct {
+	ctx     context.Context
+	client  net.ProtocolClient
+	peer    net.Peer
+	clock   clock.Clock
+	period  time.Duration
+	l       log.Logger
+	packets chan *proto.PartialBeaconPacket
+	events  chan streamEvent
+	done    chan bool
+}
+
+// streamEvent is an acknowledgment, or the error that broke the stream of the
+// given generation
+type streamEvent struct {
+	gen uint64
+	ack *proto.PartialBeaconAck
+	err error
+}
+
+func newPartialSender(ctx context.Context, c net.ProtocolClient, peer net.Peer, cl clock.Clock,
+	period time.Duration, l log.Logger) *partialSender {
+	return &partialSender{
+		ctx:     ctx,
+		client:  c,
+		peer:    peer,
+		clock:   cl,
+		period:  period,
+		l:       l,
+		packets: make(chan *proto.PartialBeaconPacket, PartialStreamBuffer),
+		events:  make(chan streamEvent, 1),
+		done:    make(chan bool),
+	}
+}
+
+func (s *partialSender) queue(p *proto.PartialBeaconPacket) {
+	select {
+	case s.packets <- p:
+	default:
+		s.l.Errorw("", "partial_stream", s.peer.Address(), "queue", "full", "dropped_round", p.GetRound())
+	}
+}
+
+//nolint:gocyclo
+func (s *partialSender) run() {
+	var stream proto.Protocol_PartialBeaconStreamClient
+	cancel := func() {}
+	defer func() { cancel() }()
+	// gen identifies the current stream, to ignore the events of the previous
+	// ones
+	var gen uint64
+	// the partial beacons sent over the stream and not acknowledged yet
+	var pending []*proto.PartialBeaconPacket
+	// unary is set while the peer runs a version without streams, which is
+	// legacy once known from its replies
+	var unary bool
+	var legacy *common.NodeVersion
+	var retryAt time.Time
+	backoff := PartialStreamBackoff
+
+	broken := func() {
+		retryAt = s.clock.Now().Add(backoff)
+		if backoff *= 2; backoff > MaxPartialStreamBackoff {
+			backoff = MaxPartialStreamBackoff
+		}
+	}
+
+	// sendUnary delivers the partial beacon with a unary call, and tries the
+	// stream again once the peer runs another version than the legacy one
+	sendUnary := func(p *proto.PartialBeaconPacket) {
+		v := s.sendUnary(p)
+		if !unary || v == nil {
+			return
+		}
+		if legacy == nil {
+			legacy = v
+		} else if !sameVersion(legacy, v) {
+			s.l.Infow("", "partial_stream", s.peer.Address(), "status", "peer upgraded, trying the stream again",
+				"version", versionString(v))
+			unary, legacy = false, nil
+		}
+	}
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case p := <-s.packets:
+			if stream == nil && !unary && !s.clock.Now().Before(retryAt) {
+				ctx, c := context.WithCancel(s.ctx)
+				st, err := s.client.PartialBeaconStream(ctx, s.peer)
+				if err != nil {
+					c()
+					s.l.Debugw("", "partial_stream", s.peer.Address(), "open", err, "retry_in", backoff)
+					broken()
+				} else {
+					stream, cancel = st, c
+					gen++
+					go s.receive(gen, st)
+				}
+			}
+			if stream == nil {
+				sendUnary(p)
+				break
+			}
+			if len(pending) == PartialStreamBuffer {
+				s.l.Errorw("", "partial_stream", s.peer.Address(), "pending", "full", "unacknowledged_round", pending[0].GetRound())
+				pending = pending[1:]
+			}
+			pending = append(pending, p)
+			// a send blocked for a period breaks the stream
+			timer := time.AfterFunc(s.period, cancel)
+			err := stream.Send(p)
+			timer.Stop()
+			if err != nil {
+				// the receiving side reports why the stream broke
+				s.l.Debugw("", "partial_stream", s.peer.Address(), "send", err, "round", p.GetRound())
+			}
+		case e := <-s.events:
+			if e.gen != gen || stream == nil {
+				break
+			}
+			if e.err == nil {
+				backoff = PartialStreamBackoff
+				if len(pending) > 0 {
+					pending = pending[1:]
+				}
+				if e.ack.GetError() != "" {
+					s.l.Errorw("", "beacon_round", e.ack.GetRound(), "err_request", e.ack.GetError(), "from", s.peer.Address())
+				}
+				break
+			}
+
+			cancel()
+			stream = nil
+			if status.Code(e.err) == codes.Unimplemented {
+				s.l.Infow("", "partial_stream", s.peer.Address(), "status", "unsupported by the peer, using unary calls")
+				unary, legacy = true, nil
+			} else {
+				s.l.Infow("", "partial_stream", s.peer.Address(), "status", "broken", "err", e.err, "retry_in", backoff)
+				broken()
+			}
+			// the partial beacons not acknowledged are delivered in order with
+			// unary calls
+			for _, p := range pending {
+				sendUnary(p)
+			}
+			pending = nil
+		}
+	}
+}
+
+// receive forwards the acknowledgments of the stream to the run loop, until
+// the stream breaks.
+func (s *partialSender) receive(gen uint64, stream proto.Protocol_PartialBeaconStreamClient) {
+	for {
+		ack, err := stream.Recv()
+		select {
+		case s.events <- streamEvent{gen: gen, ack: ack, err: err}:
+		case <-s.done:
+			return
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// sendUnary delivers the partial beacon with a unary call lasting at most a
+// period. It returns the version of the peer, if it replied with one.
+func (s *partialSender) sendUnary(p *proto.PartialBeaconPacket) *common.NodeVersion {
+	s.l.Debugw("", "beacon_round", p.GetRound(), "send_to", s.peer.Address())
+	ctx, cancel := context.WithTimeout(s.ctx, s.period)
+	defer cancel()
+	resp, err := s.client.PartialBeacon(ctx, s.peer, p)
+	if err != nil {
+		s.l.Errorw("", "beacon_round", p.GetRound(), "err_request", err, "from", s.peer.Address())
+		if strings.Contains(err.Error(), errOutOfRound) {
+			s.l.Errorw("", "beacon_round", p.GetRound(), "node", s.peer.Address(), "reply", "out-of-round")
+		}
+	}
+	return resp.GetMetadata().GetNodeVersion()
+}
+
+func sameVersion(a, b *common.NodeVersion) bool {
+	return a.GetMajor() == b.GetMajor() && a.GetMinor() == b.GetMinor() && a.GetPatch() == b.GetPatch()
+}
+
+func versionString(v *common.NodeVersion) string {
+	return fmt.Sprintf("%d.%d.%d", v.GetMajor(), v.GetMinor(), v.GetPatch())
+}
@@ -0,0 +1,245 @@
+package beacon
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	clock "github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/drand/drand/log"
+	"github.com/drand/drand/net"
+	pbCommon "github.com/drand/drand/protobuf/common"
+	"github.com/drand/drand/protobuf/drand"
+	"github.com/drand/drand/test"
+)
+
+// streamClient records the partial beacons it delivers, over streams or with
+// unary calls
+type streamClient struct {
+	net.ProtocolClient
+	sync.Mutex
+	// openErr is returned when opening a stream
+	openErr error
+	// recvErr breaks the streams when receiving, if set
+	recvErr error
+	// block makes the sends over the streams block until they are canceled
+	block bool
+	// version is the version the peer replies to unary calls with
+	version     *pbCommon.NodeVersion
+	opened      int
+	interrupted int
+	streamed    []uint64
+	unary       []uint64
+}
+
+func (c *streamClient) PartialBeacon(_ context.Context, _ net.Peer, in *drand.PartialBeaconPacket, _ ...net.CallOption) (
+	*drand.Empty, error) {
+	c.Lock()
+	defer c.Unlock()
+	c.unary = append(c.unary, in.GetRound())
+	return &drand.Empty{Metadata: pbCommon.NewMetadata(c.version)}, nil
+}
+
+func (c *streamClient) PartialBeaconStream(ctx context.Context, _ net.Peer, _ ...net.CallOption) (
+	drand.Protocol_PartialBeaconStreamClient, error) {
+	c.Lock()
+	defer c.Unlock()
+	if c.openErr != nil {
+		return nil, c.openErr
+	}
+	c.opened++
+	return &fakePartialStream{ctx: ctx, c: c, acks: make(chan *drand.PartialBeaconAck, PartialStreamBuffer)}, nil
+}
+
+func (c *streamClient) delivered() (streamed, unary []uint64) {
+	c.Lock()
+	defer c.Unlock()
+	return append([]uint64(nil), c.streamed...), append([]uint64(nil), c.unary...)
+}
+
+type fakePartialStream struct {
+	grpc.ClientStream
+	ctx  context.Context
+	c    *streamClient
+	acks chan *drand.PartialBeaconAck
+}
+
+func (s *fakePartialStream) Send(p *drand.PartialBeaconPacket) error {
+	s.c.Lock()
+	block := s.c.block
+	s.c.Unlock()
+	if block {
+		<-s.ctx.Done()
+		s.c.Lock()
+		s.c.interrupted++
+		s.c.Unlock()
+		return io.EOF
+	}
+	s.c.Lock()
+	broken := s.c.recvErr != nil
+	if !broken {
+		s.c.streamed = append(s.c.streamed, p.GetRound())
+	}
+	s.c.Unlock()
+	if !broken {
+		s.acks <- &drand.PartialBeaconAck{Round: p.GetRound()}
+	}
+	return nil
+}
+
+func (s *fakePartialStream) Recv() (*drand.PartialBeaconAck, error) {
+	s.c.Lock()
+	err := s.c.recvErr
+	s.c.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	select {
+	case ack := <-s.acks:
+		return ack, nil
+	case <-s.ctx.Done():
+		return nil, io.EOF
+	}
+}
+
+func sendRounds(s *partialSenders, from, to uint64) {
+	peer := test.NewPeer("127.0.0.1:8080")
+	for round := from; round <= to; round++ {
+		s.Send(peer, &drand.PartialBeaconPacket{Round: round})
+	}
+}
+
+func TestPartialStreamOrdered(t *testing.T) {
+	c := &streamClient{}
+	s := newPartialSenders(c, clock.NewFakeClock(), time.Second, log.DefaultLogger())
+	defer s.Stop()
+
+	sendRounds(s, 1, 10)
+	require.Eventually(t, func() bool {
+		streamed, _ := c.delivered()
+		return len(streamed) == 10
+	}, time.Second, 10*time.Millisecond)
+	streamed, unary := c.delivered()
+	require.Equal(t, []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, streamed)
+	require.Empty(t, unary)
+	require.Equal(t, 1, c.opened)
+}
+
+func TestPartialStreamUnimplemented(t *testing.T) {
+	// a peer running a version without streams
+	c := &streamClient{
+		recvErr: status.Error(codes.Unimplemented, "unknown method PartialBeaconStream"),
+		version: &pbCommon.NodeVersion{Major: 1, Minor: 4, Patch: 5},
+	}
+	s := newPartialSenders(c, clock.NewFakeClock(), time.Second, log.DefaultLogger())
+	defer s.Stop()
+
+	sendRounds(s, 1, 1)
+	require.Eventually(t, func() bool {
+		_, unary := c.delivered()
+		return len(unary) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// the stream is not reopened while the peer runs the same version
+	sendRounds(s, 2, 3)
+	require.Eventually(t, func() bool {
+		_, unary := c.delivered()
+		return len(unary) == 3
+	}, time.Second, 10*time.Millisecond)
+	_, unary := c.delivered()
+	require.Equal(t, []uint64{1, 2, 3}, unary)
+	require.Equal(t, 1, c.opened)
+
+	// the peer upgrades: it replies with another version, and the stream is
+	// opened again after that
+	c.Lock()
+	c.recvErr = nil
+	c.version = &pbCommon.NodeVersion{Major: 1, Minor: 5, Patch: 0}
+	c.Unlock()
+	sendRounds(s, 4, 5)
+	require.Eventually(t, func() bool {
+		streamed, _ := c.delivered()
+		return len(streamed) == 1
+	}, time.Second, 10*time.Millisecond)
+	streamed, unary := c.delivered()
+	require.Equal(t, []uint64{5}, streamed)
+	require.Equal(t, []uint64{1, 2, 3, 4}, unary)
+	require.Equal(t, 2, c.opened)
+}
+
+func TestPartialStreamSendDeadline(t *testing.T) {
+	// a peer which stops reading the stream
+	c := &streamClient{block: true}
+	s := newPartialSenders(c, clock.NewFakeClock(), 100*time.Millisecond, log.DefaultLogger())
+	defer s.Stop()
+
+	// the blocked send breaks the stream after a period, and the partial
+	// beacon is delivered with a unary call
+	sendRounds(s, 1, 1)
+	require.Eventually(t, func() bool {
+		_, unary := c.delivered()
+		return len(unary) == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestPartialStreamStop(t *testing.T) {
+	c := &streamClient{block: true}
+	s := newPartialSenders(c, clock.NewFakeClock(), time.Hour, log.DefaultLogger())
+
+	sendRounds(s, 1, 1)
+	require.Eventually(t, func() bool {
+		c.Lock()
+		defer c.Unlock()
+		return c.opened == 1
+	}, time.Second, 10*time.Millisecond)
+	// stopping interrupts the blocked send
+	s.Stop()
+	require.Eventually(t, func() bool {
+		c.Lock()
+		defer c.Unlock()
+		return c.interrupted == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestPartialStreamReconnect(t *testing.T) {
+	cl := clock.NewFakeClock()
+	c := &streamClient{openErr: status.Error(codes.Unavailable, "connection refused")}
+	s := newPartialSenders(c, cl, time.Second, log.DefaultLogger())
+	defer s.Stop()
+
+	// the partials are sent with unary calls while the stream can't be opened
+	sendRounds(s, 1, 2)
+	require.Eventually(t, func() bool {
+		_, unary := c.delivered()
+		return len(unary) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	c.Lock()
+	c.openErr = nil
+	c.Unlock()
+	// still within the backoff
+	sendRounds(s, 3, 3)
+	require.Eventually(t, func() bool {
+		_, unary := c.delivered()
+		return len(unary) == 3
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, 0, c.opened)
+
+	cl.Advance(MaxPartialStreamBackoff)
+	sendRounds(s, 4, 5)
+	require.Eventually(t, func() bool {
+		streamed, _ := c.delivered()
+		return len(streamed) == 2
+	}, time.Second, 10*time.Millisecond)
+	streamed, unary := c.delivered()
+	require.Equal(t, []uint64{4, 5}, streamed)
+	require.Equal(t, []uint64{1, 2, 3}, unary)
+	require.Equal(t, 1, c.opened)
+}
@@ -0,0 +1,140 @@
+package beacon
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	clock "github.com/jonboulle/clockwork"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/metrics"
+)
+
+// ParticipationHistory is the number of rounds whose participation records the
+// handler keeps.
+const ParticipationHistory = 128
+
+// RoundParticipation records the partial beacons a node received for a round.
+type RoundParticipation struct {
+	Round uint64
+	// Partials maps the index of the nodes whose valid partial was received to
+	// its arrival time, relative to the time of the round
+	Partials map[uint32]time.Duration
+	// Invalid is the number of partials that didn't verify. They are not
+	// attributed to a node, since the index they claim is not authenticated.
+	Invalid int
+	// Aggregated is true once this node recovered the beacon from the partials
+	Aggregated bool
+}
+
+func (r *RoundParticipation) copy() *RoundParticipation {
+	c := &RoundParticipation{
+		Round:      r.Round,
+		Partials:   make(map[uint32]time.Duration, len(r.Partials)),
+		Invalid:    r.Invalid,
+		Aggregated: r.Aggregated,
+	}
+	for i, latency := range r.Partials {
+		c.Partials[i] = latency
+	}
+	return c
+}
+
+// participation keeps the participation records of the last rounds, and
+// exports the arrival of the partials of each node as metrics.
+type participation struct {
+	sync.Mutex
+	clock    clock.Clock
+	period   time.Duration
+	genesis  int64
+	beaconID string
+	// rounds is sorted by round
+	rounds []*RoundParticipation
+}
+
+func newParticipation(c clock.Clock, period time.Duration, genesis int64, beaconID string) *participation {
+	return &participation{
+		clock:    c,
+		period:   period,
+		genesis:  genesis,
+		beaconID: beaconID,
+	}
+}
+
+// Valid records a valid partial of the node at the given index.
+func (p *participation) Valid(index uint32, round uint64) {
+	latency := p.clock.Now().Sub(chain.RoundTime(p.period, p.genesis, round))
+	label := strconv.Itoa(int(index))
+	metrics.PartialParticipation.WithLabelValues(p.beaconID, label).Inc()
+	metrics.PartialLatency.WithLabelValues(p.beaconID, label).Set(float64(latency.Milliseconds()))
+
+	p.Lock()
+	defer p.Unlock()
+	r := p.get(round)
+	if r == nil {
+		return
+	}
+	if _, seen := r.Partials[index]; !seen {
+		r.Partials[index] = latency
+	}
+}
+
+// Invalid records a partial of the round that didn't verify.
+func (p *participation) Invalid(round uint64) {
+	metrics.InvalidPartials.WithLabelValues(p.beaconID).Inc()
+
+	p.Lock()
+	defer p.Unlock()
+	r := p.get(round)
+	if r == nil {
+		return
+	}
+	r.Invalid++
+}
+
+// Aggregated records this node recovered the beacon of the round.
+func (p *participation) Aggregated(round uint64) {
+	p.Lock()
+	defer p.Unlock()
+	if r := p.get(round); r != nil {
+		r.Aggregated = true
+	}
+}
+
+// Last returns a copy of the records of the last rounds, at most n if n is
+// positive, sorted by round.
+func (p *participation) Last(n int) []*RoundParticipation {
+	p.Lock()
+	defer p.Unlock()
+	rounds := p.rounds
+	if n > 0 && n < len(rounds) {
+		rounds = rounds[len(rounds)-n:]
+	}
+	last := make([]*RoundParticipation, len(rounds))
+	for i, r := range rounds {
+		last[i] = r.copy()
+	}
+	return last
+}
+
+// get returns the record of the round, creating it if needed, or nil if the
+// round is older than the history kept.
+func (p *participation) get(round uint64) *RoundParticipation {
+	i := sort.Search(len(p.rounds), func(i int) bool { return p.rounds[i].Round >= round })
+	if i < len(p.rounds) && p.rounds[i].Round == round {
+		return p.rounds[i]
+	}
+	if i == 0 && len(p.rounds) >= ParticipationHistory {
+		return nil
+	}
+	r := &RoundParticipation{Round: round, Partials: make(map[uint32]time.Duration)}
+	p.rounds = append(p.rounds, nil)
+	copy(p.rounds[i+1:], p.rounds[i:])
+	p.rounds[i] = r
+	if len(p.rounds) > ParticipationHistory {
+		p.rounds = p.rounds[len(p.rounds)-ParticipationHistory:]
+	}
+	return r
+}
@@ -0,0 +1,62 @@
+package beacon
+
+import (
+	"testing"
+	"time"
+
+	clock "github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParticipation(t *testing.T) {
+	period := 3 * time.Second
+	c := clock.NewFakeClock()
+	genesis := c.Now().Unix()
+	p := newParticipation(c, period, genesis, "default")
+
+	// round 2 happens one period after genesis
+	c.Advance(period + 200*time.Millisecond)
+	p.Valid(0, 2)
+	c.Advance(100 * time.Millisecond)
+	p.Valid(2, 2)
+	// a duplicate keeps the first arrival
+	p.Valid(2, 2)
+	p.Invalid(2)
+	p.Aggregated(2)
+	// a partial of the next round, in advance
+	p.Valid(1, 3)
+
+	last := p.Last(0)
+	require.Len(t, last, 2)
+	r := last[0]
+	require.Equal(t, uint64(2), r.Round)
+	require.Equal(t, map[uint32]time.Duration{0: 200 * time.Millisecond, 2: 300 * time.Millisecond}, r.Partials)
+	require.Equal(t, 1, r.Invalid)
+	require.True(t, r.Aggregated)
+	require.Equal(t, uint64(3), last[1].Round)
+	require.False(t, last[1].Aggregated)
+
+	// the records returned are copies
+	r.Partials[3] = 0
+	require.Len(t, p.Last(0)[0].Partials, 2)
+
+	require.Len(t, p.Last(1), 1)
+	require.Equal(t, uint64(3), p.Last(1)[0].Round)
+}
+
+func TestParticipationHistory(t *testing.T) {
+	c := clock.NewFakeClock()
+	p := newParticipation(c, time.Second, c.Now().Unix(), "default")
+	for round := uint64(1); round <= ParticipationHistory+10; round++ {
+		p.Valid(0, round)
+	}
+	last := p.Last(0)
+	require.Len(t, last, ParticipationHistory)
+	require.Equal(t, uint64(11), last[0].Round)
+	require.Equal(t, uint64(ParticipationHistory+10), last[len(last)-1].Round)
+
+	// rounds older than the history kept are ignored
+	p.Valid(1, 5)
+	require.Len(t, p.Last(0), ParticipationHistory)
+	require.Equal(t, uint64(11), p.Last(0)[0].Round)
+}
@@ -0,0 +1,142 @@
+package beacon
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/drand/drand/chain"
+)
+
+// ProtectionWindow is the number of rounds below the highest round signed for
+// which the signed messages are remembered. Older rounds are refused, as they
+// can't be checked anymore.
+const ProtectionWindow = 128
+
+// ErrConflict is returned when asked to sign a message for a round for which
+// another message was signed, or for a round too old to be checked.
+var ErrConflict = errors.New("refusing to sign conflicting message")
+
+var protectionBucket = []byte("signed")
+
+// Protection remembers the messages signed for the latest rounds, so that two
+// different messages are never signed for the same round. This happens when
+// two nodes run with the same share, or when a node restored from an old backup
+// builds a different chain. The records are kept in memory, and in a database
+// when opened with OpenProtection so that they survive restarts.
+type Protection struct {
+	sync.Mutex
+	highest uint64
+	signed  map[uint64][]byte
+	db      *bolt.DB
+}
+
+// NewProtection returns a protection which has not signed any round yet, and
+// keeps its records in memory.
+func NewProtection() *Protection {
+	return &Protection{signed: make(map[uint64][]byte)}
+}
+
+// OpenProtection returns a protection keeping its records in the database at
+// the given path, created if needed.
+func OpenProtection(path string, opts *bolt.Options) (*Protection, error) {
+	db, err := bolt.Open(path, 0o600, opts)
+	if err != nil {
+		return nil, err
+	}
+	p := NewProtection()
+	p.db = db
+	err = db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(protectionBucket)
+		if err != nil {
+			return err
+		}
+		return bucket.ForEach(func(k, v []byte) error {
+			round := binary.BigEndian.Uint64(k)
+			p.signed[round] = append([]byte(nil), v...)
+			if round > p.highest {
+				p.highest = round
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return p, nil
+}
+
+// Check returns an error wrapping ErrConflict if signing the message for the
+// given round could conflict with a message signed before. Otherwise, it
+// records the message as signed.
+func (p *Protection) Check(round uint64, msg []byte) error {
+	p.Lock()
+	defer p.Unlock()
+	if prev, ok := p.signed[round]; ok {
+		if bytes.Equal(prev, msg) {
+			return nil
+		}
+		return fmt.Errorf("%w: round %d was signed over another message", ErrConflict, round)
+	}
+	if p.highest >= ProtectionWindow && round <= p.highest-ProtectionWindow {
+		return fmt.Errorf("%w: round %d is too old, the last round signed is %d", ErrConflict, round, p.highest)
+	}
+	return p.record(round, msg)
+}
+
+// Record records the message as signed for the given round, replacing any
+// message recorded before. It is meant to override the protection.
+func (p *Protection) Record(round uint64, msg []byte) error {
+	p.Lock()
+	defer p.Unlock()
+	return p.record(round, msg)
+}
+
+// record requires the lock of the protection.
+func (p *Protection) record(round uint64, msg []byte) error {
+	var pruned []uint64
+	highest := p.highest
+	if round > highest {
+		highest = round
+		for r := range p.signed {
+			if r+ProtectionWindow <= highest {
+				pruned = append(pruned, r)
+			}
+		}
+	}
+	if p.db != nil {
+		// the record is persisted before signing, so that a crash can't
+		// lose it
+		err := p.db.Update(func(tx *bolt.Tx) error {
+			bucket := tx.Bucket(protectionBucket)
+			for _, r := range pruned {
+				if err := bucket.Delete(chain.RoundToBytes(r)); err != nil {
+					return err
+				}
+			}
+			return bucket.Put(chain.RoundToBytes(round), msg)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	p.signed[round] = append([]byte(nil), msg...)
+	p.highest = highest
+	for _, r := range pruned {
+		delete(p.signed, r)
+	}
+	return nil
+}
+
+// Close closes the database of the protection, if any.
+func (p *Protection) Close() error {
+	if p.db == nil {
+		return nil
+	}
+	return p.db.Close()
+}
@@ -0,0 +1,52 @@
+package beacon
+
+import (
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtection(t *testing.T) {
+	p := NewProtection()
+	require.NoError(t, p.Check(10, []byte("a")))
+	// signing the same message again is fine
+	require.NoError(t, p.Check(10, []byte("a")))
+	require.ErrorIs(t, p.Check(10, []byte("b")), ErrConflict)
+	// rounds can be signed out of order within the window
+	require.NoError(t, p.Check(9, []byte("c")))
+	require.NoError(t, p.Check(10+ProtectionWindow, []byte("d")))
+	require.ErrorIs(t, p.Check(10+ProtectionWindow, []byte("e")), ErrConflict)
+	require.ErrorIs(t, p.Check(10, []byte("b")), ErrConflict)
+	require.NoError(t, p.Check(11, []byte("f")))
+	require.Len(t, p.signed, 2)
+
+	// the protection can be overridden
+	require.NoError(t, p.Record(11, []byte("g")))
+	require.NoError(t, p.Check(11, []byte("g")))
+}
+
+func TestProtectionPersistence(t *testing.T) {
+	file := path.Join(t.TempDir(), "protection.db")
+	p, err := OpenProtection(file, nil)
+	require.NoError(t, err)
+	require.NoError(t, p.Check(1, []byte("a")))
+	require.NoError(t, p.Check(2, []byte("b")))
+	require.NoError(t, p.Record(2, []byte("c")))
+	require.NoError(t, p.Close())
+
+	p, err = OpenProtection(file, nil)
+	require.NoError(t, err)
+	require.ErrorIs(t, p.Check(1, []byte("b")), ErrConflict)
+	require.ErrorIs(t, p.Check(2, []byte("b")), ErrConflict)
+	require.NoError(t, p.Check(2, []byte("c")))
+	// old rounds are pruned from the database too
+	require.NoError(t, p.Check(1+ProtectionWindow, []byte("d")))
+	require.NoError(t, p.Close())
+
+	p, err = OpenProtection(file, nil)
+	require.NoError(t, err)
+	defer p.Close()
+	require.Len(t, p.signed, 2)
+	require.ErrorIs(t, p.Check(1, []byte("a")), ErrConflict)
+}
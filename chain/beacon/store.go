@@ -120,7 +120,7 @@ func (d *discrepancyStore) Put(b *chain.Beacon) error {
 	beaconID := common.GetCanonicalBeaconID(d.group.ID)
 
 	actual := d.clock.Now().UnixNano()
-	expected := chain.TimeOfRound(d.group.Period, d.group.GenesisTime, b.Round) * 1e9
+	expected := chain.RoundTime(d.group.Period, d.group.GenesisTime, b.Round).UnixNano()
 	discrepancy := float64(actual-expected) / float64(time.Millisecond)
 
 	metrics.BeaconDiscrepancyLatency.WithLabelValues(beaconID).Set(float64(actual-expected) / float64(time.Millisecond))
@@ -125,7 +125,7 @@ func (s *SyncManager) Run() {
 		time.Sleep(time.Second)
 	}
 	// tracks the time of the last round we successfully synced
-	lastRoundTime := 0
+	var lastRoundTime time.Time
 	// the context being used by the current sync process
 	lastCtx, cancel := context.WithCancel(context.Background()) //nolint
 	for {
@@ -148,8 +148,8 @@ func (s *SyncManager) Run() {
 			// We always give a delay of a few periods since the one next to "now"
 			// might not be exactly ready yet so only after a few periods we know we
 			// must have gotten some data.
-			upperBound := lastRoundTime + int(s.period.Seconds())*s.factor
-			if upperBound < int(s.clock.Now().Unix()) {
+			upperBound := lastRoundTime.Add(s.period * time.Duration(s.factor))
+			if upperBound.Before(s.clock.Now()) {
 				// we haven't received a new block in a while
 				// -> time to start a new sync
 				cancel()
@@ -159,7 +159,7 @@ func (s *SyncManager) Run() {
 
 		case <-s.newSync:
 			// just received a new beacon from sync, we keep track of this time
-			lastRoundTime = int(s.clock.Now().Unix())
+			lastRoundTime = s.clock.Now()
 		case <-s.done:
 			s.log.Infow("", "sync_manager", "exits")
 			cancel()
@@ -360,7 +360,7 @@ func (s *SyncManager) tryNode(global context.Context, from, upTo uint64, peer ne
 	}
 
 	// for effective rate limiting but not when we are caught up and following a chain live
-	target := chain.CurrentRound(s.clock.Now().Unix(), s.info.Period, s.info.GenesisTime)
+	target := chain.CurrentRoundAt(s.clock.Now(), s.info.Period, s.info.GenesisTime)
 	if upTo > 0 {
 		target = upTo
 	}
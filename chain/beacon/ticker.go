@@ -34,12 +34,12 @@ func (t *ticker) Channel() chan roundInfo {
 	newCh := make(chan roundInfo, 1)
 	t.newCh <- channelInfo{
 		ch:      newCh,
-		startAt: t.clock.Now().Unix(),
+		startAt: t.clock.Now(),
 	}
 	return newCh
 }
 
-func (t *ticker) ChannelAt(start int64) chan roundInfo {
+func (t *ticker) ChannelAt(start time.Time) chan roundInfo {
 	newCh := make(chan roundInfo, 1)
 	t.newCh <- channelInfo{
 		ch:      newCh,
@@ -52,7 +52,7 @@ func (t *ticker) Stop() {
 }
 
 func (t *ticker) CurrentRound() uint64 {
-	return chain.CurrentRound(t.clock.Now().Unix(), t.period, t.genesis)
+	return chain.CurrentRoundAt(t.clock.Now(), t.period, t.genesis)
 }
 
 // Start will sleep until the next upcoming round and start sending out the
@@ -62,10 +62,10 @@ func (t *ticker) Start() {
 	// whole reason of this function is to accept new incoming channels while
 	// still sleeping until the next time
 	go func() {
-		now := t.clock.Now().Unix()
-		_, ttime := chain.NextRound(now, t.period, t.genesis)
-		if ttime > now {
-			t.clock.Sleep(time.Duration(ttime-now) * time.Second)
+		now := t.clock.Now()
+		_, ttime := chain.NextRoundAt(now, t.period, t.genesis)
+		if ttime.After(now) {
+			t.clock.Sleep(ttime.Sub(now))
 		}
 		// first tick happens at specified time
 		chanTime <- t.clock.Now()
@@ -83,7 +83,7 @@ func (t *ticker) Start() {
 	}()
 	var channels []channelInfo
 	var sendTicks = false
-	var ttime int64
+	var ttime time.Time
 	var tround uint64
 	for {
 		if sendTicks {
@@ -93,7 +93,7 @@ func (t *ticker) Start() {
 				time:  ttime,
 			}
 			for _, chinfo := range channels {
-				if chinfo.startAt > ttime {
+				if chinfo.startAt.After(ttime) {
 					continue
 				}
 				select {
@@ -105,8 +105,8 @@ func (t *ticker) Start() {
 		}
 		select {
 		case nt := <-chanTime:
-			tround = chain.CurrentRound(nt.Unix(), t.period, t.genesis)
-			ttime = nt.Unix()
+			tround = chain.CurrentRoundAt(nt, t.period, t.genesis)
+			ttime = nt
 			sendTicks = true
 		case newChan := <-t.newCh:
 			channels = append(channels, newChan)
@@ -121,10 +121,10 @@ func (t *ticker) Start() {
 
 type roundInfo struct {
 	round uint64
-	time  int64
+	time  time.Time
 }
 
 type channelInfo struct {
 	ch      chan roundInfo
-	startAt int64
+	startAt time.Time
 }
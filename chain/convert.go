@@ -3,10 +3,10 @@ package chain
 import (
 	"fmt"
 	"io"
-	"time"
 
 	json "github.com/nikkolasg/hexjson"
 
+	commonutils "github.com/drand/drand/common"
 	"github.com/drand/drand/common/scheme"
 	"github.com/drand/drand/key"
 	"github.com/drand/drand/protobuf/common"
@@ -28,10 +28,11 @@ func InfoFromProto(p *drand.ChainInfoPacket) (*Info, error) {
 	return &Info{
 		PublicKey:   public,
 		GenesisTime: p.GenesisTime,
-		Period:      time.Duration(p.Period) * time.Second,
+		Period:      commonutils.PeriodFromProto(p.GetPeriod(), p.GetPeriodMs()),
 		GenesisSeed: p.GroupHash,
 		Scheme:      sch,
 		ID:          p.GetMetadata().GetBeaconID(),
+		EndRound:    p.GetEndRound(),
 	}, nil
 }
 
@@ -45,14 +46,17 @@ func (c *Info) ToProto(metadata *common.Metadata) *drand.ChainInfoPacket {
 		metadata = &common.Metadata{BeaconID: c.ID}
 	}
 
+	period, periodMs := commonutils.PeriodToProto(c.Period)
 	return &drand.ChainInfoPacket{
 		PublicKey:   buff,
 		GenesisTime: c.GenesisTime,
-		Period:      uint32(c.Period.Seconds()),
+		Period:      period,
+		PeriodMs:    periodMs,
 		Hash:        c.Hash(),
 		GroupHash:   c.GenesisSeed,
 		SchemeID:    c.Scheme.ID,
 		Metadata:    metadata,
+		EndRound:    c.EndRound,
 	}
 }
 
@@ -5,6 +5,8 @@ import (
 	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/drand/drand/common"
@@ -23,8 +25,15 @@ type Info struct {
 	Scheme      scheme.Scheme `json:"scheme"`
 	GenesisTime int64         `json:"genesis_time"`
 	GenesisSeed []byte        `json:"group_hash"`
+	// EndRound is the last round of a retired chain, zero while the chain
+	// runs. It isn't part of the hash of the chain.
+	EndRound uint64 `json:"end_round,omitempty"`
 }
 
+// ErrChainEnded is returned when asking for a round after the last round of a
+// retired chain.
+var ErrChainEnded = errors.New("chain ended")
+
 // NewChainInfo makes a chain Info from a group
 func NewChainInfo(g *key.Group) *Info {
 	return &Info{
@@ -37,12 +46,23 @@ func NewChainInfo(g *key.Group) *Info {
 	}
 }
 
+// infoHashV2 prefixes the hash of the chains with a sub-second period
+const infoHashV2 = "drand-chain-info-v2"
+
 // Hash returns the canonical hash representing the chain information. A hash is
 // consistent throughout the entirety of a chain, regardless of the network
 // composition, the actual nodes, generating the randomness.
+// Chains with a period that isn't a whole number of seconds use a second
+// version of the hash, covering the period in milliseconds, so that the hash of
+// the existing chains is unchanged.
 func (c *Info) Hash() []byte {
 	h := sha256.New()
-	_ = binary.Write(h, binary.BigEndian, uint32(c.Period.Seconds()))
+	if c.Period%time.Second != 0 {
+		_, _ = h.Write([]byte(infoHashV2))
+		_ = binary.Write(h, binary.BigEndian, uint64(c.Period.Milliseconds()))
+	} else {
+		_ = binary.Write(h, binary.BigEndian, uint32(c.Period.Seconds()))
+	}
 	_ = binary.Write(h, binary.BigEndian, c.GenesisTime)
 
 	buff, err := c.PublicKey.MarshalBinary()
@@ -66,6 +86,30 @@ func (c *Info) HashString() string {
 	return hex.EncodeToString(c.Hash())
 }
 
+// Retired indicates whether the chain ended.
+func (c *Info) Retired() bool {
+	return c.EndRound != 0
+}
+
+// CheckRound returns an error wrapping ErrChainEnded if the round is after the
+// last round of a retired chain.
+func (c *Info) CheckRound(round uint64) error {
+	if c.Retired() && round > c.EndRound {
+		return fmt.Errorf("%w at round %d: no round %d", ErrChainEnded, c.EndRound, round)
+	}
+	return nil
+}
+
+// RoundAt returns the round active at the given time, which is at most the
+// last round of a retired chain.
+func (c *Info) RoundAt(t time.Time) uint64 {
+	round := CurrentRoundAt(t, c.Period, c.GenesisTime)
+	if c.Retired() && round > c.EndRound {
+		return c.EndRound
+	}
+	return round
+}
+
 // Equal indicates if two Chain Info objects are equivalent
 func (c *Info) Equal(c2 *Info) bool {
 	return c.GenesisTime == c2.GenesisTime &&
@@ -75,6 +119,26 @@ func (c *Info) Equal(c2 *Info) bool {
 		common.CompareBeaconIDs(c.ID, c2.ID)
 }
 
+// CheckStore verifies the last beacon of the store with the public key of the
+// chain, to detect a distributed key that doesn't match the chain stored.
+func (c *Info) CheckStore(s Store) error {
+	if s.Len() == 0 {
+		return nil
+	}
+	last, err := s.Last()
+	if err != nil {
+		return fmt.Errorf("chain: can't load the last beacon: %w", err)
+	}
+	if last.Round == 0 {
+		// the genesis beacon isn't signed
+		return nil
+	}
+	if err := c.Verifier().VerifyBeacon(*last, c.PublicKey); err != nil {
+		return fmt.Errorf("chain: the public key %s doesn't verify the last beacon %d stored: %w", c.PublicKey, last.Round, err)
+	}
+	return nil
+}
+
 // Verifier returns the verifier used to verify the beacon produced by this
 // chain
 func (c *Info) Verifier() *Verifier {
@@ -2,7 +2,10 @@ package chain
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -67,3 +70,68 @@ func TestChainInfo(t *testing.T) {
 	require.NotNil(t, c13)
 	require.Equal(t, c1, c13)
 }
+
+func TestChainInfoHashSubSecond(t *testing.T) {
+	sch := scheme.GetSchemeFromEnv()
+	_, g := test.BatchIdentities(5, sch, "test_beacon")
+	g.Period = 30 * time.Second
+	info := NewChainInfo(g)
+
+	// the hash of the chains with a whole-second period is unchanged
+	legacy := func(c *Info) []byte {
+		h := sha256.New()
+		_ = binary.Write(h, binary.BigEndian, uint32(c.Period.Seconds()))
+		_ = binary.Write(h, binary.BigEndian, c.GenesisTime)
+		buff, _ := c.PublicKey.MarshalBinary()
+		_, _ = h.Write(buff)
+		_, _ = h.Write(c.GenesisSeed)
+		_, _ = h.Write([]byte(c.ID))
+		return h.Sum(nil)
+	}
+	require.Equal(t, legacy(info), info.Hash())
+
+	sub := *info
+	sub.Period = 500 * time.Millisecond
+	require.NotEqual(t, legacy(&sub), sub.Hash())
+	other := sub
+	other.Period = 600 * time.Millisecond
+	require.NotEqual(t, sub.Hash(), other.Hash())
+
+	var buff bytes.Buffer
+	require.NoError(t, sub.ToJSON(&buff, nil))
+	loaded, err := InfoFromJSON(&buff)
+	require.NoError(t, err)
+	require.Equal(t, 500*time.Millisecond, loaded.Period)
+	require.Equal(t, sub.Hash(), loaded.Hash())
+}
+
+func TestChainInfoRetired(t *testing.T) {
+	sch := scheme.GetSchemeFromEnv()
+	_, g := test.BatchIdentities(5, sch, "test_beacon")
+	g.Period = 30 * time.Second
+	info := NewChainInfo(g)
+	hash := info.Hash()
+	genesis := time.Unix(info.GenesisTime, 0)
+
+	require.False(t, info.Retired())
+	require.NoError(t, info.CheckRound(100))
+	require.Equal(t, uint64(101), info.RoundAt(genesis.Add(100*g.Period)))
+
+	info.EndRound = 50
+	require.True(t, info.Retired())
+	require.NoError(t, info.CheckRound(50))
+	require.NoError(t, info.CheckRound(0))
+	err := info.CheckRound(51)
+	require.ErrorIs(t, err, ErrChainEnded)
+	require.Equal(t, "chain ended at round 50: no round 51", err.Error())
+	require.Equal(t, uint64(50), info.RoundAt(genesis.Add(100*g.Period)))
+	require.Equal(t, uint64(11), info.RoundAt(genesis.Add(10*g.Period)))
+	// the end isn't part of the chain hash
+	require.Equal(t, hash, info.Hash())
+
+	var buff bytes.Buffer
+	require.NoError(t, info.ToJSON(&buff, nil))
+	loaded, err := InfoFromJSON(&buff)
+	require.NoError(t, err)
+	require.Equal(t, uint64(50), loaded.EndRound)
+}
@@ -14,8 +14,13 @@ const maxTimeBuffer = int64(1 << timeBufferBits)
 // specified.
 const TimeOfRoundErrorValue = math.MaxInt64 - maxTimeBuffer
 
-// TimeOfRound is returning the time the current round should happen
+// TimeOfRound is returning the time the current round should happen. For
+// sub-second periods, the time is truncated to the second: use RoundTime to get
+// the exact time.
 func TimeOfRound(period time.Duration, genesis int64, round uint64) int64 {
+	if period%time.Second != 0 {
+		return RoundTime(period, genesis, round).Unix()
+	}
 	if round == 0 {
 		return genesis
 	}
@@ -37,9 +42,43 @@ func TimeOfRound(period time.Duration, genesis int64, round uint64) int64 {
 	return val
 }
 
+// RoundTime returns the time the given round should happen, at the millisecond
+// resolution of the period. It returns the time of TimeOfRoundErrorValue when
+// an invalid round is specified.
+func RoundTime(period time.Duration, genesis int64, round uint64) time.Time {
+	if period%time.Second == 0 {
+		return time.Unix(TimeOfRound(period, genesis, round), 0)
+	}
+	if round == 0 {
+		return time.Unix(genesis, 0)
+	}
+	if period < 0 {
+		return time.Unix(TimeOfRoundErrorValue, 0)
+	}
+
+	ms := uint64(period.Milliseconds())
+	periodBits := math.Log2(float64(ms) + 1)
+	if round >= (math.MaxUint64 >> (int(periodBits) + 2)) {
+		return time.Unix(TimeOfRoundErrorValue, 0)
+	}
+	delta := (round - 1) * ms
+
+	val := genesis + int64(delta/1000)
+	if val > math.MaxInt64-maxTimeBuffer {
+		return time.Unix(TimeOfRoundErrorValue, 0)
+	}
+	return time.Unix(val, int64(delta%1000)*int64(time.Millisecond))
+}
+
 // CurrentRound calculates the active round at `now`
 func CurrentRound(now int64, period time.Duration, genesis int64) uint64 {
-	nextRound, _ := NextRound(now, period, genesis)
+	return CurrentRoundAt(time.Unix(now, 0), period, genesis)
+}
+
+// CurrentRoundAt calculates the active round at `now`, at the resolution of the
+// period.
+func CurrentRoundAt(now time.Time, period time.Duration, genesis int64) uint64 {
+	nextRound, _ := NextRoundAt(now, period, genesis)
 	if nextRound <= 1 {
 		return nextRound
 	}
@@ -49,15 +88,40 @@ func CurrentRound(now int64, period time.Duration, genesis int64) uint64 {
 // NextRound returns the next upcoming round and its UNIX time given the genesis
 // time and the period.
 // round at time genesis = round 1. Round 0 is fixed.
+// For sub-second periods, it returns the first upcoming round that happens on a
+// whole second, so that its UNIX time is exact: use NextRoundAt to get the next
+// upcoming round.
 func NextRound(now int64, period time.Duration, genesis int64) (nextRound uint64, nextTime int64) {
-	if now < genesis {
-		return 1, genesis
-	}
-	fromGenesis := now - genesis
-	// we take the time from genesis divided by the periods in seconds, that
-	// gives us the number of periods since genesis. We add +1 since we want the
-	// next round. We also add +1 because round 1 starts at genesis time.
-	nextRound = uint64(math.Floor(float64(fromGenesis)/period.Seconds())) + 1
-	nextTime = genesis + int64(nextRound*uint64(period.Seconds()))
-	return nextRound + 1, nextTime
+	nextRound, _ = NextRoundAt(time.Unix(now, 0), period, genesis)
+	if period%time.Second != 0 && nextRound > 1 {
+		// the rounds happening on a whole second are every "every" rounds
+		// from the genesis
+		ms := uint64(period.Milliseconds())
+		every := 1000 / gcd(ms, 1000)
+		if r := (nextRound - 1) % every; r != 0 {
+			nextRound += every - r
+		}
+	}
+	return nextRound, TimeOfRound(period, genesis, nextRound)
+}
+
+// NextRoundAt returns the next upcoming round and its time given the genesis
+// time and the period, at the resolution of the period.
+func NextRoundAt(now time.Time, period time.Duration, genesis int64) (nextRound uint64, nextTime time.Time) {
+	if now.Before(time.Unix(genesis, 0)) {
+		return 1, time.Unix(genesis, 0)
+	}
+	fromGenesis := now.Sub(time.Unix(genesis, 0))
+	// we take the time from genesis divided by the period, that gives us the
+	// number of periods since genesis. We add +1 since we want the next round.
+	// We also add +1 because round 1 starts at genesis time.
+	nextRound = uint64(fromGenesis/period) + 2
+	return nextRound, RoundTime(period, genesis, nextRound)
+}
+
+func gcd(a, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
 }
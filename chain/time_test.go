@@ -68,3 +68,52 @@ func TestChainNextRound(t *testing.T) {
 	time2 := TimeOfRound(period, genesis, 3)
 	require.Equal(t, expTime2, time2)
 }
+
+func TestSubSecondRounds(t *testing.T) {
+	genesis := time.Date(2020, 01, 01, 0, 0, 0, 0, time.UTC).Unix()
+	period := 400 * time.Millisecond
+	at := func(ms int64) time.Time {
+		return time.Unix(genesis, 0).Add(time.Duration(ms) * time.Millisecond)
+	}
+
+	require.Equal(t, time.Unix(genesis, 0), RoundTime(period, genesis, 0))
+	require.Equal(t, time.Unix(genesis, 0), RoundTime(period, genesis, 1))
+	require.Equal(t, at(400), RoundTime(period, genesis, 2))
+	require.Equal(t, at(1200), RoundTime(period, genesis, 4))
+	// the UNIX time of a round is truncated to the second
+	require.Equal(t, genesis+1, TimeOfRound(period, genesis, 4))
+
+	require.Equal(t, uint64(1), CurrentRoundAt(at(-100), period, genesis))
+	require.Equal(t, uint64(1), CurrentRoundAt(at(0), period, genesis))
+	require.Equal(t, uint64(1), CurrentRoundAt(at(399), period, genesis))
+	require.Equal(t, uint64(2), CurrentRoundAt(at(400), period, genesis))
+	require.Equal(t, uint64(4), CurrentRoundAt(at(1300), period, genesis))
+	require.Equal(t, uint64(3), CurrentRound(genesis+1, period, genesis))
+
+	round, roundTime := NextRoundAt(at(1300), period, genesis)
+	require.Equal(t, uint64(5), round)
+	require.Equal(t, at(1600), roundTime)
+
+	// the next round on a whole second is every five rounds
+	round, unixTime := NextRound(genesis+1, period, genesis)
+	require.Equal(t, uint64(6), round)
+	require.Equal(t, genesis+2, unixTime)
+	require.Equal(t, time.Unix(unixTime, 0), RoundTime(period, genesis, round))
+
+	overflow := RoundTime(period, genesis, math.MaxUint64>>3)
+	require.Equal(t, time.Unix(TimeOfRoundErrorValue, 0), overflow)
+	require.Equal(t, time.Unix(TimeOfRoundErrorValue, 0), RoundTime(-period, genesis, 2))
+}
+
+func TestWholeSecondRounds(t *testing.T) {
+	genesis := time.Date(2020, 01, 01, 0, 0, 0, 0, time.UTC).Unix()
+	period := 3 * time.Second
+	for now := genesis - 2; now < genesis+10; now++ {
+		round, unixTime := NextRound(now, period, genesis)
+		roundAt, timeAt := NextRoundAt(time.Unix(now, 0), period, genesis)
+		require.Equal(t, round, roundAt)
+		require.Equal(t, time.Unix(unixTime, 0), timeAt)
+		require.Equal(t, CurrentRound(now, period, genesis), CurrentRoundAt(time.Unix(now, 0), period, genesis))
+		require.Equal(t, time.Unix(TimeOfRound(period, genesis, round), 0), RoundTime(period, genesis, round))
+	}
+}
@@ -2,10 +2,12 @@ package chain
 
 import (
 	"crypto/sha256"
+	"errors"
 
 	"github.com/drand/drand/common/scheme"
 	"github.com/drand/drand/key"
 	"github.com/drand/kyber"
+	"github.com/drand/kyber/util/random"
 )
 
 // Verifier allows verifying the beacons signature based on a scheme.
@@ -43,6 +45,44 @@ func (v Verifier) VerifyBeacon(b Beacon, pubkey kyber.Point) error {
 	return key.Scheme.VerifyRecovered(pubkey, msg, b.Signature)
 }
 
+// VerifyBeacons returns an error if any of the given beacons does not verify
+// given the public key. The beacons are verified at once, with two pairings
+// whatever their number: the signatures and the hashed messages are combined
+// with random coefficients, so that invalid signatures cannot cancel out. It
+// doesn't tell which beacons are invalid.
+func (v Verifier) VerifyBeacons(bs []Beacon, pubkey kyber.Point) error {
+	switch len(bs) {
+	case 0:
+		return nil
+	case 1:
+		return v.VerifyBeacon(bs[0], pubkey)
+	}
+
+	hashable, ok := key.SigGroup.Point().(interface {
+		Hash([]byte) kyber.Point
+	})
+	if !ok {
+		return errors.New("signature group point needs to implement hashing")
+	}
+	rnd := random.New()
+	sigs := key.SigGroup.Point().Null()
+	msgs := key.SigGroup.Point().Null()
+	for _, b := range bs {
+		sig := key.SigGroup.Point()
+		if err := sig.UnmarshalBinary(b.Signature); err != nil {
+			return err
+		}
+		r := key.SigGroup.Scalar().Pick(rnd)
+		sigs.Add(sigs, sig.Mul(r, sig))
+		hm := hashable.Hash(v.DigestMessage(b.Round, b.PreviousSig))
+		msgs.Add(msgs, hm.Mul(r, hm))
+	}
+	if !key.Pairing.ValidatePairing(pubkey, msgs, key.KeyGroup.Point().Base(), sigs) {
+		return errors.New("invalid batch of beacons")
+	}
+	return nil
+}
+
 func (v Verifier) IsPrevSigMeaningful() bool {
 	return !v.scheme.DecouplePrevSig
 }
@@ -0,0 +1,47 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/common/scheme"
+	"github.com/drand/drand/key"
+	"github.com/drand/kyber/util/random"
+)
+
+func TestVerifyBeacons(t *testing.T) {
+	secret := key.KeyGroup.Scalar().Pick(random.New())
+	public := key.KeyGroup.Point().Mul(secret, nil)
+	verifier := NewVerifier(scheme.GetSchemeFromEnv())
+
+	var bs []Beacon
+	prevSig := []byte("genesis")
+	for round := uint64(1); round <= 5; round++ {
+		sig, err := key.AuthScheme.Sign(secret, verifier.DigestMessage(round, prevSig))
+		require.NoError(t, err)
+		bs = append(bs, Beacon{Round: round, Signature: sig, PreviousSig: prevSig})
+		prevSig = sig
+	}
+
+	require.NoError(t, verifier.VerifyBeacons(nil, public))
+	require.NoError(t, verifier.VerifyBeacons(bs[:1], public))
+	require.NoError(t, verifier.VerifyBeacons(bs, public))
+
+	// a signature of another round invalidates the batch
+	invalid := append([]Beacon{}, bs...)
+	invalid[2].Signature = bs[3].Signature
+	require.Error(t, verifier.VerifyBeacons(invalid, public))
+
+	// as well as a malformed one
+	invalid[2].Signature = []byte("not a signature")
+	require.Error(t, verifier.VerifyBeacons(invalid, public))
+
+	// signatures swapped between rounds don't cancel out
+	swapped := append([]Beacon{}, bs...)
+	swapped[1].Signature, swapped[2].Signature = bs[2].Signature, bs[1].Signature
+	require.Error(t, verifier.VerifyBeacons(swapped, public))
+
+	other := key.KeyGroup.Point().Pick(random.New())
+	require.Error(t, verifier.VerifyBeacons(bs, other))
+}
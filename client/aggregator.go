@@ -71,6 +71,11 @@ func (c *watchAggregator) SetLog(l log.Logger) {
 	c.log = l
 }
 
+// Sources returns the status of the upstream sources of the wrapped client.
+func (c *watchAggregator) Sources() []SourceStatus {
+	return trySources(c.Client)
+}
+
 // String returns the name of this client.
 func (c *watchAggregator) String() string {
 	return fmt.Sprintf("%s.(+aggregator)", c.Client)
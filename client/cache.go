@@ -81,6 +81,11 @@ func (c *cachingClient) SetLog(l log.Logger) {
 	c.log = l
 }
 
+// Sources returns the status of the upstream sources of the wrapped client.
+func (c *cachingClient) Sources() []SourceStatus {
+	return trySources(c.Client)
+}
+
 // String returns the name of this client.
 func (c *cachingClient) String() string {
 	if arc, ok := c.cache.(*typedCache); ok {
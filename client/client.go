@@ -43,6 +43,15 @@ func trySetLog(c Client, l log.Logger) {
 	}
 }
 
+// trySources returns the status of the upstream sources of c, if it is able
+// to report them.
+func trySources(c Client) []SourceStatus {
+	if hc, ok := c.(HealthReportingClient); ok {
+		return hc.Sources()
+	}
+	return nil
+}
+
 // makeClient creates a client from a configuration.
 func makeClient(cfg *clientConfig) (Client, error) {
 	if !cfg.insecure && cfg.chainHash == nil && cfg.chainInfo == nil {
@@ -30,7 +30,7 @@ func (m *emptyClient) Info(ctx context.Context) (*chain.Info, error) {
 }
 
 func (m *emptyClient) RoundAt(t time.Time) uint64 {
-	return chain.CurrentRound(t.Unix(), m.i.Period, m.i.GenesisTime)
+	return chain.CurrentRoundAt(t, m.i.Period, m.i.GenesisTime)
 }
 
 func (m *emptyClient) Get(ctx context.Context, round uint64) (Result, error) {
@@ -9,8 +9,10 @@ import (
 
 	grpcProm "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	grpcInsec "google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 
 	"github.com/drand/drand/chain"
 	"github.com/drand/drand/client"
@@ -72,6 +74,14 @@ func (g *grpcClient) String() string {
 // Get returns a the randomness at `round` or an error.
 func (g *grpcClient) Get(ctx context.Context, round uint64) (client.Result, error) {
 	curr, err := g.client.PublicRand(ctx, &drand.PublicRandRequest{Round: round, Metadata: g.getMetadata()})
+	if status.Code(err) == codes.OutOfRange {
+		// the round is after the end of a retired chain
+		if info, ierr := g.Info(ctx); ierr == nil {
+			if cerr := info.CheckRound(round); cerr != nil {
+				return nil, cerr
+			}
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -111,7 +121,9 @@ func (g *grpcClient) translate(stream drand.Public_PublicRandStreamClient, out c
 	for {
 		next, err := stream.Recv()
 		if err != nil || stream.Context().Err() != nil {
-			if stream.Context().Err() == nil {
+			if status.Code(err) == codes.OutOfRange {
+				g.l.Infow("", "grpc_client", "public rand stream", "status", status.Convert(err).Message())
+			} else if stream.Context().Err() == nil {
 				g.l.Warnw("", "grpc_client", "public rand stream", "err", err)
 			}
 			return
@@ -128,11 +140,11 @@ func (g *grpcClient) RoundAt(t time.Time) uint64 {
 	ctx, cancel := context.WithTimeout(context.Background(), grpcDefaultTimeout)
 	defer cancel()
 
-	info, err := g.client.ChainInfo(ctx, &drand.ChainInfoRequest{Metadata: g.getMetadata()})
+	info, err := g.Info(ctx)
 	if err != nil {
 		return 0
 	}
-	return chain.CurrentRound(t.Unix(), time.Second*time.Duration(info.Period), info.GenesisTime)
+	return info.RoundAt(t)
 }
 
 // SetLog configures the client log output
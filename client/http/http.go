@@ -141,6 +141,37 @@ func Ping(ctx context.Context, root string) error {
 	return nil
 }
 
+// ChainHashes returns the hashes of the chains served by the drand HTTP
+// endpoint at root.
+func ChainHashes(ctx context.Context, root string) ([]string, error) {
+	url := fmt.Sprintf("%s/chains", strings.TrimSuffix(root, "/"))
+
+	ctx, cancel := context.WithTimeout(ctx, maxTimeoutHTTPRequest)
+	defer cancel()
+
+	req, err := nhttp.NewRequestWithContext(ctx, nhttp.MethodGet, url, nhttp.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+
+	response, err := nhttp.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("doing request: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != nhttp.StatusOK {
+		return nil, fmt.Errorf("unexpected status from %s: %d", url, response.StatusCode)
+	}
+
+	var chainHashes []string
+	if err := json.NewDecoder(response.Body).Decode(&chainHashes); err != nil {
+		return nil, fmt.Errorf("decoding response: %w", err)
+	}
+
+	return chainHashes, nil
+}
+
 // Instruments an HTTP client around a transport
 func instrumentClient(url string, transport nhttp.RoundTripper) *nhttp.Client {
 	hc := nhttp.Client{}
@@ -235,7 +266,12 @@ func (h *httpClient) FetchChainInfo(ctx context.Context, chainHash []byte) (*cha
 	if h.chainInfo != nil {
 		return h.chainInfo, nil
 	}
+	return h.fetchChainInfo(ctx, chainHash)
+}
 
+// fetchChainInfo requests the chain info from the relay, ignoring the one
+// known by the client.
+func (h *httpClient) fetchChainInfo(ctx context.Context, chainHash []byte) (*chain.Info, error) {
 	resC := make(chan httpInfoResponse, 1)
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
@@ -332,6 +368,16 @@ func (h *httpClient) Get(ctx context.Context, round uint64) (client.Result, erro
 		}
 		defer randResponse.Body.Close()
 
+		if randResponse.StatusCode != nhttp.StatusOK {
+			// a retired chain has no round after its last one
+			if info, err := h.fetchChainInfo(ctx, h.chainInfo.Hash()); err == nil {
+				if err := info.CheckRound(round); err != nil {
+					resC <- httpGetResponse{nil, err}
+					return
+				}
+			}
+		}
+
 		randResp := client.RandomData{}
 		if err := json.NewDecoder(randResponse.Body).Decode(&randResp); err != nil {
 			resC <- httpGetResponse{nil, fmt.Errorf("decoding response: %w", err)}
@@ -389,7 +435,7 @@ func (h *httpClient) Info(ctx context.Context) (*chain.Info, error) {
 // RoundAt will return the most recent round of randomness that will be available
 // at time for the current client.
 func (h *httpClient) RoundAt(t time.Time) uint64 {
-	return chain.CurrentRound(t.Unix(), h.chainInfo.Period, h.chainInfo.GenesisTime)
+	return h.chainInfo.RoundAt(t)
 }
 
 func (h *httpClient) Close() error {
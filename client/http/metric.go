@@ -57,7 +57,7 @@ func (c *HealthMetrics) startObserve(ctx context.Context) {
 		}
 		// compute the latency metric
 		actual := time.Now().UnixNano()
-		expected := chain.TimeOfRound(httpClient.chainInfo.Period, httpClient.chainInfo.GenesisTime, result.Round()) * 1e9
+		expected := chain.RoundTime(httpClient.chainInfo.Period, httpClient.chainInfo.GenesisTime, result.Round()).UnixNano()
 		// the labels of the gauge vec must already be set at the registerer level
 		metrics.ClientHTTPHeartbeatLatency.With(prometheus.Labels{"http_address": httpClient.root}).
 			Set(float64(actual-expected) / float64(time.Millisecond))
@@ -44,3 +44,29 @@ type Result interface {
 type LoggingClient interface {
 	SetLog(log.Logger)
 }
+
+// SourceStatus is a point-in-time view of one upstream source of randomness
+// used by a client.
+type SourceStatus struct {
+	// Name is the name of the source, as given by its String method.
+	Name string
+	// Passive indicates the source only pushes results (e.g. gossip) and is
+	// not raced when calling Get.
+	Passive bool
+	// RTT is the latest round trip time measured for the source, or zero if
+	// it is unknown.
+	RTT time.Duration
+	// LastSuccess is the last time the source returned a result.
+	LastSuccess time.Time
+	// LastError is the last error returned by the source, if any.
+	LastError string
+	// VerificationFailures counts the results from this source that failed
+	// verification.
+	VerificationFailures uint64
+}
+
+// HealthReportingClient reports the status of the upstream sources for clients
+// that support it
+type HealthReportingClient interface {
+	Sources() []SourceStatus
+}
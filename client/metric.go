@@ -35,7 +35,7 @@ func (c *watchLatencyMetricClient) startObserve(ctx context.Context) {
 			}
 			// compute the latency metric
 			actual := time.Now().UnixNano()
-			expected := chain.TimeOfRound(c.chainInfo.Period, c.chainInfo.GenesisTime, result.Round()) * 1e9
+			expected := chain.RoundTime(c.chainInfo.Period, c.chainInfo.GenesisTime, result.Round()).UnixNano()
 			// the labels of the gauge vec must already be set at the registerer level
 			metrics.ClientWatchLatency.Set(float64(actual-expected) / float64(time.Millisecond))
 		case <-ctx.Done():
@@ -44,6 +44,11 @@ func (c *watchLatencyMetricClient) startObserve(ctx context.Context) {
 	}
 }
 
+// Sources returns the status of the upstream sources of the wrapped client.
+func (c *watchLatencyMetricClient) Sources() []SourceStatus {
+	return trySources(c.Client)
+}
+
 func (c *watchLatencyMetricClient) Close() error {
 	err := c.Client.Close()
 	c.cancel()
@@ -44,6 +44,15 @@ type optimizingClient struct {
 	watchRetryInterval time.Duration
 	log                log.Logger
 	done               chan struct{}
+	// health keeps the outcome of the latest requests made to each client.
+	health map[Client]*sourceHealth
+}
+
+type sourceHealth struct {
+	// lastSuccess is the last time the client returned a result.
+	lastSuccess time.Time
+	// lastErr is the error returned by the latest failed request, if any.
+	lastErr error
 }
 
 // newOptimizingClient creates a drand client that measures the speed of clients
@@ -101,6 +110,7 @@ func newOptimizingClient(
 		watchRetryInterval: watchRetryInterval,
 		log:                log.DefaultLogger(),
 		done:               done,
+		health:             make(map[Client]*sourceHealth),
 	}
 	return oc, nil
 }
@@ -193,6 +203,7 @@ func (oc *optimizingClient) testSpeed() {
 				if rr.err != nil {
 					oc.log.Infow("", "optimizing_client", "endpoint down when speed tested", "client", fmt.Sprintf("%s", rr.client), "err", rr.err)
 				}
+				oc.recordOutcome(rr.client, rr.err)
 				stats = append(stats, rr.stat)
 			case <-oc.done:
 				cancel()
@@ -244,6 +255,7 @@ LOOP:
 				break LOOP
 			}
 			stats = append(stats, rr.stat)
+			oc.recordOutcome(rr.client, rr.err)
 			res = rr.result
 			if rr.err != nil && !errors.Is(rr.err, errEmptyClientUnsupportedGet) {
 				err = fmt.Errorf("%v - %w", err, rr.err)
@@ -371,6 +383,57 @@ func (oc *optimizingClient) updateStats(stats []*requestStat) {
 	})
 }
 
+// recordOutcome keeps track of the result of a request made to a client, so
+// that it can be reported by Sources.
+func (oc *optimizingClient) recordOutcome(c Client, err error) {
+	if errors.Is(err, errEmptyClientUnsupportedGet) {
+		return
+	}
+	oc.Lock()
+	defer oc.Unlock()
+
+	h, ok := oc.health[c]
+	if !ok {
+		h = &sourceHealth{}
+		oc.health[c] = h
+	}
+	if err != nil {
+		h.lastErr = err
+		return
+	}
+	h.lastSuccess = time.Now()
+}
+
+// Sources returns the status of each of the clients used by the optimizing
+// client, fastest first.
+func (oc *optimizingClient) Sources() []SourceStatus {
+	oc.RLock()
+	defer oc.RUnlock()
+
+	sources := make([]SourceStatus, 0, len(oc.stats))
+	for _, s := range oc.stats {
+		status := SourceStatus{
+			Name:    fmt.Sprint(s.client),
+			Passive: oc.markedPassive(s.client),
+		}
+		// failing and passive clients are pushed back with a maximal RTT
+		if s.rtt != math.MaxInt64 {
+			status.RTT = s.rtt
+		}
+		if h, ok := oc.health[s.client]; ok {
+			status.LastSuccess = h.lastSuccess
+			if h.lastErr != nil {
+				status.LastError = h.lastErr.Error()
+			}
+		}
+		if v, ok := s.client.(*verifyingClient); ok {
+			status.VerificationFailures = v.verificationFailures()
+		}
+		sources = append(sources, status)
+	}
+	return sources
+}
+
 type watchResult struct {
 	Result
 	Client
@@ -382,13 +445,14 @@ func (oc *optimizingClient) trackWatchResults(info *chain.Info, in chan watchRes
 	latest := uint64(0)
 	for r := range in {
 		round := r.Result.Round()
-		timeOfRound := time.Unix(chain.TimeOfRound(info.Period, info.GenesisTime, round), 0)
+		timeOfRound := chain.RoundTime(info.Period, info.GenesisTime, round)
 		stat := requestStat{
 			client:    r.Client,
 			rtt:       time.Since(timeOfRound),
 			startTime: timeOfRound,
 		}
 		oc.updateStats([]*requestStat{&stat})
+		oc.recordOutcome(r.Client, nil)
 		if round > latest {
 			latest = round
 			out <- r.Result
@@ -301,3 +301,39 @@ func TestOptimizingClose(t *testing.T) {
 
 	wg.Wait() // wait for underlying clients to close
 }
+
+func TestOptimizingSources(t *testing.T) {
+	c0 := MockClientWithResults(0, 1)
+	c1 := &MockClient{}
+
+	oc, err := newOptimizingClient([]Client{c0, c1}, time.Second*5, 2, -1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	oc.MarkPassive(c1)
+	defer closeClient(t, oc)
+
+	if _, err := oc.Get(context.Background(), 0); err != nil {
+		t.Fatal(err)
+	}
+	// c0 has no results left, the request falls back to c1 which fails too
+	if _, err := oc.Get(context.Background(), 0); err == nil {
+		t.Fatal("expected error from mock clients without results")
+	}
+
+	sources := oc.Sources()
+	if len(sources) != 2 {
+		t.Fatalf("expected 2 sources, got %d", len(sources))
+	}
+	for _, s := range sources {
+		if s.LastError == "" {
+			t.Fatalf("expected source %s to report its last error", s.Name)
+		}
+		if s.Passive && s.RTT != 0 {
+			t.Fatal("passive sources should not report an RTT")
+		}
+	}
+	if sources[0].LastSuccess.IsZero() && sources[1].LastSuccess.IsZero() {
+		t.Fatal("expected a source to report a successful request")
+	}
+}
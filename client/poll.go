@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/drand/drand/chain"
@@ -12,6 +13,10 @@ import (
 // by asking for them once each group period.
 func PollingWatcher(ctx context.Context, c Client, chainInfo *chain.Info, l log.Logger) <-chan Result {
 	ch := make(chan Result, 1)
+	// the last round of a retired chain is the last result
+	ended := func(r Result) bool {
+		return chainInfo.Retired() && r.Round() >= chainInfo.EndRound
+	}
 	r := c.RoundAt(time.Now())
 	val, err := c.Get(ctx, r)
 	if err != nil {
@@ -20,21 +25,31 @@ func PollingWatcher(ctx context.Context, c Client, chainInfo *chain.Info, l log.
 		return ch
 	}
 	ch <- val
+	if ended(val) {
+		close(ch)
+		return ch
+	}
 
 	go func() {
 		defer close(ch)
 
 		// Initially, wait to synchronize to the round boundary.
-		_, nextTime := chain.NextRound(time.Now().Unix(), chainInfo.Period, chainInfo.GenesisTime)
+		_, nextTime := chain.NextRoundAt(time.Now(), chainInfo.Period, chainInfo.GenesisTime)
 		select {
 		case <-ctx.Done():
 			return
-		case <-time.After(time.Duration(nextTime-time.Now().Unix()) * time.Second):
+		case <-time.After(time.Until(nextTime)):
 		}
 
 		r, err := c.Get(ctx, c.RoundAt(time.Now()))
-		if err == nil {
+		if errors.Is(err, chain.ErrChainEnded) {
+			l.Infow("", "polling_client", "chain ended", "from", c, "err", err)
+			return
+		} else if err == nil {
 			ch <- r
+			if ended(r) {
+				return
+			}
 		} else {
 			l.Errorw("", "polling_client", "failed first async get", "from", c, "err", err)
 		}
@@ -46,8 +61,14 @@ func PollingWatcher(ctx context.Context, c Client, chainInfo *chain.Info, l log.
 			select {
 			case <-t.C:
 				r, err := c.Get(ctx, c.RoundAt(time.Now()))
-				if err == nil {
+				if errors.Is(err, chain.ErrChainEnded) {
+					l.Infow("", "polling_client", "chain ended", "from", c, "err", err)
+					return
+				} else if err == nil {
 					ch <- r
+					if ended(r) {
+						return
+					}
 				} else {
 					l.Errorw("", "polling_client", "failed subsequent watch poll", "from", c, "err", err)
 				}
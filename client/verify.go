@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 
 	"github.com/drand/drand/chain"
 	"github.com/drand/drand/common/scheme"
@@ -44,6 +45,10 @@ type verifyingClient struct {
 
 	verifier *chain.Verifier
 	log      log.Logger
+
+	// failures counts the results of the wrapped client which failed
+	// verification.
+	failures uint64
 }
 
 // SetLog configures the client log output.
@@ -63,6 +68,7 @@ func (v *verifyingClient) Get(ctx context.Context, round uint64) (Result, error)
 	}
 	rd := asRandomData(r)
 	if err := v.verify(ctx, info, rd); err != nil {
+		atomic.AddUint64(&v.failures, 1)
 		return nil, err
 	}
 	return rd, nil
@@ -84,6 +90,7 @@ func (v *verifyingClient) Watch(ctx context.Context) <-chan Result {
 		defer close(outCh)
 		for r := range inCh {
 			if err := v.verify(ctx, info, asRandomData(r)); err != nil {
+				atomic.AddUint64(&v.failures, 1)
 				v.log.Warnw("", "verifying_client", "skipping invalid watch round", "round", r.Round(), "err", err)
 				continue
 			}
@@ -207,6 +214,12 @@ func (v *verifyingClient) verify(ctx context.Context, info *chain.Info, r *Rando
 	return nil
 }
 
+// verificationFailures returns how many results of the wrapped client failed
+// verification so far.
+func (v *verifyingClient) verificationFailures() uint64 {
+	return atomic.LoadUint64(&v.failures)
+}
+
 // String returns the name of this client.
 func (v *verifyingClient) String() string {
 	return fmt.Sprintf("%s.(+verifier)", v.Client)
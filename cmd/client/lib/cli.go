@@ -15,6 +15,7 @@ import (
 	"github.com/google/uuid"
 	bds "github.com/ipfs/go-ds-badger2"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/host"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/urfave/cli/v2"
 
@@ -84,6 +85,26 @@ var (
 		Usage: "Local (host:)port for constructed libp2p host to listen on",
 	}
 
+	// GossipDHTFlag is the CLI flag enabling the discovery of the gossip peers
+	// of the chain on the DHT bootstrapped from the relays.
+	GossipDHTFlag = &cli.BoolFlag{
+		Name:  "gossip-dht",
+		Usage: "Discover the gossip peers of the chain on the DHT bootstrapped from the relay peers",
+	}
+	// GossipMDNSFlag is the CLI flag enabling the discovery of the gossip
+	// peers on the local network.
+	GossipMDNSFlag = &cli.BoolFlag{
+		Name:  "gossip-mdns",
+		Usage: "Discover the gossip peers on the local network over mDNS",
+	}
+	// GossipLegacyTopicFlag is the CLI flag subscribing to the legacy topic of
+	// the chain, carrying the bare randomness, in addition to its versioned one.
+	GossipLegacyTopicFlag = &cli.BoolFlag{
+		Name:  "gossip-legacy-topic",
+		Usage: "Subscribe to the legacy gossip topic of the chain too, on which the randomness isn't wrapped in envelopes",
+		Value: true,
+	}
+
 	// JSONFlag is the value of the CLI flag `json` enabling JSON output of the loggers
 	JSONFlag = &cli.BoolFlag{
 		Name:  "json",
@@ -101,12 +122,22 @@ var ClientFlags = []cli.Flag{
 	InsecureFlag,
 	RelayFlag,
 	PortFlag,
+	GossipDHTFlag,
+	GossipMDNSFlag,
+	GossipLegacyTopicFlag,
 	JSONFlag,
 }
 
 // Create builds a client, and can be invoked from a cli action supplied
 // with ClientFlags
 func Create(c *cli.Context, withInstrumentation bool, opts ...client.Option) (client.Client, error) {
+	return CreateWithHash(c, c.String(HashFlag.Name), withInstrumentation, opts...)
+}
+
+// CreateWithHash builds a client following the chain with the given hash (in
+// hex), ignoring the value of HashFlag. An empty hash follows the default chain.
+// It can be invoked from a cli action supplied with ClientFlags.
+func CreateWithHash(c *cli.Context, chainHash string, withInstrumentation bool, opts ...client.Option) (client.Client, error) {
 	clients := make([]client.Client, 0)
 	var info *chain.Info
 	var err error
@@ -122,22 +153,25 @@ func Create(c *cli.Context, withInstrumentation bool, opts ...client.Option) (cl
 		opts = append(opts, client.WithChainInfo(info))
 	}
 
-	gc, err := buildGrpcClient(c, &info)
+	var hash []byte
+	if chainHash != "" {
+		hash, err = hex.DecodeString(chainHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	gc, err := buildGrpcClient(c, &info, hash)
 	if err != nil {
 		return nil, err
 	}
 	clients = append(clients, gc...)
 
-	var hash []byte
-	if c.IsSet(HashFlag.Name) && c.String(HashFlag.Name) != "" {
-		hash, err = hex.DecodeString(c.String(HashFlag.Name))
-		if err != nil {
-			return nil, err
-		}
+	if len(hash) > 0 {
 		if info != nil && !bytes.Equal(hash, info.Hash()) {
 			return nil, fmt.Errorf(
 				"%w %v != %v", commonutils.ErrInvalidChainHash,
-				c.String(HashFlag.Name),
+				chainHash,
 				hex.EncodeToString(info.Hash()),
 			)
 		}
@@ -150,7 +184,7 @@ func Create(c *cli.Context, withInstrumentation bool, opts ...client.Option) (cl
 
 	clients = append(clients, buildHTTPClients(c, &info, hash, withInstrumentation)...)
 
-	gopt, err := buildGossipClient(c)
+	gopt, err := buildGossipClient(c, info, hash)
 	if err != nil {
 		return nil, err
 	}
@@ -159,17 +193,10 @@ func Create(c *cli.Context, withInstrumentation bool, opts ...client.Option) (cl
 	return client.Wrap(clients, opts...)
 }
 
-func buildGrpcClient(c *cli.Context, info **chain.Info) ([]client.Client, error) {
+func buildGrpcClient(c *cli.Context, info **chain.Info, hash []byte) ([]client.Client, error) {
 	if c.IsSet(GRPCConnectFlag.Name) {
-		hash := make([]byte, 0)
-
-		if c.IsSet(HashFlag.Name) {
-			var err error
-
-			hash, err = hex.DecodeString(c.String(HashFlag.Name))
-			if err != nil {
-				return nil, err
-			}
+		if hash == nil {
+			hash = make([]byte, 0)
 		}
 
 		if *info != nil && len(hash) == 0 {
@@ -236,54 +263,67 @@ func buildHTTPClients(c *cli.Context, info **chain.Info, hash []byte, withInstru
 	return clients
 }
 
-func buildGossipClient(c *cli.Context) ([]client.Option, error) {
-	if c.IsSet(RelayFlag.Name) {
-		addrs := c.StringSlice(RelayFlag.Name)
-		if len(addrs) > 0 {
-			relayPeers, err := lp2p.ParseMultiaddrSlice(addrs)
-			if err != nil {
-				return nil, err
-			}
-			listen := ""
-			if c.IsSet(PortFlag.Name) {
-				listen = c.String(PortFlag.Name)
-			}
-			ps, err := buildClientHost(listen, relayPeers)
-			if err != nil {
-				return nil, err
-			}
-			return []client.Option{gclient.WithPubsub(ps)}, nil
-		}
+func buildGossipClient(c *cli.Context, info *chain.Info, hash []byte) ([]client.Option, error) {
+	addrs := c.StringSlice(RelayFlag.Name)
+	discovery := lp2p.DiscoveryConfig{
+		DHT:  c.Bool(GossipDHTFlag.Name),
+		MDNS: c.Bool(GossipMDNSFlag.Name),
 	}
-	return []client.Option{}, nil
+	if len(addrs) == 0 && !discovery.MDNS {
+		return []client.Option{}, nil
+	}
+
+	relayPeers, err := lp2p.ParseMultiaddrSlice(addrs)
+	if err != nil {
+		return nil, err
+	}
+	listen := ""
+	if c.IsSet(PortFlag.Name) {
+		listen = c.String(PortFlag.Name)
+	}
+	if len(hash) == 0 && info != nil {
+		hash = info.Hash()
+	}
+	h, ps, err := buildClientHost(listen, relayPeers, discovery, hash)
+	if err != nil {
+		return nil, err
+	}
+	opts := []gclient.Option{gclient.WithHistory(h)}
+	if !c.Bool(GossipLegacyTopicFlag.Name) {
+		opts = append(opts, gclient.WithoutLegacyTopic())
+	}
+	return []client.Option{gclient.WithPubsub(ps, opts...)}, nil
 }
 
-func buildClientHost(clientListenAddr string, relayMultiaddr []ma.Multiaddr) (*pubsub.PubSub, error) {
+// buildClientHost builds the libp2p host of a gossip client, connected to the
+// relays and discovering the peers of the chain with the given hash if enabled.
+func buildClientHost(clientListenAddr string, relayMultiaddr []ma.Multiaddr,
+	discovery lp2p.DiscoveryConfig, chainHash []byte) (host.Host, *pubsub.PubSub, error) {
 	clientID := uuid.New().String()
 	ds, err := bds.NewDatastore(path.Join(os.TempDir(), "drand-"+clientID+"-datastore"), nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	priv, err := lp2p.LoadOrCreatePrivKey(path.Join(os.TempDir(), "drand-"+clientID+"-id"), log.DefaultLogger())
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	listen := ""
 	if clientListenAddr != "" {
 		bindHost := "0.0.0.0"
 		if strings.Contains(clientListenAddr, ":") {
-			host, port, err := net.SplitHostPort(clientListenAddr)
+			bindAddr, port, err := net.SplitHostPort(clientListenAddr)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
-			bindHost = host
+			bindHost = bindAddr
 			clientListenAddr = port
 		}
 		listen = fmt.Sprintf("/ip4/%s/tcp/%s", bindHost, clientListenAddr)
 	}
 
-	_, ps, err := lp2p.ConstructHost(
+	h, ps, err := lp2p.ConstructHost(
 		ds,
 		priv,
 		listen,
@@ -291,9 +331,21 @@ func buildClientHost(clientListenAddr string, relayMultiaddr []ma.Multiaddr) (*p
 		log.DefaultLogger(),
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	if discovery.DHT || discovery.MDNS {
+		disc, err := lp2p.NewDiscovery(ds, h, relayMultiaddr, discovery, log.DefaultLogger())
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(chainHash) > 0 {
+			disc.Join(lp2p.PubSubTopic(hex.EncodeToString(chainHash)))
+		} else if discovery.DHT {
+			log.DefaultLogger().Warnw("", "client", "unknown chain hash, gossip peers won't be discovered on the DHT")
+		}
 	}
-	return ps, nil
+	return h, ps, nil
 }
 
 // chainInfoFromGroupTOML reads a drand group TOML file and returns the chain info.
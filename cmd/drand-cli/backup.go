@@ -0,0 +1,167 @@
+package drand
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/common"
+	"github.com/drand/drand/fs"
+	"github.com/drand/drand/key"
+)
+
+// keyBackupCmd saves the keys, the group and the share of a beacon in an
+// encrypted backup file, optionally split into shards.
+func keyBackupCmd(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return errors.New("drand: key-backup expects the path of the backup file")
+	}
+	file := c.Args().First()
+	if exists, err := fs.Exists(file); err != nil {
+		return err
+	} else if exists {
+		return fmt.Errorf("drand: %s already exists", file)
+	}
+
+	beaconID := getBeaconID(c)
+	store := contextToConfig(c).KeyStore(beaconID)
+	pair, err := store.LoadKeyPair()
+	if err != nil {
+		return fmt.Errorf("beacon id [%s] - could not load key pair: %w", beaconID, err)
+	}
+	archived, err := store.LoadArchivedKeyPairs()
+	if err != nil {
+		return fmt.Errorf("beacon id [%s] - could not load archived keys: %w", beaconID, err)
+	}
+	backup := &key.Backup{Pair: pair, Archived: archived}
+
+	group, err := store.LoadGroup()
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		// no DKG ran yet, only the keys are saved
+	case err != nil:
+		return fmt.Errorf("beacon id [%s] - could not load group: %w", beaconID, err)
+	default:
+		share, err := store.LoadShare()
+		if err != nil {
+			return fmt.Errorf("beacon id [%s] - could not load share: %w", beaconID, err)
+		}
+		backup.Group = group
+		backup.Share = share
+	}
+	if err := backup.Validate(); err != nil {
+		return fmt.Errorf("beacon id [%s] - %w", beaconID, err)
+	}
+	if backup.Group != nil {
+		backup.ChainHash = chain.NewChainInfo(backup.Group).HashString()
+	}
+
+	if c.IsSet(splitFlag.Name) {
+		var threshold, n int
+		if _, err := fmt.Sscanf(c.String(splitFlag.Name), "%d-of-%d", &threshold, &n); err != nil {
+			return fmt.Errorf("invalid --%s, expected m-of-n: %w", splitFlag.Name, err)
+		}
+		shards, err := key.SaveSplitBackup(file, backup, threshold, n)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(output, "Backup of beacon id [%s] saved at %s.\n", beaconID, file)
+		fmt.Fprintf(output, "Any %d of the following %d shards restore it, keep them apart:\n", threshold, n)
+		for i, shard := range shards {
+			fmt.Fprintf(output, "  shard %d: %s\n", i+1, shard)
+		}
+		return nil
+	}
+
+	passphrase, err := getBackupPassphrase(c)
+	if err != nil {
+		return err
+	}
+	if err := key.SaveBackup(file, backup, passphrase); err != nil {
+		return err
+	}
+	fmt.Fprintf(output, "Backup of beacon id [%s] saved at %s.\n", beaconID, file)
+	return nil
+}
+
+// keyRestoreCmd writes the content of a backup file into the key store, once
+// the share it holds is validated against the distributed key of the group.
+func keyRestoreCmd(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return errors.New("drand: key-restore expects the path of the backup file")
+	}
+	file := c.Args().First()
+
+	var backup *key.Backup
+	var err error
+	if c.IsSet(shardsFlag.Name) {
+		backup, err = key.LoadSplitBackup(file, strings.Split(c.String(shardsFlag.Name), ","))
+	} else {
+		var passphrase []byte
+		if passphrase, err = getBackupPassphrase(c); err != nil {
+			return err
+		}
+		backup, err = key.LoadBackup(file, passphrase)
+	}
+	if err != nil {
+		return fmt.Errorf("drand: can't restore %s: %w", file, err)
+	}
+
+	beaconID := getBeaconID(c)
+	if backup.Group != nil {
+		if id := common.GetCanonicalBeaconID(backup.Group.ID); id != beaconID {
+			return fmt.Errorf("drand: the backup is of beacon id [%s], not [%s]", id, beaconID)
+		}
+		if hash := chain.NewChainInfo(backup.Group).HashString(); hash != backup.ChainHash {
+			return fmt.Errorf("drand: the group of the backup has chain hash %s, not %s", hash, backup.ChainHash)
+		}
+	}
+
+	store := contextToConfig(c).KeyStore(beaconID)
+	// as with generate-keypair, existing keys are never overwritten
+	_, err = store.LoadKeyPair()
+	if err == nil || errors.Is(err, key.ErrEncryptedKey) || errors.Is(err, key.ErrWrongPassphrase) {
+		return fmt.Errorf("beacon id [%s] - keypair already present, remove it before restoring a backup", beaconID)
+	}
+	if err := store.SaveKeyPair(backup.Pair); err != nil {
+		return fmt.Errorf("could not save key: %w", err)
+	}
+	for _, p := range backup.Archived {
+		if err := store.ArchiveKeyPair(p); err != nil {
+			return fmt.Errorf("could not save archived key: %w", err)
+		}
+	}
+	if backup.Group != nil {
+		if err := store.SaveGroup(backup.Group); err != nil {
+			return fmt.Errorf("could not save group: %w", err)
+		}
+		if err := store.SaveShare(backup.Share); err != nil {
+			return fmt.Errorf("could not save share: %w", err)
+		}
+		fmt.Fprintf(output, "Restored the keys and share %d of beacon id [%s], chain hash %s\n",
+			backup.Share.Share.I, beaconID, backup.ChainHash)
+		return nil
+	}
+	fmt.Fprintf(output, "Restored the keys of beacon id [%s]\n", beaconID)
+	return nil
+}
+
+func getBackupPassphrase(c *cli.Context) ([]byte, error) {
+	if !c.IsSet(backupPassphraseFileFlag.Name) {
+		return nil, fmt.Errorf("no passphrase given: use --%s", backupPassphraseFileFlag.Name)
+	}
+	content, err := os.ReadFile(c.String(backupPassphraseFileFlag.Name))
+	if err != nil {
+		return nil, fmt.Errorf("reading backup passphrase: %w", err)
+	}
+	passphrase := bytes.TrimRight(content, "\r\n")
+	if len(passphrase) == 0 {
+		return nil, errors.New("the backup passphrase file is empty")
+	}
+	return passphrase, nil
+}
@@ -15,13 +15,17 @@ import (
 	"path/filepath"
 	"regexp"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/BurntSushi/toml"
 	"github.com/urfave/cli/v2"
+	bolt "go.etcd.io/bbolt"
 
+	"github.com/drand/drand/chain"
 	"github.com/drand/drand/chain/boltdb"
 	"github.com/drand/drand/common"
 	"github.com/drand/drand/common/scheme"
@@ -33,6 +37,7 @@ import (
 	"github.com/drand/drand/net"
 	common2 "github.com/drand/drand/protobuf/common"
 	"github.com/drand/drand/protobuf/drand"
+	"github.com/drand/drand/publisher"
 )
 
 // default output of the drand operational commands
@@ -117,11 +122,52 @@ var roundFlag = &cli.IntFlag{
 		" it returns an error. If not specified, the current randomness is returned.",
 }
 
+var atRoundFlag = &cli.IntFlag{
+	Name: "at-round",
+	Usage: "Vote to end the chain at the given round instead of stopping the daemon. The chain ends once a threshold " +
+		"of nodes voted for the same round: no beacon is produced after it, and the chain is still served.",
+}
+
 var certsDirFlag = &cli.StringFlag{
 	Name:  "certs-dir",
 	Usage: "directory containing trusted certificates (PEM format). Useful for testing and self signed certificates",
 }
 
+// keyPassphraseEnv is the environment variable holding the passphrase that
+// encrypts the private key and the share, as an alternative to a file
+const keyPassphraseEnv = "DRAND_KEY_PASSPHRASE"
+
+var keyPassphraseFileFlag = &cli.StringFlag{
+	Name: "key-passphrase-file",
+	Usage: "File containing the passphrase that encrypts the long-term private key and the share of each beacon. " +
+		"Use /dev/fd/N to read it from a file descriptor. The passphrase can also be given directly with the " +
+		keyPassphraseEnv + " environment variable.",
+	EnvVars: []string{"DRAND_KEY_PASSPHRASE_FILE"},
+}
+
+var backupPassphraseFileFlag = &cli.StringFlag{
+	Name:    "backup-passphrase-file",
+	Usage:   "File containing the passphrase that encrypts the key backup. Use /dev/fd/N to read it from a file descriptor.",
+	EnvVars: []string{"DRAND_BACKUP_PASSPHRASE_FILE"},
+}
+
+var splitFlag = &cli.StringFlag{
+	Name: "split",
+	Usage: "Encrypt the key backup with a random key split into n shards, any m of which restore the backup, " +
+		"given as m-of-n, e.g. 3-of-5. The shards are printed, to be written down and kept apart.",
+}
+
+var shardsFlag = &cli.StringFlag{
+	Name:  "shards",
+	Usage: "Comma-separated list of the shards of a split key backup, at least as many as its threshold.",
+}
+
+var lastRoundsFlag = &cli.IntFlag{
+	Name:  "rounds",
+	Usage: "Number of the last rounds to show, 0 to show all the rounds the daemon keeps.",
+	Value: 10,
+}
+
 var outFlag = &cli.StringFlag{
 	Name:  "out",
 	Usage: "save the group file into a separate file instead of stdout",
@@ -129,7 +175,7 @@ var outFlag = &cli.StringFlag{
 
 var periodFlag = &cli.StringFlag{
 	Name:  "period",
-	Usage: "period to set when doing a setup",
+	Usage: "period to set when doing a setup, at the resolution of a millisecond (e.g. 30s, 500ms)",
 }
 
 var catchupPeriodFlag = &cli.StringFlag{
@@ -155,6 +201,14 @@ var transitionFlag = &cli.BoolFlag{
 		"The node will use the currently stored group as the basis for the resharing",
 }
 
+// using a simple string flag because the StringSliceFlag is not intuitive
+// see https://github.com/urfave/cli/issues/62
+var evictFlag = &cli.StringFlag{
+	Name: "evict",
+	Usage: "<ADDRESS:PORT>,<...> of the nodes of the current group to remove with a resharing, without their " +
+		"participation. The leader and all the other nodes resharing pass the same addresses",
+}
+
 var forceFlag = &cli.BoolFlag{
 	Name:    "force",
 	Aliases: []string{"f"},
@@ -200,6 +254,32 @@ var skipValidationFlag = &cli.BoolFlag{
 	Usage: "skips bls verification of beacon rounds for faster catchup.",
 }
 
+var signerFlag = &cli.StringFlag{
+	Name: "signer",
+	Usage: "Address of a signer holding the shares, which signs the partial beacons instead of the daemon: " +
+		"the path of a Unix socket prefixed by unix://, or host:port on a trusted network. See drand-signer.",
+}
+
+var overrideProtectionFlag = &cli.BoolFlag{
+	Name: "override-signing-protection",
+	Usage: "UNSAFE: sign partial beacons even when they conflict with the ones signed before, e.g. after the " +
+		"chain was deleted on purpose. Conflicts are still logged and counted in the signing_conflicts metric.",
+}
+
+var streamPartialsFlag = &cli.BoolFlag{
+	Name: "stream-partials",
+	Usage: "Send the partial beacons to each node over a long-lived stream, reopened when it breaks, instead of a " +
+		"call per partial beacon. Nodes that don't support it still receive them with calls.",
+}
+
+var rotateFlag = &cli.BoolFlag{
+	Name: "rotate",
+	Usage: "Replace the existing keypair by a new one signed off by it. The previous keypair is archived, and " +
+		"the group swaps the identities at the next resharing including this node. That node can't deal its " +
+		"share of the old group with the new key, so it can't lead the resharing, and enough nodes of the old " +
+		"group to reach its threshold must keep their key: rotate the keys of a few nodes at each resharing.",
+}
+
 var timeoutFlag = &cli.StringFlag{
 	Name:  "timeout",
 	Usage: fmt.Sprintf("Timeout to use during the DKG, in string format. Default is %s", core.DefaultDKGTimeout),
@@ -297,6 +377,41 @@ var allBeaconsFlag = &cli.BoolFlag{
 	Value: false,
 }
 
+var proposalFlag = &cli.StringFlag{
+	Name:    "proposal",
+	Aliases: []string{"leaderless"},
+	Usage: "Run the DKG or resharing of the given proposal file, signed by all its participants, without a leader. " +
+		"All participants must run this command before the start time of the proposal. New nodes of a " +
+		"resharing also pass the current group with --from",
+}
+
+var startFlag = &cli.Int64Flag{
+	Name:  "start",
+	Usage: "Unix time at which all participants start the DKG of a proposal",
+}
+
+var watchFlag = &cli.BoolFlag{
+	Name:  "watch",
+	Usage: "Keep printing the progress of the DKG at each change, until it is over",
+}
+
+var snapshotDirFlag = &cli.StringFlag{
+	Name:     "dir",
+	Usage:    "Directory to render the static snapshot into",
+	Required: true,
+}
+
+var snapshotSplitChecksumsFlag = &cli.BoolFlag{
+	Name: "split-checksums",
+	Usage: fmt.Sprintf("Split the checksums of the rounds into files of %d rounds, for chains with many rounds",
+		publisher.ChecksumsPerFile),
+}
+
+var snapshotSignFlag = &cli.BoolFlag{
+	Name:  "sign",
+	Usage: "Sign the manifest of the snapshot with the long-term key of the node for the beacon id given with --id",
+}
+
 var appCommands = []*cli.Command{
 	{
 		Name:  "start",
@@ -304,7 +419,8 @@ var appCommands = []*cli.Command{
 		Flags: toArray(folderFlag, tlsCertFlag, tlsKeyFlag,
 			insecureFlag, controlFlag, privListenFlag, pubListenFlag, metricsFlag,
 			certsDirFlag, pushFlag, verboseFlag, enablePrivateRand, oldGroupFlag,
-			skipValidationFlag, jsonFlag),
+			skipValidationFlag, jsonFlag, keyPassphraseFileFlag, signerFlag, overrideProtectionFlag,
+			streamPartialsFlag),
 		Action: func(c *cli.Context) error {
 			banner()
 			return startCmd(c)
@@ -313,8 +429,8 @@ var appCommands = []*cli.Command{
 	},
 	{
 		Name:  "stop",
-		Usage: "Stop the drand daemon.\n",
-		Flags: toArray(controlFlag, beaconIDFlag),
+		Usage: "Stop the drand daemon, or end the chain at a round with --at-round.\n",
+		Flags: toArray(controlFlag, beaconIDFlag, atRoundFlag),
 		Action: func(c *cli.Context) error {
 			banner()
 			return stopDaemon(c)
@@ -327,12 +443,54 @@ var appCommands = []*cli.Command{
 			timeoutFlag, sourceFlag, userEntropyOnlyFlag, secretFlag,
 			periodFlag, shareNodeFlag, thresholdFlag, connectFlag, outFlag,
 			leaderFlag, beaconOffset, transitionFlag, forceFlag, catchupPeriodFlag,
-			schemeFlag, beaconIDFlag),
+			schemeFlag, beaconIDFlag, proposalFlag, evictFlag),
 		Action: func(c *cli.Context) error {
 			banner()
 			return shareCmd(c)
 		},
 	},
+	{
+		Name: "dkg",
+		Usage: "Prepare a DKG run without a leader, from a proposal signed by all its participants, " +
+			"and follow the progress of DKGs.",
+		Subcommands: []*cli.Command{
+			{
+				Name: "propose",
+				Usage: "Create the proposal of a DKG between the given nodes, starting at the given time, " +
+					"or of a resharing from the group given with --from. Each participant then signs it " +
+					"with the sign command, and runs it with `drand share --proposal`.\n",
+				ArgsUsage: "<id1.toml> <id2.toml> ... are the public identities of the participants " +
+					"(the drand_id.public files of their key folders)",
+				Flags: toArray(startFlag, thresholdFlag, periodFlag, catchupPeriodFlag, schemeFlag,
+					timeoutFlag, beaconOffset, beaconIDFlag, oldGroupFlag, outFlag),
+				Action: proposeDKGCmd,
+			},
+			{
+				Name: "validate",
+				Usage: "Check a DKG proposal before running it: its parameters, the self-signatures and " +
+					"reachability of all its nodes and, for a resharing, its consistency with the " +
+					"current group given with --from. Missing signatures are reported but allowed.\n",
+				ArgsUsage: "<proposal.toml> is the proposal to check",
+				Flags:     toArray(certsDirFlag, oldGroupFlag),
+				Action:    validateDKGCmd,
+			},
+			{
+				Name:      "sign",
+				Usage:     "Sign a DKG proposal with the long-term key of this node. The signature is added to the file.\n",
+				ArgsUsage: "<proposal.toml> is the proposal to sign",
+				Flags:     toArray(folderFlag, beaconIDFlag, outFlag, keyPassphraseFileFlag),
+				Action:    signDKGCmd,
+				Before:    checkArgs,
+			},
+			{
+				Name: "status",
+				Usage: "Show the progress of the DKG or resharing this node runs, or of the last one: its phase, " +
+					"the packets received from each node, the complaints and, once over, the qualified nodes.\n",
+				Flags:  toArray(controlFlag, beaconIDFlag, watchFlag),
+				Action: dkgStatusCmd,
+			},
+		},
+	},
 	{
 		Name:   "load",
 		Usage:  "Launch a sharing protocol from filesystem",
@@ -351,10 +509,14 @@ var appCommands = []*cli.Command{
 		Usage: "Generate the longterm keypair (drand.private, drand.public) " +
 			"for this node, and load it on the drand daemon if it is up and running.\n",
 		ArgsUsage: "<address> is the address other nodes will be able to contact this node on (specified as 'private-listen' to the daemon)",
-		Flags:     toArray(controlFlag, folderFlag, insecureFlag, beaconIDFlag),
+		Flags:     toArray(controlFlag, folderFlag, insecureFlag, beaconIDFlag, keyPassphraseFileFlag, rotateFlag),
 		Action: func(c *cli.Context) error {
 			banner()
 			err := keygenCmd(c)
+			if c.Bool(rotateFlag.Name) {
+				// a running daemon keeps the previous keys until it restarts
+				return err
+			}
 
 			// If keys were generated successfully, daemon needs to load them
 			// In other to load them, we run LoadBeacon cmd.
@@ -475,13 +637,67 @@ var appCommands = []*cli.Command{
 				Action: deleteBeaconCmd,
 				Before: checkMigration,
 			},
+			{
+				Name: "snapshot",
+				Usage: "Render the chains into a static directory following the paths of the HTTP API, which can be " +
+					"served by any file server. Rounds already in the directory are kept, so that it can be updated " +
+					"incrementally. The daemon MUST NOT be running while reading its database: stop it or use a backup.",
+				Flags: toArray(folderFlag, beaconIDFlag, allBeaconsFlag, snapshotDirFlag, snapshotSplitChecksumsFlag, snapshotSignFlag,
+					keyPassphraseFileFlag),
+				Action: snapshotCmd,
+				Before: checkMigration,
+			},
 			{
 				Name:   "self-sign",
 				Usage:  "Signs the public identity of this node. Needed for backward compatibility with previous versions.",
-				Flags:  toArray(folderFlag, beaconIDFlag),
+				Flags:  toArray(folderFlag, beaconIDFlag, keyPassphraseFileFlag),
 				Action: selfSign,
 				Before: checkMigration,
 			},
+			{
+				Name: "encrypt-keys",
+				Usage: "Encrypt the long-term private key and the share of this node with the passphrase given with " +
+					"--key-passphrase-file or the " + keyPassphraseEnv + " environment variable. The daemon then needs " +
+					"the same passphrase to start. You MUST stop the daemon before running it.",
+				Flags:  toArray(folderFlag, beaconIDFlag, allBeaconsFlag, keyPassphraseFileFlag),
+				Action: encryptKeysCmd,
+				Before: checkMigration,
+			},
+			{
+				Name: "key-backup",
+				Usage: "Save the keys, the group and the share of a beacon in an encrypted backup file, with the " +
+					"passphrase of --backup-passphrase-file or split into shards with --split.",
+				ArgsUsage: "<file> is the path of the backup file to create",
+				Flags:     toArray(folderFlag, beaconIDFlag, keyPassphraseFileFlag, backupPassphraseFileFlag, splitFlag),
+				Action:    keyBackupCmd,
+				Before:    checkMigration,
+			},
+			{
+				Name: "key-restore",
+				Usage: "Restore the keys, the group and the share of a beacon from a backup file, decrypted with the " +
+					"passphrase of --backup-passphrase-file or with the shards given with --shards. The share is " +
+					"checked against the distributed key of the group before it is written. You MUST stop the daemon " +
+					"before running it.",
+				ArgsUsage: "<file> is the path of the backup file",
+				Flags:     toArray(folderFlag, beaconIDFlag, keyPassphraseFileFlag, backupPassphraseFileFlag, shardsFlag),
+				Action:    keyRestoreCmd,
+				Before:    checkMigration,
+			},
+			{
+				Name: "check-share",
+				Usage: "Check the share of a beacon against the distributed key of its group, and the last beacon " +
+					"stored against that key. The chain is only checked when the daemon is stopped.",
+				Flags:  toArray(folderFlag, beaconIDFlag, keyPassphraseFileFlag),
+				Action: checkShareCmd,
+				Before: checkMigration,
+			},
+			{
+				Name: "participation",
+				Usage: "Show which nodes sent partial beacons for the last rounds, their delay after the time of the " +
+					"round and the partial beacons that didn't verify, as seen by the local daemon.",
+				Flags:  toArray(controlFlag, beaconIDFlag, lastRoundsFlag, jsonFlag),
+				Action: participationCmd,
+			},
 			{
 				Name:   "backup",
 				Usage:  "backs up the primary drand database to a secondary location.",
@@ -706,9 +922,15 @@ func keygenCmd(c *cli.Context) error {
 
 	config := contextToConfig(c)
 	beaconID := getBeaconID(c)
-	fileStore := key.NewFileStore(config.ConfigFolderMB(), beaconID)
+	fileStore := config.KeyStore(beaconID)
+
+	if c.Bool(rotateFlag.Name) {
+		return rotateKeys(fileStore, priv)
+	}
 
-	if _, err := fileStore.LoadKeyPair(); err == nil {
+	// an encrypted key pair is never overwritten, even with a wrong passphrase
+	_, err := fileStore.LoadKeyPair()
+	if err == nil || errors.Is(err, key.ErrEncryptedKey) || errors.Is(err, key.ErrWrongPassphrase) {
 		keyDirectory := path.Join(config.ConfigFolderMB(), beaconID)
 		fmt.Fprintf(output, "Keypair already present in `%s`.\nRemove them before generating new one\n", keyDirectory)
 		return nil
@@ -735,6 +957,33 @@ func keygenCmd(c *cli.Context) error {
 	return nil
 }
 
+// rotateKeys replaces the key pair of the store by the given one, after the
+// previous key pair signed off the rotation and was archived.
+func rotateKeys(store key.Store, next *key.Pair) error {
+	previous, err := store.LoadKeyPair()
+	if err != nil {
+		return fmt.Errorf("could not load the keypair to rotate: %w", err)
+	}
+	previous.SignRotation(next.Public)
+	if err := store.ArchiveKeyPair(previous); err != nil {
+		return fmt.Errorf("could not archive the previous key: %w", err)
+	}
+	if err := store.SaveKeyPair(next); err != nil {
+		return fmt.Errorf("could not save key: %w", err)
+	}
+
+	fmt.Fprintf(output, "Rotated keys, the previous key %s is archived.\n", previous.Public.Key)
+	fmt.Fprintf(output, "Restart the daemon, and run a resharing including this node to swap its identity in the group.\n")
+
+	var buff bytes.Buffer
+	if err := toml.NewEncoder(&buff).Encode(next.Public.TOML()); err != nil {
+		return err
+	}
+	buff.WriteString("\n")
+	fmt.Fprintln(output, buff.String())
+	return nil
+}
+
 func groupOut(c *cli.Context, group *key.Group) error {
 	if c.IsSet("out") {
 		groupPath := c.String("out")
@@ -834,6 +1083,19 @@ func checkConnection(c *cli.Context) error {
 }
 
 func checkIdentityAddress(conf *core.Config, addr string, tls bool, beaconID string) error {
+	id, err := fetchIdentity(conf, addr, tls, beaconID)
+	if err != nil {
+		return err
+	}
+	if id.Address() != addr {
+		return fmt.Errorf("mismatch of address: contact %s reply with %s", addr, id.Address())
+	}
+	return nil
+}
+
+// fetchIdentity returns the identity the node at the given address replies
+// with for the given beacon id.
+func fetchIdentity(conf *core.Config, addr string, tls bool, beaconID string) (*key.Identity, error) {
 	peer := net.CreatePeer(addr, tls)
 	client := net.NewGrpcClientFromCertManager(conf.Certs())
 
@@ -843,18 +1105,11 @@ func checkIdentityAddress(conf *core.Config, addr string, tls bool, beaconID str
 	metadata := &common2.Metadata{BeaconID: beaconID}
 	identityResp, err := client.GetIdentity(ctx, peer, &drand.IdentityRequest{Metadata: metadata})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	identity := &drand.Identity{Signature: identityResp.Signature, Tls: identityResp.Tls, Address: identityResp.Address, Key: identityResp.Key}
-	id, err := key.IdentityFromProto(identity)
-	if err != nil {
-		return err
-	}
-	if id.Address() != addr {
-		return fmt.Errorf("mismatch of address: contact %s reply with %s", addr, id.Address())
-	}
-	return nil
+	return key.IdentityFromProto(identity)
 }
 
 // deleteBeaconCmd deletes all beacon in the database from the given round until
@@ -915,6 +1170,124 @@ func deleteBeaconCmd(c *cli.Context) error {
 	return err
 }
 
+func snapshotCmd(c *cli.Context) error {
+	conf := contextToConfig(c)
+
+	stores, err := getDBStoresPaths(c)
+	if err != nil {
+		return err
+	}
+
+	var signer *key.Pair
+	if c.Bool(snapshotSignFlag.Name) {
+		beaconID := getBeaconID(c)
+		signer, err = conf.KeyStore(beaconID).LoadKeyPair()
+		if err != nil {
+			return fmt.Errorf("beacon id [%s] - can't load key pair: %w", beaconID, err)
+		}
+	}
+
+	snap, err := publisher.NewSnapshot(c.String(snapshotDirFlag.Name), c.Bool(snapshotSplitChecksumsFlag.Name), signer, log.DefaultLogger())
+	if err != nil {
+		return fmt.Errorf("can't open snapshot: %w", err)
+	}
+
+	beaconIDs := make([]string, 0, len(stores))
+	for beaconID := range stores {
+		beaconIDs = append(beaconIDs, beaconID)
+	}
+	sort.Strings(beaconIDs)
+
+	for _, beaconID := range beaconIDs {
+		// Using an anonymous function to not leak the defer
+		err := func() error {
+			group, err := conf.KeyStore(beaconID).LoadGroup()
+			if err != nil {
+				return fmt.Errorf("beacon id [%s] - can't load group: %w", beaconID, err)
+			}
+
+			store, err := boltdb.NewBoltStore(path.Join(stores[beaconID], core.DefaultDBFolder), conf.BoltOptions())
+			if err != nil {
+				return fmt.Errorf("beacon id [%s] - invalid bolt store creation: %w", beaconID, err)
+			}
+			defer store.Close()
+
+			n, err := snap.Render(c.Context, publisher.NewStoreSource(chain.NewChainInfo(group), store))
+			fmt.Fprintf(output, "beacon id [%s] - rendered %d rounds\n", beaconID, n)
+			if err != nil {
+				return fmt.Errorf("beacon id [%s] - can't render chain: %w", beaconID, err)
+			}
+			return nil
+		}()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkShareCmd verifies the share of a beacon against the distributed key of
+// its group and, when the daemon is stopped, the chain stored against that key.
+func checkShareCmd(c *cli.Context) error {
+	conf := contextToConfig(c)
+	beaconID := getBeaconID(c)
+	store := conf.KeyStore(beaconID)
+
+	pair, err := store.LoadKeyPair()
+	if err != nil {
+		return fmt.Errorf("beacon id [%s] - can't load key pair: %w", beaconID, err)
+	}
+	archived, err := store.LoadArchivedKeyPairs()
+	if err != nil {
+		return fmt.Errorf("beacon id [%s] - can't load archived keys: %w", beaconID, err)
+	}
+	group, err := store.LoadGroup()
+	if err != nil {
+		return fmt.Errorf("beacon id [%s] - can't load group: %w", beaconID, err)
+	}
+	share, err := store.LoadShare()
+	if err != nil {
+		return fmt.Errorf("beacon id [%s] - can't load share: %w", beaconID, err)
+	}
+
+	node := group.Find(pair.Public)
+	for _, p := range archived {
+		if node != nil {
+			break
+		}
+		node = group.Find(p.Public)
+	}
+	if node == nil {
+		return fmt.Errorf("beacon id [%s] - the key pair is not part of the group", beaconID)
+	}
+	if err := group.CheckShare(share, node); err != nil {
+		return fmt.Errorf("beacon id [%s] - %w", beaconID, err)
+	}
+	fmt.Fprintf(output, "beacon id [%s] - share %d matches the distributed key %s\n",
+		beaconID, share.Share.I, group.PublicKey.Key())
+
+	dbFolder := conf.DBFolder(beaconID)
+	if exists, err := fs.Exists(dbFolder); err != nil {
+		return err
+	} else if !exists {
+		fmt.Fprintf(output, "beacon id [%s] - no chain stored yet\n", beaconID)
+		return nil
+	}
+	// the database is locked while the daemon runs, don't wait for it
+	chainStore, err := boltdb.NewBoltStore(dbFolder, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		fmt.Fprintf(output, "beacon id [%s] - chain not checked, stop the daemon to check it: %s\n", beaconID, err)
+		return nil
+	}
+	defer chainStore.Close()
+	if err := chain.NewChainInfo(group).CheckStore(chainStore); err != nil {
+		return fmt.Errorf("beacon id [%s] - %w", beaconID, err)
+	}
+	fmt.Fprintf(output, "beacon id [%s] - the chain stored matches the distributed key\n", beaconID)
+	return nil
+}
+
 func toArray(flags ...cli.Flag) []cli.Flag {
 	return flags
 }
@@ -943,10 +1316,41 @@ func checkArgs(c *cli.Context) error {
 			return err
 		}
 	}
+	if _, err := getKeyPassphrase(c); err != nil {
+		return err
+	}
 
 	return nil
 }
 
+// getKeyPassphrase returns the passphrase encrypting the keys, or nil if none
+// is given. The passphrase file is read only once, as a file descriptor can't
+// be read twice.
+func getKeyPassphrase(c *cli.Context) ([]byte, error) {
+	if !c.IsSet(keyPassphraseFileFlag.Name) {
+		if passphrase := os.Getenv(keyPassphraseEnv); passphrase != "" {
+			return []byte(passphrase), nil
+		}
+		return nil, nil
+	}
+	if passphrase, ok := c.App.Metadata[keyPassphraseFileFlag.Name]; ok {
+		return passphrase.([]byte), nil
+	}
+	content, err := os.ReadFile(c.String(keyPassphraseFileFlag.Name))
+	if err != nil {
+		return nil, fmt.Errorf("reading key passphrase: %w", err)
+	}
+	passphrase := bytes.TrimRight(content, "\r\n")
+	if len(passphrase) == 0 {
+		return nil, errors.New("the key passphrase file is empty")
+	}
+	if c.App.Metadata == nil {
+		c.App.Metadata = make(map[string]interface{})
+	}
+	c.App.Metadata[keyPassphraseFileFlag.Name] = passphrase
+	return passphrase, nil
+}
+
 func contextToConfig(c *cli.Context) *core.Config {
 	var opts []core.ConfigOption
 	version := common.GetAppVersion()
@@ -990,10 +1394,72 @@ func contextToConfig(c *cli.Context) *core.Config {
 	if c.Bool(enablePrivateRand.Name) {
 		opts = append(opts, core.WithPrivateRandomness())
 	}
+	if c.Bool(overrideProtectionFlag.Name) {
+		opts = append(opts, core.WithSigningProtectionOverride())
+	}
+	if c.Bool(streamPartialsFlag.Name) {
+		opts = append(opts, core.WithPartialStreams())
+	}
+	passphrase, err := getKeyPassphrase(c)
+	if err != nil {
+		// it wouldn't reach here, as it was verified on checkArgs func before
+		panic(err)
+	}
+	if passphrase != nil {
+		opts = append(opts, core.WithKeyPassphrase(passphrase))
+	}
 	conf := core.NewConfig(opts...)
 	return conf
 }
 
+func encryptKeysCmd(c *cli.Context) error {
+	passphrase, err := getKeyPassphrase(c)
+	if err != nil {
+		return err
+	}
+	if passphrase == nil {
+		return fmt.Errorf("no passphrase given: use --%s or the %s environment variable",
+			keyPassphraseFileFlag.Name, keyPassphraseEnv)
+	}
+
+	stores, err := getKeyStores(c)
+	if err != nil {
+		return err
+	}
+	for beaconID, store := range stores {
+		// the encrypted store loads plain text files and saves them encrypted
+		pair, err := store.LoadKeyPair()
+		if err != nil {
+			return fmt.Errorf("beacon id [%s] - could not load key pair: %w", beaconID, err)
+		}
+		if err := store.SaveKeyPair(pair); err != nil {
+			return fmt.Errorf("beacon id [%s] - could not save key pair: %w", beaconID, err)
+		}
+		archived, err := store.LoadArchivedKeyPairs()
+		if err != nil {
+			return fmt.Errorf("beacon id [%s] - could not load archived keys: %w", beaconID, err)
+		}
+		for _, p := range archived {
+			if err := store.ArchiveKeyPair(p); err != nil {
+				return fmt.Errorf("beacon id [%s] - could not save archived key: %w", beaconID, err)
+			}
+		}
+		share, err := store.LoadShare()
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+			// no DKG ran yet
+		case err != nil:
+			return fmt.Errorf("beacon id [%s] - could not load share: %w", beaconID, err)
+		default:
+			if err := store.SaveShare(share); err != nil {
+				return fmt.Errorf("beacon id [%s] - could not save share: %w", beaconID, err)
+			}
+		}
+		fmt.Fprintf(output, "beacon id [%s] - keys encrypted\n", beaconID)
+	}
+	return nil
+}
+
 func getNodes(c *cli.Context) ([]*key.Node, error) {
 	group, err := getGroup(c)
 	if err != nil {
@@ -1075,12 +1541,12 @@ func getKeyStores(c *cli.Context) (map[string]key.Store, error) {
 	conf := contextToConfig(c)
 
 	if c.IsSet(allBeaconsFlag.Name) {
-		return key.NewFileStores(conf.ConfigFolderMB())
+		return conf.KeyStores()
 	}
 
 	beaconID := getBeaconID(c)
 
-	store := key.NewFileStore(conf.ConfigFolderMB(), beaconID)
+	store := conf.KeyStore(beaconID)
 	stores := map[string]key.Store{beaconID: store}
 
 	return stores, nil
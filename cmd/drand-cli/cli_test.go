@@ -28,14 +28,13 @@ import (
 	"github.com/drand/drand/core"
 	"github.com/drand/drand/fs"
 	"github.com/drand/drand/key"
+	"github.com/drand/drand/publisher"
 	"github.com/drand/drand/test"
 	"github.com/drand/kyber"
 	"github.com/drand/kyber/share"
 	"github.com/drand/kyber/util/random"
 )
 
-const expectedShareOutput = "0000000000000000000000000000000000000000000000000000000000000001"
-
 func TestMigrate(t *testing.T) {
 	tmp := getSBFolderStructure()
 	defer os.RemoveAll(tmp)
@@ -139,6 +138,38 @@ func TestDeleteBeacon(t *testing.T) {
 	require.Nil(t, b)
 }
 
+func TestUtilSnapshot(t *testing.T) {
+	beaconID := test.GetBeaconIDFromEnv()
+	sch := scheme.GetSchemeFromEnv()
+
+	tmp := t.TempDir()
+	out := t.TempDir()
+
+	args := []string{"drand", "generate-keypair", "--folder", tmp, "--id", beaconID, "127.0.0.1:8081"}
+	require.NoError(t, CLI().Run(args))
+
+	conf := core.NewConfig(core.WithConfigFolder(tmp))
+	_, group := test.BatchIdentities(3, sch, beaconID)
+	require.NoError(t, key.NewFileStore(conf.ConfigFolderMB(), beaconID).SaveGroup(group))
+
+	fs.CreateSecureFolder(conf.DBFolder(beaconID))
+	store, err := boltdb.NewBoltStore(conf.DBFolder(beaconID), conf.BoltOptions())
+	require.NoError(t, err)
+	for round := uint64(1); round <= 3; round++ {
+		require.NoError(t, store.Put(&chain.Beacon{Round: round, Signature: []byte("Hello")}))
+	}
+	store.Close()
+
+	hash := chain.NewChainInfo(group).HashString()
+	snapshot := []string{"drand", "util", "snapshot", "--folder", tmp, "--id", beaconID, "--dir", out, "--sign"}
+	testCommand(t, snapshot, "rendered 3 rounds")
+	require.FileExists(t, path.Join(out, hash, "public", "3"))
+	require.FileExists(t, path.Join(out, publisher.ManifestSignatureKey))
+
+	// rendering again is a no-op
+	testCommand(t, snapshot, "rendered 0 rounds")
+}
+
 func TestKeySelfSignError(t *testing.T) {
 	beaconID := test.GetBeaconIDFromEnv()
 
@@ -216,6 +247,80 @@ func TestKeyGen(t *testing.T) {
 	require.Nil(t, priv)
 }
 
+func TestDKGProposeSign(t *testing.T) {
+	beaconID := test.GetBeaconIDFromEnv()
+	n := 3
+
+	tmp := t.TempDir()
+	folders := make([]string, n)
+	ids := make([]string, n)
+	for i := range folders {
+		folders[i] = path.Join(tmp, fmt.Sprintf("node%d", i))
+		args := []string{"drand", "generate-keypair", "--folder", folders[i], "--id", beaconID,
+			fmt.Sprintf("127.0.0.1:%d", 8081+i)}
+		require.NoError(t, CLI().Run(args))
+		config := core.NewConfig(core.WithConfigFolder(folders[i]))
+		ids[i] = path.Join(config.ConfigFolderMB(), common.GetCanonicalBeaconID(beaconID), key.KeyFolderName,
+			"drand_id.public")
+	}
+
+	proposalPath := path.Join(tmp, "proposal.toml")
+	propose := append([]string{"drand", "dkg", "propose", "--id", beaconID, "--period", "5s",
+		"--start", "1000", "--timeout", "10s", "--out", proposalPath}, ids...)
+	require.NoError(t, CLI().Run(propose))
+
+	for i, folder := range folders {
+		sign := []string{"drand", "dkg", "sign", "--folder", folder, "--id", beaconID, proposalPath}
+		expectedOutput := "Waiting for the signatures of"
+		if i == n-1 {
+			expectedOutput = "All participants signed the proposal"
+		}
+		testCommand(t, sign, expectedOutput)
+	}
+
+	proposal := new(key.Proposal)
+	require.NoError(t, key.Load(proposalPath, proposal))
+	require.NoError(t, proposal.Verify())
+	require.Equal(t, int64(1000), proposal.Start)
+	require.Equal(t, int64(1000+30+1), proposal.Group.GenesisTime)
+	require.Equal(t, key.DefaultThreshold(n), proposal.Group.Threshold)
+
+	// the nodes are not running
+	var buff bytes.Buffer
+	output = &buff
+	defer func() { output = os.Stdout }()
+	validate := []string{"drand", "dkg", "validate", proposalPath}
+	require.ErrorContains(t, CLI().Run(validate), fmt.Sprintf("%d checks of the proposal failed", n))
+	require.Contains(t, buff.String(), "[OK]   threshold")
+	require.Contains(t, buff.String(), "[OK]   self-signature of 127.0.0.1:8081")
+	require.Contains(t, buff.String(), "[FAIL] reachability of 127.0.0.1:8081")
+	require.Contains(t, buff.String(), "Signed by all nodes")
+
+	// a resharing from the group created by the proposal
+	group := proposal.Group
+	group.PublicKey = &key.DistPublic{Coefficients: []kyber.Point{key.KeyGroup.Point().Pick(random.New()),
+		key.KeyGroup.Point().Pick(random.New())}}
+	groupPath := path.Join(tmp, "group.toml")
+	require.NoError(t, key.Save(groupPath, group, false))
+	resharePath := path.Join(tmp, "reshare.toml")
+	propose = append([]string{"drand", "dkg", "propose", "--from", groupPath, "--start", "2000",
+		"--timeout", "10s", "--out", resharePath}, ids...)
+	require.NoError(t, CLI().Run(propose))
+
+	reshare := new(key.Proposal)
+	require.NoError(t, key.Load(resharePath, reshare))
+	require.True(t, reshare.Reshare())
+	require.Equal(t, group.GenesisTime, reshare.Group.GenesisTime)
+	require.Equal(t, group.GetGenesisSeed(), reshare.Group.GetGenesisSeed())
+	// the first round after the end of the resharing
+	require.Equal(t, int64(2036), reshare.Group.TransitionTime)
+
+	// the period of a resharing is the one of the current group
+	propose = append([]string{"drand", "dkg", "propose", "--from", groupPath, "--start", "2000",
+		"--period", "10s", "--out", resharePath}, ids...)
+	require.Error(t, CLI().Run(propose))
+}
+
 // tests valid commands and then invalid commands
 func TestStartAndStop(t *testing.T) {
 	tmpPath := path.Join(os.TempDir(), "drand")
@@ -356,6 +461,11 @@ func TestStartWithoutGroup(t *testing.T) {
 	initDKGArgs := []string{"drand", "share", "--control", ctrlPort1, "--id", beaconID}
 	require.Error(t, CLI().Run(initDKGArgs))
 
+	fmt.Println("--- DRAND DKG STATUS --- (expected to fail)")
+	// no dkg ran on this node
+	dkgStatusArgs := []string{"drand", "dkg", "status", "--control", ctrlPort1, "--id", beaconID}
+	require.ErrorContains(t, CLI().Run(dkgStatusArgs), "no dkg ran")
+
 	fmt.Println("--- DRAND STOP --- (failing instance)")
 	CLI().Run([]string{"drand", "stop", "--control", ctrlPort1})
 
@@ -364,20 +474,12 @@ func TestStartWithoutGroup(t *testing.T) {
 	// fake group
 	_, group := test.BatchIdentities(5, sch, beaconID)
 
-	// fake dkg outuput
-	fakeKey := key.KeyGroup.Point().Pick(random.New())
-	distKey := &key.DistPublic{
-		Coefficients: []kyber.Point{
-			fakeKey,
-			key.KeyGroup.Point().Pick(random.New()),
-			key.KeyGroup.Point().Pick(random.New()),
-		},
-	}
 	priv.Public.TLS = false
 
 	group.Period = 5 * time.Second
 	group.GenesisTime = time.Now().Unix() - 10
-	group.PublicKey = distKey
+	// fake dkg output
+	fakeShare := newFakeShare(group, 0)
 	group.Nodes[0] = &key.Node{Identity: priv.Public, Index: 0}
 	group.Nodes[1] = &key.Node{Identity: priv.Public, Index: 1}
 	groupPath := path.Join(tmpPath, "drand_group.toml")
@@ -387,9 +489,6 @@ func TestStartWithoutGroup(t *testing.T) {
 	require.NoError(t, fileStore.SaveGroup(group))
 
 	// fake share
-	scalarOne := key.KeyGroup.Scalar().One()
-	s := &share.PriShare{I: 2, V: scalarOne}
-	fakeShare := &key.Share{Share: s}
 	require.NoError(t, fileStore.SaveShare(fakeShare))
 
 	fmt.Println(" --- DRAND START --- control ", ctrlPort2)
@@ -448,8 +547,19 @@ func testStartedDrandFunctional(t *testing.T, ctrlPort, rootPath, address string
 	testCommand(t, chainInfoCmdHash, expectedOutput)
 
 	fmt.Println("\nRunning SHOW SHARE command")
+	fakeShare, err := fileStore.LoadShare()
+	require.NoError(t, err)
 	shareCmd := []string{"drand", "show", "share", "--control", ctrlPort}
-	testCommand(t, shareCmd, expectedShareOutput)
+	testCommand(t, shareCmd, expectedShareOutput(t, fakeShare))
+
+	participation := []string{"drand", "util", "participation", "--control", ctrlPort, "--id", beaconID}
+	testCommand(t, participation, fmt.Sprintf("Participation of beacon id [%s], threshold %d of %d nodes",
+		beaconID, group.Threshold, group.Len()))
+
+	stopAtRound := []string{"drand", "stop", "--control", ctrlPort, "--id", beaconID, "--at-round", "1000"}
+	testCommand(t, stopAtRound, fmt.Sprintf("voted to end the chain of beacon id [%s] at round 1000: 1 of %d votes needed",
+		beaconID, group.Threshold))
+	require.Error(t, CLI().Run([]string{"drand", "stop", "--control", ctrlPort, "--id", beaconID, "--at-round", "1"}))
 
 	showChainInfo := []string{"drand", "show", "chain-info", "--control", ctrlPort}
 	buffCi, err := json.MarshalIndent(chain.NewChainInfo(group).ToProto(nil), "", "    ")
@@ -555,26 +665,14 @@ func TestClientTLS(t *testing.T) {
 	// fake group
 	_, group := test.BatchTLSIdentities(5, sch, beaconID)
 	// fake dkg outuput
-	fakeKey := key.KeyGroup.Point().Pick(random.New())
-	// need a threshold of coefficients
-	distKey := &key.DistPublic{
-		Coefficients: []kyber.Point{
-			fakeKey,
-			key.KeyGroup.Point().Pick(random.New()),
-			key.KeyGroup.Point().Pick(random.New()),
-		},
-	}
+	fakeShare := newFakeShare(group, 0)
 	group.Nodes[0] = &key.Node{Identity: priv.Public, Index: 0}
 	group.Period = 2 * time.Minute
 	group.GenesisTime = time.Now().Unix()
-	group.PublicKey = distKey
 	require.NoError(t, fileStore.SaveGroup(group))
 	require.NoError(t, key.Save(groupPath, group, false))
 
 	// fake share
-	scalarOne := key.KeyGroup.Scalar().One()
-	s := &share.PriShare{I: 2, V: scalarOne}
-	fakeShare := &key.Share{Share: s}
 	fileStore.SaveShare(fakeShare)
 
 	startArgs := []string{
@@ -592,10 +690,11 @@ func TestClientTLS(t *testing.T) {
 	defer CLI().Run([]string{"drand", "stop", "--control", ctrlPort})
 	time.Sleep(500 * time.Millisecond)
 
-	testStartedTLSDrandFunctional(t, ctrlPort, certPath, groupPath, group, priv)
+	testStartedTLSDrandFunctional(t, ctrlPort, certPath, groupPath, group, priv, fakeShare)
 }
 
-func testStartedTLSDrandFunctional(t *testing.T, ctrlPort, certPath, groupPath string, group *key.Group, priv *key.Pair) {
+func testStartedTLSDrandFunctional(t *testing.T, ctrlPort, certPath, groupPath string, group *key.Group, priv *key.Pair,
+	fakeShare *key.Share) {
 	var err error
 	for i := 0; i < 3; i++ {
 		getPrivate := []string{"drand", "get", "private", "--tls-cert", certPath, groupPath}
@@ -614,7 +713,7 @@ func testStartedTLSDrandFunctional(t *testing.T, ctrlPort, certPath, groupPath s
 	testCommand(t, chainInfoCmd, expectedOutput)
 
 	showCmd := []string{"drand", "show", "share", "--control", ctrlPort}
-	testCommand(t, showCmd, expectedShareOutput)
+	testCommand(t, showCmd, expectedShareOutput(t, fakeShare))
 
 	showPublic := []string{"drand", "show", "public", "--control", ctrlPort}
 	b, _ := priv.Public.Key.MarshalBinary()
@@ -982,4 +1081,193 @@ func TestSharingWithInvalidFlagCombos(t *testing.T) {
 		CLI().Run(share3),
 		"--from flag invalid with --reshare - nodes resharing should already have a secret share and group ready to use",
 	)
+
+	// a dkg from a proposal has no leader
+	share4 := []string{
+		"drand", "share", "--tls-disable", "--id", beaconID, "--proposal", "proposal.toml", "--leader",
+	}
+
+	assert.EqualError(t, CLI().Run(share4), "you can't use the proposal and leader flags together")
+
+	// nodes can only be evicted by a resharing
+	share5 := []string{
+		"drand", "share", "--tls-disable", "--id", beaconID, "--connect", "127.0.0.1:9090", "--evict", "127.0.0.1:8080",
+	}
+
+	assert.EqualError(t, CLI().Run(share5), "--evict flag is only valid with a resharing - try the --reshare flag")
+}
+
+func TestEncryptKeys(t *testing.T) {
+	beaconID := test.GetBeaconIDFromEnv()
+	tmp := t.TempDir()
+	passFile := path.Join(tmp, "passphrase")
+	require.NoError(t, os.WriteFile(passFile, []byte("a passphrase\n"), 0o600))
+
+	keygen := []string{"drand", "generate-keypair", "--folder", tmp, "--id", beaconID, "127.0.0.1:8081"}
+	require.NoError(t, CLI().Run(keygen))
+
+	encrypt := []string{"drand", "util", "encrypt-keys", "--folder", tmp, "--id", beaconID}
+	require.Error(t, CLI().Run(encrypt))
+	testCommand(t, append(encrypt, "--key-passphrase-file", passFile), "keys encrypted")
+
+	_, err := core.NewConfig(core.WithConfigFolder(tmp)).KeyStore(beaconID).LoadKeyPair()
+	require.ErrorIs(t, err, key.ErrEncryptedKey)
+	config := core.NewConfig(core.WithConfigFolder(tmp), core.WithKeyPassphrase([]byte("a passphrase")))
+	_, err = config.KeyStore(beaconID).LoadKeyPair()
+	require.NoError(t, err)
+
+	// the encrypted key pair is never overwritten
+	testCommand(t, keygen, "Keypair already present")
+
+	t.Setenv("DRAND_KEY_PASSPHRASE", "a passphrase")
+	selfSign := []string{"drand", "util", "self-sign", "--folder", tmp, "--id", beaconID}
+	testCommand(t, selfSign, "already self signed")
+}
+
+func TestKeyGenRotate(t *testing.T) {
+	beaconID := test.GetBeaconIDFromEnv()
+	tmp := t.TempDir()
+
+	keygen := []string{"drand", "generate-keypair", "--folder", tmp, "--id", beaconID, "127.0.0.1:8081"}
+	rotate := []string{"drand", "generate-keypair", "--folder", tmp, "--id", beaconID, "--rotate", "127.0.0.1:8081"}
+	require.Error(t, CLI().Run(rotate))
+	require.NoError(t, CLI().Run(keygen))
+
+	store := core.NewConfig(core.WithConfigFolder(tmp)).KeyStore(beaconID)
+	previous, err := store.LoadKeyPair()
+	require.NoError(t, err)
+
+	testCommand(t, rotate, "Rotated keys")
+	next, err := store.LoadKeyPair()
+	require.NoError(t, err)
+	require.False(t, next.Public.Key.Equal(previous.Public.Key))
+	require.NoError(t, next.Public.ValidSignature())
+	require.NoError(t, next.Public.ValidRotation(previous.Public.Key))
+
+	archived, err := store.LoadArchivedKeyPairs()
+	require.NoError(t, err)
+	require.Len(t, archived, 1)
+	require.True(t, archived[0].Public.Equal(previous.Public))
+}
+
+func TestKeyBackupRestore(t *testing.T) {
+	beaconID := test.GetBeaconIDFromEnv()
+	tmp := t.TempDir()
+	passFile := path.Join(tmp, "passphrase")
+	require.NoError(t, os.WriteFile(passFile, []byte("a backup passphrase\n"), 0o600))
+
+	// a node with its group and a share of a real distributed key
+	from := path.Join(tmp, "from")
+	keygen := []string{"drand", "generate-keypair", "--folder", from, "--id", beaconID, "127.0.0.1:8081"}
+	require.NoError(t, CLI().Run(keygen))
+	store := core.NewConfig(core.WithConfigFolder(from)).KeyStore(beaconID)
+	pair, err := store.LoadKeyPair()
+	require.NoError(t, err)
+	_, group := test.BatchIdentities(3, scheme.GetSchemeFromEnv(), beaconID)
+	group.Nodes[1] = &key.Node{Identity: pair.Public, Index: 1}
+	poly := share.NewPriPoly(key.KeyGroup, group.Threshold, nil, random.New())
+	_, commits := poly.Commit(key.KeyGroup.Point().Base()).Info()
+	group.PublicKey = &key.DistPublic{Coefficients: commits}
+	require.NoError(t, store.SaveGroup(group))
+	require.NoError(t, store.SaveShare(&key.Share{Commits: commits, Share: poly.Shares(3)[1]}))
+
+	backupFile := path.Join(tmp, "backup.toml")
+	backup := []string{"drand", "util", "key-backup", "--folder", from, "--id", beaconID}
+	require.Error(t, CLI().Run(append(backup, backupFile)))
+	testCommand(t, append(backup, "--backup-passphrase-file", passFile, backupFile), "Backup of beacon id")
+	// an existing backup is never overwritten
+	require.Error(t, CLI().Run(append(backup, "--backup-passphrase-file", passFile, backupFile)))
+
+	to := path.Join(tmp, "to")
+	restore := []string{"drand", "util", "key-restore", "--folder", to, "--id", beaconID}
+	require.Error(t, CLI().Run(append(restore, backupFile)))
+	testCommand(t, append(restore, "--backup-passphrase-file", passFile, backupFile), "Restored the keys and share 1")
+	restored := core.NewConfig(core.WithConfigFolder(to)).KeyStore(beaconID)
+	restoredPair, err := restored.LoadKeyPair()
+	require.NoError(t, err)
+	require.True(t, restoredPair.Public.Equal(pair.Public))
+	restoredGroup, err := restored.LoadGroup()
+	require.NoError(t, err)
+	require.True(t, restoredGroup.Equal(group))
+	restoredShare, err := restored.LoadShare()
+	require.NoError(t, err)
+	require.Equal(t, poly.Shares(3)[1].V.String(), restoredShare.Share.V.String())
+	// restoring twice would overwrite the keys
+	require.Error(t, CLI().Run(append(restore, "--backup-passphrase-file", passFile, backupFile)))
+
+	// the same with a backup split into shards
+	var buff bytes.Buffer
+	output = &buff
+	defer func() { output = os.Stdout }()
+	splitFile := path.Join(tmp, "split.toml")
+	require.NoError(t, CLI().Run(append(backup, "--split", "2-of-3", splitFile)))
+	var shards []string
+	for _, line := range strings.Split(buff.String(), "\n") {
+		if i := strings.Index(line, "drand-shard:"); i >= 0 {
+			shards = append(shards, line[i:])
+		}
+	}
+	require.Len(t, shards, 3)
+
+	split := path.Join(tmp, "split")
+	restore = []string{"drand", "util", "key-restore", "--folder", split, "--id", beaconID}
+	require.Error(t, CLI().Run(append(restore, "--shards", shards[2], splitFile)))
+	require.NoError(t, CLI().Run(append(restore, "--shards", shards[2]+","+shards[0], splitFile)))
+	restoredShare, err = core.NewConfig(core.WithConfigFolder(split)).KeyStore(beaconID).LoadShare()
+	require.NoError(t, err)
+	require.Equal(t, poly.Shares(3)[1].V.String(), restoredShare.Share.V.String())
+
+	// a share that isn't on the distributed polynomial of the group is refused
+	require.NoError(t, store.SaveShare(&key.Share{Commits: commits, Share: poly.Shares(3)[2]}))
+	require.Error(t, CLI().Run(append(backup, "--split", "2-of-3", path.Join(tmp, "invalid.toml"))))
+}
+
+func TestCheckShare(t *testing.T) {
+	beaconID := test.GetBeaconIDFromEnv()
+	tmp := t.TempDir()
+
+	keygen := []string{"drand", "generate-keypair", "--folder", tmp, "--id", beaconID, "127.0.0.1:8081"}
+	require.NoError(t, CLI().Run(keygen))
+	config := core.NewConfig(core.WithConfigFolder(tmp))
+	store := config.KeyStore(beaconID)
+	pair, err := store.LoadKeyPair()
+	require.NoError(t, err)
+	_, group := test.BatchIdentities(3, scheme.GetSchemeFromEnv(), beaconID)
+	group.Nodes[1] = &key.Node{Identity: pair.Public, Index: 1}
+	fakeShare := newFakeShare(group, 1)
+	require.NoError(t, store.SaveGroup(group))
+	require.NoError(t, store.SaveShare(fakeShare))
+
+	check := []string{"drand", "util", "check-share", "--folder", tmp, "--id", beaconID}
+	testCommand(t, check, "share 1 matches the distributed key")
+	testCommand(t, check, "no chain stored yet")
+
+	// a chain signed with another distributed key
+	require.NoError(t, os.MkdirAll(config.DBFolder(beaconID), 0o740))
+	chainStore, err := boltdb.NewBoltStore(config.DBFolder(beaconID), nil)
+	require.NoError(t, err)
+	require.NoError(t, chainStore.Put(&chain.Beacon{Round: 1, Signature: []byte("not a signature")}))
+	chainStore.Close()
+	require.ErrorContains(t, CLI().Run(check), "doesn't verify the last beacon 1")
+
+	// a share that doesn't match its commitments
+	fakeShare.Share.V = key.KeyGroup.Scalar().Pick(random.New())
+	require.NoError(t, store.SaveShare(fakeShare))
+	require.ErrorContains(t, CLI().Run(check), "doesn't match the commitment")
+}
+
+// expectedShareOutput returns the private share as printed by drand show share
+func expectedShareOutput(t *testing.T, s *key.Share) string {
+	buff, err := s.Share.V.MarshalBinary()
+	require.NoError(t, err)
+	return hex.EncodeToString(buff)
+}
+
+// newFakeShare sets the distributed key of a fake DKG on the group, and returns
+// the share of the node at the given index, consistent with that key.
+func newFakeShare(group *key.Group, index int) *key.Share {
+	poly := share.NewPriPoly(key.KeyGroup, group.Threshold, nil, random.New())
+	_, commits := poly.Commit(key.KeyGroup.Point().Base()).Info()
+	group.PublicKey = &key.DistPublic{Coefficients: commits}
+	return &key.Share{Commits: commits, Share: poly.Eval(index)}
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -104,6 +105,10 @@ func shareCmd(c *cli.Context) error {
 		return err
 	}
 
+	if c.IsSet(proposalFlag.Name) {
+		return proposalShareCmd(c)
+	}
+
 	if c.IsSet(transitionFlag.Name) || c.IsSet(oldGroupFlag.Name) {
 		return reshareCmd(c)
 	}
@@ -147,6 +152,18 @@ func validateShareArgs(c *cli.Context) error {
 		return fmt.Errorf("you can't use the leader and connect flags together")
 	}
 
+	if c.IsSet(proposalFlag.Name) {
+		for _, f := range []cli.Flag{leaderFlag, connectFlag, transitionFlag, evictFlag} {
+			if c.IsSet(f.Names()[0]) {
+				return fmt.Errorf("you can't use the %s and %s flags together", proposalFlag.Name, f.Names()[0])
+			}
+		}
+	}
+
+	if c.IsSet(evictFlag.Name) && !c.IsSet(transitionFlag.Name) && !c.IsSet(oldGroupFlag.Name) {
+		return fmt.Errorf("--%s flag is only valid with a resharing - try the --%s flag", evictFlag.Name, transitionFlag.Name)
+	}
+
 	if c.IsSet(transitionFlag.Name) && c.IsSet(oldGroupFlag.Name) {
 		return fmt.Errorf(
 			"--%s flag invalid with --%s - nodes resharing should already have a secret share and group ready to use",
@@ -187,12 +204,18 @@ func leadShareCmd(c *cli.Context) error {
 	if err != nil {
 		return fmt.Errorf("period given is invalid: %w", err)
 	}
+	if period <= 0 || period%time.Millisecond != 0 {
+		return fmt.Errorf("period given is invalid: %s is not a positive number of milliseconds", period)
+	}
 
 	var catchupPeriod time.Duration
 	catchupPeriodStr := c.String(catchupPeriodFlag.Name)
 	if catchupPeriod, err = time.ParseDuration(catchupPeriodStr); err != nil {
 		return fmt.Errorf("catchup period given is invalid: %w", err)
 	}
+	if catchupPeriod%time.Millisecond != 0 {
+		return fmt.Errorf("catchup period given is invalid: %s is not a whole number of milliseconds", catchupPeriod)
+	}
 
 	var sch scheme.Scheme
 	if sch, err = scheme.GetSchemeByIDWithDefault(c.String(schemeFlag.Name)); err != nil {
@@ -291,9 +314,13 @@ func reshareCmd(c *cli.Context) error {
 		beaconID = common.GetCanonicalBeaconID(oldGroup.ID)
 	}
 
+	evict := getEvicted(c)
 	fmt.Fprintf(output, "Participating to the resharing. Beacon ID: [%s] \n", beaconID)
+	if len(evict) > 0 {
+		fmt.Fprintf(output, "Evicting %s from the group.\n", strings.Join(evict, ", "))
+	}
 
-	groupP, shareErr := ctrlClient.InitReshare(connectPeer, args.secret, oldPath, args.force, beaconID)
+	groupP, shareErr := ctrlClient.InitReshare(connectPeer, args.secret, oldPath, args.force, beaconID, evict...)
 	if shareErr != nil {
 		return fmt.Errorf("error setting up the network: %w", shareErr)
 	}
@@ -358,9 +385,13 @@ func leadReshareCmd(c *cli.Context) error {
 		}
 	}
 
+	evict := getEvicted(c)
 	fmt.Fprintf(output, "Initiating the resharing as a leader. Beacon ID: [%s] \n", beaconID)
+	if len(evict) > 0 {
+		fmt.Fprintf(output, "Evicting %s from the group.\n", strings.Join(evict, ", "))
+	}
 	groupP, shareErr := ctrlClient.InitReshareLeader(nodes, args.threshold, args.timeout,
-		catchupPeriod, args.secret, oldPath, offset, beaconID)
+		catchupPeriod, args.secret, oldPath, offset, beaconID, evict...)
 
 	if shareErr != nil {
 		return fmt.Errorf("error setting up the network: %w", shareErr)
@@ -372,6 +403,20 @@ func leadReshareCmd(c *cli.Context) error {
 	return groupOut(c, group)
 }
 
+// getEvicted returns the addresses given with the evict flag.
+func getEvicted(c *cli.Context) []string {
+	if !c.IsSet(evictFlag.Name) {
+		return nil
+	}
+	var addrs []string
+	for _, addr := range strings.Split(c.String(evictFlag.Name), ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs
+}
+
 func getTimeout(c *cli.Context) (timeout time.Duration, err error) {
 	if c.IsSet(timeoutFlag.Name) {
 		str := c.String(timeoutFlag.Name)
@@ -665,6 +710,69 @@ func controlPort(c *cli.Context) string {
 	return port
 }
 
+func participationCmd(c *cli.Context) error {
+	client, err := controlClient(c)
+	if err != nil {
+		return err
+	}
+	beaconID := getBeaconID(c)
+	rounds := c.Int(lastRoundsFlag.Name)
+	if rounds < 0 {
+		return fmt.Errorf("invalid --%s %d", lastRoundsFlag.Name, rounds)
+	}
+	resp, err := client.Participation(beaconID, uint32(rounds))
+	if err != nil {
+		return fmt.Errorf("could not request the participation: %w", err)
+	}
+	if c.IsSet(jsonFlag.Name) {
+		return printJSON(resp)
+	}
+
+	n := int(resp.GetGroupSize())
+	fmt.Fprintf(output, "Participation of beacon id [%s], threshold %d of %d nodes\n", beaconID, resp.GetThreshold(), n)
+	received := make(map[uint32]int, n)
+	latencies := make(map[uint32]time.Duration, n)
+	for _, r := range resp.GetRounds() {
+		status := "not aggregated"
+		if r.GetAggregated() {
+			status = "aggregated"
+		}
+		fmt.Fprintf(output, "round %d: %d/%d partials, %s\n", r.GetRound(), len(r.GetPartials()), n, status)
+		seen := make(map[uint32]bool, len(r.GetPartials()))
+		for _, p := range r.GetPartials() {
+			latency := time.Duration(p.GetLatency())
+			fmt.Fprintf(output, "\tindex %d after %s\n", p.GetIndex(), latency.Round(time.Millisecond))
+			seen[p.GetIndex()] = true
+			received[p.GetIndex()]++
+			latencies[p.GetIndex()] += latency
+		}
+		var missing []string
+		for _, i := range resp.GetIndexes() {
+			if !seen[i] {
+				missing = append(missing, strconv.Itoa(int(i)))
+			}
+		}
+		if len(missing) > 0 {
+			fmt.Fprintf(output, "\tmissing: %s\n", strings.Join(missing, ", "))
+		}
+		if r.GetInvalid() > 0 {
+			fmt.Fprintf(output, "\t%d invalid partials\n", r.GetInvalid())
+		}
+	}
+
+	fmt.Fprintf(output, "Over the last %d rounds:\n", len(resp.GetRounds()))
+	for _, i := range resp.GetIndexes() {
+		if received[i] == 0 {
+			fmt.Fprintf(output, "\tindex %d: no partials\n", i)
+			continue
+		}
+		average := latencies[i] / time.Duration(received[i])
+		fmt.Fprintf(output, "\tindex %d: %d partials, %s after the round on average\n",
+			i, received[i], average.Round(time.Millisecond))
+	}
+	return nil
+}
+
 func controlClient(c *cli.Context) (*net.ControlClient, error) {
 	port := controlPort(c)
 	client, err := net.NewControlClient(port)
@@ -705,7 +813,7 @@ func selfSign(c *cli.Context) error {
 
 	beaconID := getBeaconID(c)
 
-	fs := key.NewFileStore(conf.ConfigFolderMB(), beaconID)
+	fs := conf.KeyStore(beaconID)
 	pair, err := fs.LoadKeyPair()
 
 	if err != nil {
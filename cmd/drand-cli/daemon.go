@@ -6,10 +6,20 @@ import (
 	"github.com/urfave/cli/v2"
 
 	"github.com/drand/drand/core"
+	"github.com/drand/drand/net"
+	"github.com/drand/drand/signer"
 )
 
 func startCmd(c *cli.Context) error {
 	conf := contextToConfig(c)
+	if c.IsSet(signerFlag.Name) {
+		client, err := signer.NewClient(c.String(signerFlag.Name))
+		if err != nil {
+			return fmt.Errorf("can't connect to the signer: %w", err)
+		}
+		defer client.Close()
+		core.WithPartialSigner(client)(conf)
+	}
 
 	// Create and start drand daemon
 	drandDaemon, err := core.NewDrandDaemon(conf)
@@ -33,6 +43,10 @@ func stopDaemon(c *cli.Context) error {
 		return err
 	}
 
+	if c.IsSet(atRoundFlag.Name) {
+		return scheduleHalt(c, ctrlClient)
+	}
+
 	isBeaconIDSet := c.IsSet(beaconIDFlag.Name)
 	if isBeaconIDSet {
 		beaconID := getBeaconID(c)
@@ -53,3 +67,27 @@ func stopDaemon(c *cli.Context) error {
 
 	return nil
 }
+
+// scheduleHalt votes to end the chain at the round given, and reports the
+// votes the daemon knows of.
+func scheduleHalt(c *cli.Context, ctrlClient *net.ControlClient) error {
+	round := c.Int(atRoundFlag.Name)
+	if round <= 0 {
+		return fmt.Errorf("invalid round %d to end the chain at", round)
+	}
+	beaconID := getBeaconID(c)
+	resp, err := ctrlClient.ScheduleHalt(beaconID, uint64(round))
+	if err != nil {
+		return fmt.Errorf("error ending the chain of beacon id [%s]: %w", beaconID, err)
+	}
+
+	fmt.Fprintf(output, "voted to end the chain of beacon id [%s] at round %d: %d of %d votes needed (nodes %v)\n",
+		beaconID, resp.GetRound(), len(resp.GetVotes()), resp.GetThreshold(), resp.GetVotes())
+	if uint32(len(resp.GetVotes())) >= resp.GetThreshold() {
+		fmt.Fprintf(output, "the chain ends at round %d\n", resp.GetRound())
+	}
+	for _, addr := range resp.GetUnreachable() {
+		fmt.Fprintf(output, "could not send the vote to %s\n", addr)
+	}
+	return nil
+}
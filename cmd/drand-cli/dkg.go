@@ -0,0 +1,383 @@
+package drand
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/urfave/cli/v2"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/common"
+	"github.com/drand/drand/common/scheme"
+	"github.com/drand/drand/core"
+	"github.com/drand/drand/key"
+	"github.com/drand/drand/net"
+	"github.com/drand/drand/protobuf/drand"
+	"github.com/drand/kyber/share/dkg"
+)
+
+// proposeDKGCmd creates the proposal of a leaderless DKG between the
+// identities given as arguments, or of a resharing to them from the group
+// given.
+func proposeDKGCmd(c *cli.Context) error {
+	if c.NArg() < 1 {
+		return fmt.Errorf("drand: dkg propose expects the identity files of the participants")
+	}
+	if !c.IsSet(startFlag.Name) {
+		return fmt.Errorf("drand: dkg propose needs the start time of the dkg - try the --%s flag", startFlag.Name)
+	}
+
+	ids := make([]*key.Identity, c.NArg())
+	for i, file := range c.Args().Slice() {
+		ids[i] = new(key.Identity)
+		if err := key.Load(file, ids[i]); err != nil {
+			return fmt.Errorf("drand: can't load identity %s: %w", file, err)
+		}
+	}
+
+	var oldGroup *key.Group
+	if c.IsSet(oldGroupFlag.Name) {
+		oldGroup = new(key.Group)
+		if err := key.Load(c.String(oldGroupFlag.Name), oldGroup); err != nil {
+			return fmt.Errorf("drand: can't load group to reshare from: %w", err)
+		}
+		if oldGroup.PublicKey == nil {
+			return fmt.Errorf("drand: group to reshare from has no distributed key")
+		}
+	} else if !c.IsSet(periodFlag.Name) {
+		return fmt.Errorf("drand: dkg propose needs the beacon period - try the --%s flag", periodFlag.Name)
+	}
+
+	threshold, err := getThreshold(c)
+	if err != nil {
+		return err
+	}
+	timeout, err := getTimeout(c)
+	if err != nil {
+		return fmt.Errorf("timeout given is invalid: %w", err)
+	}
+	offset := core.DefaultGenesisOffset
+	if c.IsSet(beaconOffset.Name) {
+		offset = time.Duration(c.Int(beaconOffset.Name)) * time.Second
+	}
+	start := c.Int64(startFlag.Name)
+	// as with a leader, the chain starts, or moves to the new group, once the
+	// three phases of the DKG are over
+	end := time.Unix(start, 0).Add(3*timeout + offset).Unix()
+
+	var proposal *key.Proposal
+	if oldGroup == nil {
+		period, err := time.ParseDuration(c.String(periodFlag.Name))
+		if err != nil {
+			return fmt.Errorf("period given is invalid: %w", err)
+		}
+		catchupPeriod, err := time.ParseDuration(c.String(catchupPeriodFlag.Name))
+		if err != nil {
+			return fmt.Errorf("catchup period given is invalid: %w", err)
+		}
+		sch, err := scheme.GetSchemeByIDWithDefault(c.String(schemeFlag.Name))
+		if err != nil {
+			return fmt.Errorf("scheme given is invalid: %w", err)
+		}
+		proposal = key.NewProposal(ids, threshold, end, start, period, catchupPeriod, timeout, sch, getBeaconID(c))
+	} else {
+		if c.IsSet(periodFlag.Name) || c.IsSet(schemeFlag.Name) || c.IsSet(beaconIDFlag.Name) {
+			return fmt.Errorf("drand: the period, scheme and id of a resharing are the ones of the group given with --%s",
+				oldGroupFlag.Name)
+		}
+		catchupPeriod := oldGroup.CatchupPeriod
+		if c.IsSet(catchupPeriodFlag.Name) {
+			if catchupPeriod, err = time.ParseDuration(c.String(catchupPeriodFlag.Name)); err != nil {
+				return fmt.Errorf("catchup period given is invalid: %w", err)
+			}
+		}
+		proposal = key.NewProposal(ids, threshold, oldGroup.GenesisTime, start, oldGroup.Period, catchupPeriod,
+			timeout, oldGroup.Scheme, oldGroup.ID)
+		proposal.Group.GenesisSeed = oldGroup.GetGenesisSeed()
+		// the new group takes over the chain at the time of a round
+		_, proposal.Group.TransitionTime = chain.NextRound(end, oldGroup.Period, oldGroup.GenesisTime)
+	}
+	if err := proposal.VerifyParameters(); err != nil {
+		return err
+	}
+	return proposalOut(c, proposal)
+}
+
+// signDKGCmd adds the signature of this node to a proposal.
+func signDKGCmd(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return fmt.Errorf("drand: dkg sign expects the proposal file")
+	}
+	file := c.Args().First()
+	proposal := new(key.Proposal)
+	if err := key.Load(file, proposal); err != nil {
+		return fmt.Errorf("drand: can't load proposal: %w", err)
+	}
+	if err := proposal.VerifyParameters(); err != nil {
+		return err
+	}
+
+	conf := contextToConfig(c)
+	beaconID := getBeaconID(c)
+	fs := conf.KeyStore(beaconID)
+	pair, err := fs.LoadKeyPair()
+	if err != nil {
+		return fmt.Errorf("beacon id [%s] - loading private/public: %w", beaconID, err)
+	}
+	if err := proposal.Sign(pair); err != nil {
+		return err
+	}
+
+	out := file
+	if c.IsSet(outFlag.Name) {
+		out = c.String(outFlag.Name)
+	}
+	if err := key.Save(out, proposal, false); err != nil {
+		return fmt.Errorf("drand: can't save proposal: %w", err)
+	}
+
+	fmt.Fprintf(output, "Proposal %x signed by %s\n", proposal.Hash(), pair.Public.Address())
+	unsigned := proposal.Unsigned()
+	if len(unsigned) == 0 {
+		fmt.Fprintln(output, "All participants signed the proposal.")
+		return nil
+	}
+	fmt.Fprintf(output, "Waiting for the signatures of %d participants:\n", len(unsigned))
+	for _, n := range unsigned {
+		fmt.Fprintf(output, "\t- %s\n", n.Address())
+	}
+	return nil
+}
+
+// proposalShareCmd runs the DKG or resharing of a proposal signed by all its
+// participants.
+func proposalShareCmd(c *cli.Context) error {
+	proposal := new(key.Proposal)
+	if err := key.Load(c.String(proposalFlag.Name), proposal); err != nil {
+		return fmt.Errorf("drand: can't load proposal: %w", err)
+	}
+	if err := proposal.Verify(); err != nil {
+		return err
+	}
+	if err := checkArgs(c); err != nil {
+		return err
+	}
+	entropy, err := entropyInfoFromReader(c)
+	if err != nil {
+		return fmt.Errorf("error getting entropy source: %w", err)
+	}
+
+	conf := contextToConfig(c)
+	ctrlClient, err := net.NewControlClient(conf.ControlPort())
+	if err != nil {
+		return fmt.Errorf("could not create client: %w", err)
+	}
+
+	beaconID := proposal.Group.ID
+	kind := "DKG"
+	if proposal.Reshare() {
+		kind = "resharing"
+	}
+	fmt.Fprintf(output, "Participating in the leaderless %s of proposal %x, starting at %s. Beacon ID: [%s]\n",
+		kind, proposal.Hash(), time.Unix(proposal.Start, 0), beaconID)
+	groupP, shareErr := ctrlClient.InitDKGLeaderless(proposal.ToProto(common.GetAppVersion()), entropy,
+		c.String(oldGroupFlag.Name), beaconID)
+	if shareErr != nil {
+		return fmt.Errorf("error setting up the network: %w", shareErr)
+	}
+	group, err := key.GroupFromProto(groupP)
+	if err != nil {
+		return fmt.Errorf("error interpreting the group from protobuf: %w", err)
+	}
+	return groupOut(c, group)
+}
+
+// validateDKGCmd checks a proposal before anything starts, and reports every
+// check that fails.
+func validateDKGCmd(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return fmt.Errorf("drand: dkg validate expects the proposal file")
+	}
+	proposal := new(key.Proposal)
+	if err := key.Load(c.Args().First(), proposal); err != nil {
+		return fmt.Errorf("drand: invalid proposal: %w", err)
+	}
+	g := proposal.Group
+	conf := contextToConfig(c)
+
+	failed := 0
+	check := func(name string, err error) {
+		if err != nil {
+			failed++
+			fmt.Fprintf(output, "[FAIL] %s: %s\n", name, err)
+			return
+		}
+		fmt.Fprintf(output, "[OK]   %s\n", name)
+	}
+
+	fmt.Fprintf(output, "Proposal %x: %d nodes, threshold %d, period %s, scheme %s, beacon id %s\n",
+		proposal.Hash(), g.Len(), g.Threshold, g.Period, g.Scheme.ID, g.ID)
+	check("scheme", func() error {
+		if _, found := scheme.GetSchemeByID(g.Scheme.ID); !found {
+			return fmt.Errorf("unknown scheme %s", g.Scheme.ID)
+		}
+		return nil
+	}())
+	check("threshold", func() error {
+		if min := key.MinimumT(g.Len()); g.Threshold < min || g.Threshold > g.Len() {
+			return fmt.Errorf("%d is not between %d and %d", g.Threshold, min, g.Len())
+		}
+		return nil
+	}())
+	check("parameters", proposal.VerifyParameters())
+	for _, n := range g.Nodes {
+		check(fmt.Sprintf("self-signature of %s", n.Address()), n.ValidSignature())
+		check(fmt.Sprintf("reachability of %s", n.Address()), func() error {
+			id, err := fetchIdentity(conf, n.Address(), n.IsTLS(), g.ID)
+			if err != nil {
+				return err
+			}
+			if !id.Key.Equal(n.Key) {
+				return fmt.Errorf("node replies with another key")
+			}
+			return nil
+		}())
+	}
+	if proposal.Reshare() && c.IsSet(oldGroupFlag.Name) {
+		check("consistency with the current group", func() error {
+			old := new(key.Group)
+			if err := key.Load(c.String(oldGroupFlag.Name), old); err != nil {
+				return err
+			}
+			return checkTransition(old, g)
+		}())
+	}
+
+	if unsigned := proposal.Unsigned(); len(unsigned) > 0 {
+		fmt.Fprintf(output, "Signed by %d of %d nodes, waiting for:\n", g.Len()-len(unsigned), g.Len())
+		for _, n := range unsigned {
+			fmt.Fprintf(output, "\t- %s\n", n.Address())
+		}
+	} else {
+		fmt.Fprintln(output, "Signed by all nodes.")
+	}
+	if failed > 0 {
+		return fmt.Errorf("drand: %d checks of the proposal failed", failed)
+	}
+	return nil
+}
+
+// dkgStatusCmd prints the progress of the DKG of this node, and keeps
+// printing it at each change with --watch.
+func dkgStatusCmd(c *cli.Context) error {
+	conf := contextToConfig(c)
+	ctrlClient, err := net.NewControlClient(conf.ControlPort())
+	if err != nil {
+		return fmt.Errorf("could not create client: %w", err)
+	}
+
+	progressCh, errCh, err := ctrlClient.DKGStatus(c.Context, c.Bool(watchFlag.Name), getBeaconID(c))
+	if err != nil {
+		return fmt.Errorf("error asking for the dkg status: %w", err)
+	}
+	first := true
+	for progress := range progressCh {
+		if !first {
+			fmt.Fprintln(output)
+		}
+		first = false
+		printDKGProgress(progress)
+	}
+	if err := <-errCh; err != nil {
+		return fmt.Errorf("error getting the dkg status: %w", err)
+	}
+	return nil
+}
+
+func printDKGProgress(p *drand.DKGProgress) {
+	kind := "DKG"
+	if p.GetReshare() {
+		kind = "Resharing"
+	}
+	switch {
+	case p.GetDone() && p.GetError() != "":
+		fmt.Fprintf(output, "%s failed: %s\n", kind, p.GetError())
+	case p.GetDone():
+		fmt.Fprintf(output, "%s finished\n", kind)
+	case p.GetPhaseDeadline() != 0:
+		fmt.Fprintf(output, "%s in %s phase, until %s\n", kind, dkg.Phase(p.GetPhase()),
+			time.Unix(p.GetPhaseDeadline(), 0).Format(time.RFC3339))
+	default:
+		fmt.Fprintf(output, "%s waiting to start\n", kind)
+	}
+
+	fmt.Fprintln(output, "Dealers:")
+	for _, n := range p.GetDealers() {
+		fmt.Fprintf(output, "\t[%d] %s\tdeal: %s\tjustification: %s\n", n.GetIndex(), n.GetAddress(),
+			received(n.GetDeal()), received(n.GetJustification()))
+	}
+	fmt.Fprintln(output, "Share holders:")
+	for _, n := range p.GetShareHolders() {
+		fmt.Fprintf(output, "\t[%d] %s\tresponse: %s\n", n.GetIndex(), n.GetAddress(), received(n.GetResponse()))
+	}
+	if len(p.GetComplaints()) > 0 {
+		fmt.Fprintln(output, "Complaints:")
+		for _, cp := range p.GetComplaints() {
+			fmt.Fprintf(output, "\t- node %d about the deal of node %d\n", cp.GetShareHolder(), cp.GetDealer())
+		}
+	}
+	if p.GetDone() && p.GetError() == "" {
+		qual := make([]string, len(p.GetQual()))
+		for i, idx := range p.GetQual() {
+			qual[i] = fmt.Sprint(idx)
+		}
+		fmt.Fprintf(output, "Qualified nodes: %s\n", strings.Join(qual, ", "))
+	}
+}
+
+func received(b bool) string {
+	if b {
+		return "received"
+	}
+	return "missing"
+}
+
+// checkTransition checks a resharing to the new group keeps the chain of the
+// old group.
+func checkTransition(old, g *key.Group) error {
+	switch {
+	case old.PublicKey == nil:
+		return fmt.Errorf("current group has no distributed key")
+	case !common.CompareBeaconIDs(old.ID, g.ID):
+		return fmt.Errorf("beacon id %s differs from %s", g.ID, old.ID)
+	case old.GenesisTime != g.GenesisTime:
+		return fmt.Errorf("genesis time %d differs from %d", g.GenesisTime, old.GenesisTime)
+	case !bytes.Equal(old.GetGenesisSeed(), g.GetGenesisSeed()):
+		return fmt.Errorf("genesis seed differs")
+	case old.Period != g.Period:
+		return fmt.Errorf("period %s differs from %s", g.Period, old.Period)
+	case old.Scheme.ID != g.Scheme.ID:
+		return fmt.Errorf("scheme %s differs from %s", g.Scheme.ID, old.Scheme.ID)
+	}
+	return nil
+}
+
+func proposalOut(c *cli.Context, proposal *key.Proposal) error {
+	if c.IsSet(outFlag.Name) {
+		if err := key.Save(c.String(outFlag.Name), proposal, false); err != nil {
+			return fmt.Errorf("drand: can't save proposal to specified file name: %w", err)
+		}
+		return nil
+	}
+	var buff bytes.Buffer
+	if err := toml.NewEncoder(&buff).Encode(proposal.TOML()); err != nil {
+		return fmt.Errorf("drand: can't encode proposal to TOML: %w", err)
+	}
+	fmt.Fprintf(output, "Copy the following snippet into a new proposal.toml file\n")
+	fmt.Fprint(output, buff.String())
+	fmt.Fprintf(output, "\nHash of the proposal: %x\n", proposal.Hash())
+	return nil
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/drand/drand/common"
+	"github.com/drand/drand/key"
+	"github.com/drand/drand/log"
+	"github.com/drand/drand/signer"
+)
+
+// Automatically set through -ldflags
+// Example: go install -ldflags "-X main.buildDate=`date -u +%d/%m/%Y@%H:%M:%S` -X main.gitCommit=`git rev-parse HEAD`"
+var (
+	gitCommit = "none"
+	buildDate = "unknown"
+)
+
+// keyPassphraseEnv is the environment variable holding the passphrase of
+// encrypted shares, as with the drand daemon
+const keyPassphraseEnv = "DRAND_KEY_PASSPHRASE"
+
+var listenFlag = &cli.StringFlag{
+	Name:     "listen",
+	Usage:    "Address to listen on: the path of a Unix socket prefixed by unix://, or host:port on a trusted network",
+	Required: true,
+}
+
+// using a simple string flag because the StringSliceFlag is not intuitive
+// see https://github.com/urfave/cli/issues/62
+var sharesFlag = &cli.StringFlag{
+	Name: "shares",
+	Usage: "Comma-separated list of the share files (dist_key.private) to sign with. Give the new share " +
+		"along the current one before a resharing, so that the signer keeps serving the node.",
+	Required: true,
+}
+
+var keyPassphraseFileFlag = &cli.StringFlag{
+	Name: "key-passphrase-file",
+	Usage: "File containing the passphrase of encrypted shares. The passphrase can also be given with the " +
+		keyPassphraseEnv + " environment variable.",
+}
+
+var verboseFlag = &cli.BoolFlag{
+	Name:  "verbose",
+	Usage: "Log the rounds signed",
+}
+
+func run(c *cli.Context) error {
+	passphrase := []byte(os.Getenv(keyPassphraseEnv))
+	if c.IsSet(keyPassphraseFileFlag.Name) {
+		content, err := os.ReadFile(c.String(keyPassphraseFileFlag.Name))
+		if err != nil {
+			return fmt.Errorf("reading key passphrase: %w", err)
+		}
+		passphrase = bytes.TrimRight(content, "\r\n")
+	}
+
+	var shares []*key.Share
+	for _, file := range strings.Split(c.String(sharesFlag.Name), ",") {
+		ks := new(key.Share)
+		if err := key.LoadEncrypted(file, ks, passphrase); err != nil {
+			return fmt.Errorf("loading share %s: %w", file, err)
+		}
+		shares = append(shares, ks)
+	}
+	s, err := signer.NewSigner(shares...)
+	if err != nil {
+		return err
+	}
+
+	level := log.LogInfo
+	if c.Bool(verboseFlag.Name) {
+		level = log.LogDebug
+	}
+	logger := log.NewLogger(nil, level).Named("signer")
+	l, err := signer.Listen(c.String(listenFlag.Name))
+	if err != nil {
+		return err
+	}
+	server := signer.NewServer(s, logger)
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-stop
+		server.Stop()
+	}()
+
+	logger.Infow("", "signer", "listening", "addr", l.Addr(), "shares", len(shares))
+	return server.Serve(l)
+}
+
+func main() {
+	version := common.GetAppVersion()
+
+	app := &cli.App{
+		Name:    "drand-signer",
+		Version: version.String(),
+		Usage: "Sign the partial beacons of drand nodes started with --signer, with shares held outside " +
+			"of the daemon. The signer refuses to sign two different messages for the same round with a share.",
+		Flags:  []cli.Flag{listenFlag, sharesFlag, keyPassphraseFileFlag, verboseFlag},
+		Action: run,
+	}
+	cli.VersionPrinter = func(c *cli.Context) {
+		fmt.Printf("drand signer %v (date %v, commit %v)\n", version, buildDate, gitCommit)
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.DefaultLogger().Fatalw("", "binary", "signer", "err", err)
+	}
+}
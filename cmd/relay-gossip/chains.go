@@ -0,0 +1,52 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// relayMode is the way a chain is relayed.
+type relayMode string
+
+const (
+	// modePublish publishes on gossip the randomness fetched from the
+	// upstream sources given with the client flags.
+	modePublish relayMode = "publish"
+	// modeBridge subscribes to the randomness of the chain over gossip, to
+	// serve it over HTTP.
+	modeBridge relayMode = "bridge"
+)
+
+// chainConfig is the configuration of one of the chains relayed.
+type chainConfig struct {
+	hash string
+	mode relayMode
+}
+
+// parseChains parses the values of the chain flag, given as HASH or HASH=MODE.
+func parseChains(values []string) ([]chainConfig, error) {
+	seen := make(map[string]bool)
+	chains := make([]chainConfig, 0, len(values))
+	for _, value := range values {
+		hash, mode, found := strings.Cut(value, "=")
+		cfg := chainConfig{hash: strings.TrimSpace(hash), mode: modePublish}
+		if found {
+			cfg.mode = relayMode(strings.TrimSpace(mode))
+		}
+
+		if _, err := hex.DecodeString(cfg.hash); err != nil || cfg.hash == "" {
+			return nil, fmt.Errorf("invalid chain hash %q", cfg.hash)
+		}
+		if cfg.mode != modePublish && cfg.mode != modeBridge {
+			return nil, fmt.Errorf("invalid mode %q for chain %s: expected %q or %q", cfg.mode, cfg.hash, modePublish, modeBridge)
+		}
+		if seen[cfg.hash] {
+			return nil, fmt.Errorf("chain %s given more than once", cfg.hash)
+		}
+		seen[cfg.hash] = true
+
+		chains = append(chains, cfg)
+	}
+	return chains, nil
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChains(t *testing.T) {
+	chains, err := parseChains([]string{"abcd", "0123=bridge", "ef01=publish"})
+	require.NoError(t, err)
+	require.Equal(t, []chainConfig{
+		{hash: "abcd", mode: modePublish},
+		{hash: "0123", mode: modeBridge},
+		{hash: "ef01", mode: modePublish},
+	}, chains)
+
+	for _, invalid := range [][]string{
+		{"nothex"},
+		{"=bridge"},
+		{"abcd=mirror"},
+		{"abcd", "abcd=bridge"},
+	} {
+		_, err := parseChains(invalid)
+		require.Error(t, err, invalid)
+	}
+}
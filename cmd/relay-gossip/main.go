@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"net"
+	"net/http"
 	"os"
 
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
@@ -11,10 +13,13 @@ import (
 	cli "github.com/urfave/cli/v2"
 	"golang.org/x/xerrors"
 
+	"github.com/drand/drand/client"
 	"github.com/drand/drand/cmd/client/lib"
 	"github.com/drand/drand/common"
+	dhttp "github.com/drand/drand/http"
 	"github.com/drand/drand/log"
 	"github.com/drand/drand/lp2p"
+	gclient "github.com/drand/drand/lp2p/client"
 	"github.com/drand/drand/metrics"
 	"github.com/drand/drand/metrics/pprof"
 )
@@ -55,6 +60,10 @@ var (
 		Name:  "peer-with",
 		Usage: "peer multiaddr(s) for the relay to direct connect with",
 	}
+	trustedPeerFlag = &cli.StringSliceFlag{
+		Name:  "trusted-peer",
+		Usage: "ID(s) of the peers never penalized by the gossip peer scoring, in addition to the --peer-with peers",
+	}
 	storeFlag = &cli.StringFlag{
 		Name:  "store",
 		Usage: "datastore directory",
@@ -69,6 +78,34 @@ var (
 		Name:  "metrics",
 		Usage: "local host:port to bind a metrics servlet (optional)",
 	}
+	chainFlag = &cli.StringSliceFlag{
+		Name: "chain",
+		Usage: fmt.Sprintf("chain to relay, as `HASH` or HASH=MODE (can be repeated). MODE is either %q (default), "+
+			"to publish on gossip the randomness fetched from the upstream sources, or %q, to serve over HTTP "+
+			"the randomness received over gossip. Without it, the chain given with --%s is published",
+			modePublish, modeBridge, lib.HashFlag.Name),
+	}
+	dhtFlag = &cli.BoolFlag{
+		Name:  "dht",
+		Usage: "discover the peers of the relayed chains on a DHT bootstrapped from --peer-with, and advertise the relay on it",
+	}
+	mdnsFlag = &cli.BoolFlag{
+		Name:  "mdns",
+		Usage: "discover the peers on the local network over mDNS",
+	}
+	legacyTopicFlag = &cli.BoolFlag{
+		Name:  "legacy-topic",
+		Usage: "also publish the bare randomness on the legacy v0.0.0 topics of the chains, for the clients not supporting envelopes yet",
+		Value: true,
+	}
+	signEnvelopesFlag = &cli.BoolFlag{
+		Name:  "sign-envelopes",
+		Usage: "sign the envelopes published with the libp2p identity of the relay",
+	}
+	httpBindFlag = &cli.StringFlag{
+		Name:  "http-bind",
+		Usage: "local host:port to serve the relayed chains over the HTTP API (optional, required by the bridge mode)",
+	}
 )
 
 var runCmd = &cli.Command{
@@ -77,9 +114,16 @@ var runCmd = &cli.Command{
 	Flags: append(lib.ClientFlags, []cli.Flag{
 		idFlag,
 		peerWithFlag,
+		trustedPeerFlag,
 		storeFlag,
 		listenFlag,
 		metricsFlag,
+		chainFlag,
+		httpBindFlag,
+		dhtFlag,
+		mdnsFlag,
+		legacyTopicFlag,
+		signEnvelopesFlag,
 	}...),
 	Action: func(cctx *cli.Context) error {
 		if cctx.IsSet(metricsFlag.Name) {
@@ -90,36 +134,120 @@ var runCmd = &cli.Command{
 			}
 		}
 
-		c, err := lib.Create(cctx, cctx.IsSet(metricsFlag.Name))
+		chains, err := parseChains(cctx.StringSlice(chainFlag.Name))
 		if err != nil {
-			return xerrors.Errorf("constructing client: %w", err)
-		}
-
-		chainHash := cctx.String(lib.HashFlag.Name)
-		if chainHash == "" {
-			info, err := c.Info(context.Background())
-			if err != nil {
-				return xerrors.Errorf("getting chain info: %w", err)
-			}
-			chainHash = hex.EncodeToString(info.Hash())
+			return err
 		}
 
 		cfg := &lp2p.GossipRelayConfig{
-			ChainHash:    chainHash,
 			PeerWith:     cctx.StringSlice(peerWithFlag.Name),
+			TrustedPeers: cctx.StringSlice(trustedPeerFlag.Name),
 			Addr:         cctx.String(listenFlag.Name),
 			DataDir:      cctx.String(storeFlag.Name),
 			IdentityPath: cctx.String(idFlag.Name),
-			Client:       c,
+			Discovery: lp2p.DiscoveryConfig{
+				DHT:  cctx.Bool(dhtFlag.Name),
+				MDNS: cctx.Bool(mdnsFlag.Name),
+			},
+			DisableLegacyTopic: !cctx.Bool(legacyTopicFlag.Name),
+			SignEnvelopes:      cctx.Bool(signEnvelopesFlag.Name),
 		}
-		if _, err := lp2p.NewGossipRelayNode(log.DefaultLogger(), cfg); err != nil {
+		node, err := lp2p.NewGossipRelayNode(log.DefaultLogger(), cfg)
+		if err != nil {
 			return err
 		}
-		<-chan int(nil)
-		return nil
+
+		var handler *dhttp.DrandHandler
+		if cctx.IsSet(httpBindFlag.Name) {
+			version := common.GetAppVersion()
+			handler, err = dhttp.New(cctx.Context, fmt.Sprintf("drand/%s (%s)", version, gitCommit),
+				log.DefaultLogger().Named("relay_gossip"))
+			if err != nil {
+				return xerrors.Errorf("creating http handler: %w", err)
+			}
+		}
+
+		if len(chains) == 0 {
+			if err := relayDefaultChain(cctx, node, handler); err != nil {
+				return err
+			}
+		}
+		for _, chain := range chains {
+			if err := relayChain(cctx, node, handler, chain); err != nil {
+				return err
+			}
+		}
+
+		if handler == nil {
+			<-chan int(nil)
+			return nil
+		}
+
+		listener, err := net.Listen("tcp", cctx.String(httpBindFlag.Name))
+		if err != nil {
+			return err
+		}
+		log.DefaultLogger().Infow("", "relay_gossip", "serving http", "addr", listener.Addr())
+		return http.Serve(listener, handler.GetHTTPHandler())
 	},
 }
 
+// relayDefaultChain publishes the chain given with the hash flag, or the chain
+// of the upstream sources if it isn't set.
+func relayDefaultChain(cctx *cli.Context, node *lp2p.GossipRelayNode, handler *dhttp.DrandHandler) error {
+	c, err := lib.Create(cctx, cctx.IsSet(metricsFlag.Name))
+	if err != nil {
+		return xerrors.Errorf("constructing client: %w", err)
+	}
+
+	chainHash := cctx.String(lib.HashFlag.Name)
+	if chainHash == "" {
+		info, err := c.Info(context.Background())
+		if err != nil {
+			return xerrors.Errorf("getting chain info: %w", err)
+		}
+		chainHash = hex.EncodeToString(info.Hash())
+	}
+
+	if err := node.AddChain(chainHash, c); err != nil {
+		return err
+	}
+	if handler != nil {
+		bh := handler.RegisterNewBeaconHandler(c, chainHash)
+		handler.RegisterDefaultBeaconHandler(bh)
+	}
+	return nil
+}
+
+// relayChain relays a chain according to its mode: either publishing it on
+// gossip from the upstream sources, or subscribing to it over gossip to serve
+// it over HTTP. Published chains are also served over HTTP if enabled.
+func relayChain(cctx *cli.Context, node *lp2p.GossipRelayNode, handler *dhttp.DrandHandler, chain chainConfig) error {
+	var opts []client.Option
+	if chain.mode == modeBridge {
+		if handler == nil {
+			return xerrors.Errorf("chain %s: --%s is required by the %s mode", chain.hash, httpBindFlag.Name, modeBridge)
+		}
+		opts = append(opts, gclient.WithPubsub(node.PubSub(), gclient.WithHistory(node.Host())))
+		node.Discover(chain.hash)
+	}
+
+	c, err := lib.CreateWithHash(cctx, chain.hash, cctx.IsSet(metricsFlag.Name), opts...)
+	if err != nil {
+		return xerrors.Errorf("constructing client for chain %s: %w", chain.hash, err)
+	}
+
+	if chain.mode == modePublish {
+		if err := node.AddChain(chain.hash, c); err != nil {
+			return err
+		}
+	}
+	if handler != nil {
+		handler.RegisterNewBeaconHandler(c, chain.hash)
+	}
+	return nil
+}
+
 var clientCmd = &cli.Command{
 	Name:  "client",
 	Flags: lib.ClientFlags,
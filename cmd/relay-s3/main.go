@@ -1,22 +1,19 @@
 package main
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"os"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/s3/s3manager"
-	json "github.com/nikkolasg/hexjson"
 	cli "github.com/urfave/cli/v2"
 
 	"github.com/drand/drand/client"
 	"github.com/drand/drand/cmd/client/lib"
 	"github.com/drand/drand/common"
 	"github.com/drand/drand/log"
+	"github.com/drand/drand/publisher"
 )
 
 // Automatically set through -ldflags
@@ -64,82 +61,14 @@ var runCmd = &cli.Command{
 	Flags: append(lib.ClientFlags, bucketFlag, regionFlag),
 
 	Action: func(cctx *cli.Context) error {
-		sess, err := session.NewSession(&aws.Config{Region: aws.String(cctx.String(regionFlag.Name))})
+		pub, c, err := setup(cctx)
 		if err != nil {
-			return fmt.Errorf("creating aws session: %w", err)
+			return err
 		}
-
-		if _, err := sess.Config.Credentials.Get(); err != nil {
-			return fmt.Errorf("checking credentials: %w", err)
-		}
-
-		c, err := lib.Create(cctx, false)
-		if err != nil {
-			return fmt.Errorf("creating client: %w", err)
-		}
-
-		upr := s3manager.NewUploader(sess)
-		watch(context.Background(), c, upr, cctx.String(bucketFlag.Name))
-		return nil
+		return pub.Watch(context.Background(), c)
 	},
 }
 
-func watch(ctx context.Context, c client.Watcher, upr *s3manager.Uploader, buc string) {
-	for {
-		ch := c.Watch(ctx)
-	INNER:
-		for {
-			select {
-			case res, ok := <-ch:
-				if !ok {
-					log.DefaultLogger().Warnw("", "relay_s3", "watch channel closed")
-					t := time.NewTimer(time.Second)
-					select {
-					case <-t.C:
-						break INNER
-					case <-ctx.Done():
-						return
-					}
-				}
-				log.DefaultLogger().Infow("", "relay_s3", "got randomness", "round", res.Round())
-				go func(res client.Result) {
-					url, err := uploadRandomness(ctx, upr, buc, res)
-					if err != nil {
-						log.DefaultLogger().Errorw("", "relay_s3", "failed to upload randomness", "err", err)
-						return
-					}
-					log.DefaultLogger().Infow("", "relay_s3", "uploaded randomness", "round", res.Round(), "location", url)
-				}(res)
-			case <-ctx.Done():
-				return
-			}
-		}
-	}
-}
-
-func uploadRandomness(ctx context.Context, upr *s3manager.Uploader, buc string, res client.Result) (string, error) {
-	rd, ok := res.(*client.RandomData)
-	if !ok {
-		return "", fmt.Errorf("unexpected underlying result type")
-	}
-	data, err := json.Marshal(rd)
-	if err != nil {
-		return "", fmt.Errorf("failed to marshal randomness: %w", err)
-	}
-	r, err := upr.UploadWithContext(ctx, &s3manager.UploadInput{
-		ACL:          aws.String("public-read"),
-		Bucket:       aws.String(buc),
-		Key:          aws.String(fmt.Sprintf("public/%v", res.Round())),
-		Body:         bytes.NewBuffer(data),
-		ContentType:  aws.String("application/json"),
-		CacheControl: aws.String("public, max-age=604800, immutable"),
-	})
-	if err != nil {
-		return "", err
-	}
-	return r.Location, nil
-}
-
 var syncCmd = &cli.Command{
 	Name:  "sync",
 	Usage: "sync the AWS S3 bucket with the randomness chain",
@@ -155,39 +84,30 @@ var syncCmd = &cli.Command{
 	),
 
 	Action: func(cctx *cli.Context) error {
-		sess, err := session.NewSession(&aws.Config{Region: aws.String(cctx.String(regionFlag.Name))})
+		pub, c, err := setup(cctx)
 		if err != nil {
-			return fmt.Errorf("creating aws session: %w", err)
+			return err
 		}
+		_, err = pub.Sync(context.Background(), c, cctx.Uint64("begin"))
+		return err
+	},
+}
 
-		if _, err := sess.Config.Credentials.Get(); err != nil {
-			return fmt.Errorf("checking credentials: %w", err)
-		}
+func setup(cctx *cli.Context) (*publisher.Publisher, client.Client, error) {
+	sess, err := session.NewSession(&aws.Config{Region: aws.String(cctx.String(regionFlag.Name))})
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating aws session: %w", err)
+	}
 
-		c, err := lib.Create(cctx, false)
-		if err != nil {
-			return fmt.Errorf("creating client: %w", err)
-		}
+	if _, err := sess.Config.Credentials.Get(); err != nil {
+		return nil, nil, fmt.Errorf("checking credentials: %w", err)
+	}
 
-		buc := cctx.String(bucketFlag.Name)
-		upr := s3manager.NewUploader(sess)
-		ctx := context.Background()
-
-		for rnd := cctx.Uint64("begin"); rnd <= c.RoundAt(time.Now()); rnd++ {
-			// TODO: check if bucket already has this round
-			r, err := c.Get(ctx, rnd)
-			if err != nil {
-				log.DefaultLogger().Errorw("", "relay_s3_sync", "failed to get randomness", "round", rnd, "err", err)
-				continue
-			}
-			url, err := uploadRandomness(ctx, upr, buc, r)
-			if err != nil {
-				log.DefaultLogger().Errorw("", "relay_s3_sync", "failed to upload randomness", "err", err)
-				continue
-			}
-			log.DefaultLogger().Infow("", "relay_s3_sync", "uploaded randomness", "round", r.Round(), "location", url)
-		}
+	c, err := lib.Create(cctx, false)
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating client: %w", err)
+	}
 
-		return nil
-	},
+	sink := publisher.NewS3Sink(sess, cctx.String(bucketFlag.Name))
+	return publisher.New(sink, "", log.DefaultLogger().Named("relay_s3")), c, nil
 }
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	cli "github.com/urfave/cli/v2"
+
+	"github.com/drand/drand/client"
+	"github.com/drand/drand/cmd/client/lib"
+	"github.com/drand/drand/common"
+	"github.com/drand/drand/log"
+	"github.com/drand/drand/publisher"
+)
+
+// Automatically set through -ldflags
+// Example: go install -ldflags "-X main.buildDate=$(date -u +%d/%m/%Y@%H:%M:%S) -X main.gitCommit=$(git rev-parse HEAD)"
+var (
+	gitCommit = "none"
+	buildDate = "unknown"
+)
+
+const (
+	sinkFS  = "fs"
+	sinkS3  = "s3"
+	sinkGCS = "gcs"
+)
+
+var (
+	sinkFlag = &cli.StringFlag{
+		Name:     "sink",
+		Usage:    fmt.Sprintf("Where to publish the randomness: %q, %q or %q", sinkFS, sinkS3, sinkGCS),
+		Required: true,
+	}
+	dirFlag = &cli.StringFlag{
+		Name:  "dir",
+		Usage: "Directory to write to, for the fs sink",
+	}
+	bucketFlag = &cli.StringFlag{
+		Name:  "bucket",
+		Usage: "Name of the bucket to upload to, for the s3 and gcs sinks",
+	}
+	regionFlag = &cli.StringFlag{
+		Name:  "region",
+		Usage: "Name of the region to use, for the s3 sink (optional)",
+	}
+	endpointFlag = &cli.StringFlag{
+		Name: "endpoint",
+		Usage: "Endpoint of an S3 or GCS compatible storage service, e.g. a local emulator (optional). " +
+			"The gcs sink also honors STORAGE_EMULATOR_HOST",
+	}
+	gcsTokenFlag = &cli.StringFlag{
+		Name:    "gcs-token",
+		Usage:   "OAuth2 access token for the gcs sink (optional)",
+		EnvVars: []string{"GOOGLE_OAUTH_ACCESS_TOKEN"},
+	}
+	prefixFlag = &cli.StringFlag{
+		Name:  "prefix",
+		Usage: "Path prefix of all the published objects, e.g. the chain hash (optional)",
+	}
+	sinkFlags = []cli.Flag{sinkFlag, dirFlag, bucketFlag, regionFlag, endpointFlag, gcsTokenFlag, prefixFlag}
+)
+
+func main() {
+	version := common.GetAppVersion()
+
+	app := &cli.App{
+		Name:     "drand-relay-static",
+		Version:  version.String(),
+		Usage:    "Relay a randomness beacon to static storage, following the layout of the HTTP API",
+		Commands: []*cli.Command{runCmd, syncCmd, snapshotCmd},
+	}
+	cli.VersionPrinter = func(c *cli.Context) {
+		fmt.Printf("drand static relay %s (date %v, commit %v)\n", version, buildDate, gitCommit)
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		fmt.Printf("error: %+v\n", err)
+		os.Exit(1)
+	}
+}
+
+var runCmd = &cli.Command{
+	Name:  "run",
+	Usage: "start a drand static relay process",
+	Flags: append(lib.ClientFlags, sinkFlags...),
+
+	Action: func(cctx *cli.Context) error {
+		pub, c, err := setup(cctx)
+		if err != nil {
+			return err
+		}
+		return pub.Watch(cctx.Context, c)
+	},
+}
+
+var syncCmd = &cli.Command{
+	Name:  "sync",
+	Usage: "publish all the rounds of the randomness chain missing from the sink",
+	Flags: append(
+		append(lib.ClientFlags, sinkFlags...),
+		&cli.Uint64Flag{
+			Name:  "begin",
+			Usage: "Begin syncing from this round number to the latest round.",
+			Value: 1,
+		},
+	),
+
+	Action: func(cctx *cli.Context) error {
+		pub, c, err := setup(cctx)
+		if err != nil {
+			return err
+		}
+		n, err := pub.Sync(cctx.Context, c, cctx.Uint64("begin"))
+		fmt.Printf("published %d rounds\n", n)
+		return err
+	},
+}
+
+var snapshotCmd = &cli.Command{
+	Name: "snapshot",
+	Usage: "render the randomness chain into a static directory following the paths of the HTTP API, " +
+		"with a manifest of checksums. Rounds already in the directory are kept",
+	Flags: append(
+		lib.ClientFlags,
+		&cli.StringFlag{
+			Name:     dirFlag.Name,
+			Usage:    "Directory to render the snapshot into",
+			Required: true,
+		},
+		&cli.BoolFlag{
+			Name: "split-checksums",
+			Usage: fmt.Sprintf("Split the checksums of the rounds into files of %d rounds, for chains with many rounds",
+				publisher.ChecksumsPerFile),
+		},
+	),
+
+	Action: func(cctx *cli.Context) error {
+		snap, err := publisher.NewSnapshot(cctx.String(dirFlag.Name), cctx.Bool("split-checksums"), nil, log.DefaultLogger().Named("relay_static"))
+		if err != nil {
+			return fmt.Errorf("opening snapshot: %w", err)
+		}
+
+		c, err := lib.Create(cctx, false)
+		if err != nil {
+			return fmt.Errorf("creating client: %w", err)
+		}
+		defer c.Close()
+
+		n, err := snap.Render(cctx.Context, publisher.NewClientSource(c))
+		fmt.Printf("rendered %d rounds\n", n)
+		return err
+	},
+}
+
+func setup(cctx *cli.Context) (*publisher.Publisher, client.Client, error) {
+	sink, err := buildSink(cctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c, err := lib.Create(cctx, false)
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating client: %w", err)
+	}
+
+	return publisher.New(sink, cctx.String(prefixFlag.Name), log.DefaultLogger().Named("relay_static")), c, nil
+}
+
+func buildSink(cctx *cli.Context) (publisher.Sink, error) {
+	switch cctx.String(sinkFlag.Name) {
+	case sinkFS:
+		if !cctx.IsSet(dirFlag.Name) {
+			return nil, fmt.Errorf("--%s is required for the %s sink", dirFlag.Name, sinkFS)
+		}
+		return publisher.NewFileSink(cctx.String(dirFlag.Name))
+	case sinkS3:
+		if !cctx.IsSet(bucketFlag.Name) {
+			return nil, fmt.Errorf("--%s is required for the %s sink", bucketFlag.Name, sinkS3)
+		}
+		cfg := &aws.Config{Region: aws.String(cctx.String(regionFlag.Name))}
+		if cctx.IsSet(endpointFlag.Name) {
+			cfg.Endpoint = aws.String(cctx.String(endpointFlag.Name))
+			cfg.S3ForcePathStyle = aws.Bool(true)
+		}
+		sess, err := session.NewSession(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("creating aws session: %w", err)
+		}
+		if _, err := sess.Config.Credentials.Get(); err != nil {
+			return nil, fmt.Errorf("checking credentials: %w", err)
+		}
+		return publisher.NewS3Sink(sess, cctx.String(bucketFlag.Name)), nil
+	case sinkGCS:
+		if !cctx.IsSet(bucketFlag.Name) {
+			return nil, fmt.Errorf("--%s is required for the %s sink", bucketFlag.Name, sinkGCS)
+		}
+		return publisher.NewGCSSink(cctx.String(endpointFlag.Name), cctx.String(bucketFlag.Name), cctx.String(gcsTokenFlag.Name)), nil
+	default:
+		return nil, fmt.Errorf("unknown sink %q", cctx.String(sinkFlag.Name))
+	}
+}
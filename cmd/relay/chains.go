@@ -0,0 +1,248 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/drand/drand/client"
+	dclient "github.com/drand/drand/client/http"
+	"github.com/drand/drand/cmd/client/lib"
+	"github.com/drand/drand/common"
+	dhttp "github.com/drand/drand/http"
+	"github.com/drand/drand/log"
+)
+
+var chainsFileFlag = &cli.StringFlag{
+	Name: "chains-file",
+	Usage: "file listing the hashes of the chains to relay, one per line. " +
+		"Empty lines and lines starting with '#' are ignored. The file is read again on SIGHUP",
+}
+
+var discoverChainsFlag = &cli.BoolFlag{
+	Name:  "discover-chains",
+	Usage: "relay all the chains served by the upstream HTTP endpoints given with --url",
+}
+
+var chainsRefreshFlag = &cli.DurationFlag{
+	Name:  "chains-refresh",
+	Usage: "interval at which the list of relayed chains is reloaded, in addition to SIGHUP (0 disables it)",
+}
+
+// chainSet keeps the beacon handlers of a relay in line with the configured
+// list of chains, registering and removing handlers as that list changes.
+type chainSet struct {
+	c                   *cli.Context
+	handler             *dhttp.DrandHandler
+	log                 log.Logger
+	withInstrumentation bool
+
+	lk      sync.Mutex
+	clients map[string]client.Client
+
+	// sources holds the hashes last read successfully from each source
+	sourcesLk sync.Mutex
+	sources   map[string][]string
+}
+
+func newChainSet(c *cli.Context, handler *dhttp.DrandHandler, l log.Logger, withInstrumentation bool) *chainSet {
+	return &chainSet{
+		c:                   c,
+		handler:             handler,
+		log:                 l,
+		withInstrumentation: withInstrumentation,
+		clients:             make(map[string]client.Client),
+		sources:             make(map[string][]string),
+	}
+}
+
+// reload computes the list of chains to relay and updates the beacon handlers
+// accordingly. It returns the number of chains relayed once done.
+func (s *chainSet) reload(ctx context.Context) int {
+	wanted := s.wanted(ctx)
+
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	for hash, c := range s.clients {
+		if wanted[hash] {
+			continue
+		}
+		delete(s.clients, hash)
+		done := s.handler.RemoveBeaconHandler(hash)
+		s.log.Infow("", "relay", "removing chain", "chain-hash", hash)
+		go func(hash string, c client.Client) {
+			<-done
+			if err := c.Close(); err != nil {
+				s.log.Warnw("", "relay", "failed to close client", "chain-hash", hash, "err", err)
+			}
+		}(hash, c)
+	}
+
+	for _, hash := range sortedKeys(wanted) {
+		if _, exists := s.clients[hash]; exists {
+			continue
+		}
+
+		chainHash := hash
+		if hash == common.DefaultChainHash {
+			chainHash = ""
+		}
+		subCli, err := lib.CreateWithHash(s.c, chainHash, s.withInstrumentation)
+		if err != nil {
+			s.log.Warnw("failed to create client", "hash", hash, "error", err)
+			continue
+		}
+
+		s.clients[hash] = subCli
+		s.handler.RegisterNewBeaconHandler(subCli, hash)
+		s.jumpstart(hash)
+	}
+
+	return len(s.clients)
+}
+
+// wanted returns the set of chains that should currently be relayed. The
+// chains of a source which is temporarily unavailable are the ones it listed
+// last.
+func (s *chainSet) wanted(ctx context.Context) map[string]bool {
+	wanted := make(map[string]bool)
+	add := func(source, hash string) {
+		if hash != common.DefaultChainHash {
+			if _, err := hex.DecodeString(hash); err != nil {
+				s.log.Warnw("", "relay", "invalid chain hash", "source", source, "chain-hash", hash, "err", err)
+				return
+			}
+		}
+		wanted[hash] = true
+	}
+
+	for _, hash := range s.c.StringSlice(lib.HashListFlag.Name) {
+		add(lib.HashListFlag.Name, hash)
+	}
+
+	if s.c.IsSet(chainsFileFlag.Name) {
+		hashes, err := readChainsFile(s.c.String(chainsFileFlag.Name))
+		if err != nil {
+			s.log.Warnw("", "relay", "failed to read chains file", "err", err)
+		}
+		for _, hash := range s.fromSource(chainsFileFlag.Name, hashes, err) {
+			add(chainsFileFlag.Name, hash)
+		}
+	}
+
+	if s.c.Bool(discoverChainsFlag.Name) {
+		for _, url := range s.c.StringSlice(lib.URLFlag.Name) {
+			hashes, err := dclient.ChainHashes(ctx, url)
+			if err != nil {
+				s.log.Warnw("", "relay", "failed to discover chains", "url", url, "err", err)
+			}
+			for _, hash := range s.fromSource(url, hashes, err) {
+				add(url, hash)
+			}
+		}
+	}
+
+	if len(wanted) == 0 && !s.c.IsSet(lib.HashListFlag.Name) && !s.c.IsSet(chainsFileFlag.Name) &&
+		!s.c.Bool(discoverChainsFlag.Name) {
+		wanted[common.DefaultChainHash] = true
+	}
+
+	return wanted
+}
+
+// fromSource returns the hashes read from a source, or the ones last read from
+// it if reading it failed, so that a transient error doesn't take its chains
+// offline.
+func (s *chainSet) fromSource(source string, hashes []string, err error) []string {
+	s.sourcesLk.Lock()
+	defer s.sourcesLk.Unlock()
+	if err != nil {
+		return s.sources[source]
+	}
+	s.sources[source] = hashes
+	return hashes
+}
+
+// jumpstart warms up the handler of the given chain so that it starts watching
+// for new beacons before the first request comes in.
+func (s *chainSet) jumpstart(hash string) {
+	req, _ := http.NewRequest(http.MethodGet, "/public/0", http.NoBody)
+	if hash != common.DefaultChainHash {
+		req, _ = http.NewRequest(http.MethodGet, fmt.Sprintf("/%s/public/0", hash), http.NoBody)
+	}
+
+	rr := httptest.NewRecorder()
+	s.handler.GetHTTPHandler().ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK {
+		s.log.Warnw("", "binary", "relay", "chain-hash", hash, "startup failed", rr.Code)
+	}
+}
+
+// watch reloads the list of chains on SIGHUP, and every interval if it is
+// positive, until ctx is done.
+func (s *chainSet) watch(ctx context.Context, interval time.Duration) {
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+	defer signal.Stop(sighup)
+
+	var tick <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sighup:
+			s.log.Infow("", "relay", "reloading chains on SIGHUP")
+		case <-tick:
+		}
+		s.log.Infow("", "relay", "chains reloaded", "count", s.reload(ctx))
+	}
+}
+
+// readChainsFile reads the chain hashes listed in the file at path.
+func readChainsFile(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var hashes []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		hashes = append(hashes, line)
+	}
+
+	return hashes, scanner.Err()
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
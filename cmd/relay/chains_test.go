@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+
+	"github.com/drand/drand/log"
+)
+
+func TestReadChainsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "chains")
+	content := "# relayed chains\n" +
+		"8990e7a9aaed2ffed73dbd7092123d6f289930540d7651336225dc172e51b2ce\n" +
+		"\n" +
+		"  default  \n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	hashes, err := readChainsFile(path)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"8990e7a9aaed2ffed73dbd7092123d6f289930540d7651336225dc172e51b2ce",
+		"default",
+	}, hashes)
+
+	_, err = readChainsFile(filepath.Join(t.TempDir(), "missing"))
+	require.Error(t, err)
+}
+
+func TestChainSetKeepsUnavailableSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "chains")
+	hash := "8990e7a9aaed2ffed73dbd7092123d6f289930540d7651336225dc172e51b2ce"
+	require.NoError(t, os.WriteFile(path, []byte(hash+"\n"), 0o600))
+
+	set := flag.NewFlagSet("relay", flag.ContinueOnError)
+	require.NoError(t, chainsFileFlag.Apply(set))
+	require.NoError(t, set.Parse([]string{"--chains-file", path}))
+	s := newChainSet(cli.NewContext(cli.NewApp(), set, nil), nil, log.DefaultLogger(), false)
+
+	require.Equal(t, map[string]bool{hash: true}, s.wanted(context.Background()))
+
+	// the chains file can't be read for a while
+	require.NoError(t, os.Remove(path))
+	require.Equal(t, map[string]bool{hash: true}, s.wanted(context.Background()))
+
+	// an empty file is a successful read
+	require.NoError(t, os.WriteFile(path, nil, 0o600))
+	require.Empty(t, s.wanted(context.Background()))
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"net"
 	"net/http"
-	"net/http/httptest"
 	"os"
 
 	"github.com/gorilla/handlers"
@@ -64,50 +63,19 @@ func Relay(c *cli.Context) error {
 		return fmt.Errorf("--%s is deprecated on relay http, please use %s instead", lib.HashFlag.Name, lib.HashListFlag.Name)
 	}
 
-	handler, err := dhttp.New(c.Context, fmt.Sprintf("drand/%s (%s)",
-		version, gitCommit), log.DefaultLogger().Named("relay"))
-	if err != nil {
-		return fmt.Errorf("failed to create rest handler: %w", err)
-	}
-
-	hashesMap := make(map[string]bool)
-	if c.IsSet(lib.HashListFlag.Name) {
-		hashesList := c.StringSlice(lib.HashListFlag.Name)
-		for _, hash := range hashesList {
-			hashesMap[hash] = true
-		}
-	} else {
-		hashesMap[common.DefaultChainHash] = true
-	}
-
-	skipHashes := make(map[string]bool)
-	for hash := range hashesMap {
-		// todo: don't reuse 'c'
-		if hash != common.DefaultChainHash {
-			if _, err := hex.DecodeString(hash); err != nil {
-				return fmt.Errorf("failed to decode chain hash value: %w", err)
-			}
-			if err := c.Set(lib.HashFlag.Name, hash); err != nil {
-				return fmt.Errorf("failed to initiate chain hash handler: %w", err)
-			}
-		} else {
-			if err := c.Set(lib.HashFlag.Name, ""); err != nil {
-				return fmt.Errorf("failed to initiate default chain hash handler: %w", err)
-			}
-		}
-
-		subCli, err := lib.Create(c, c.IsSet(metricsFlag.Name))
-		if err != nil {
-			log.DefaultLogger().Warnw("failed to create client", "hash", hash, "error", err)
-			skipHashes[hash] = true
+	for _, hash := range c.StringSlice(lib.HashListFlag.Name) {
+		if hash == common.DefaultChainHash {
 			continue
 		}
-
-		handler.RegisterNewBeaconHandler(subCli, hash)
+		if _, err := hex.DecodeString(hash); err != nil {
+			return fmt.Errorf("failed to decode chain hash value: %w", err)
+		}
 	}
 
-	if len(skipHashes) == len(hashesMap) {
-		return fmt.Errorf("failed to create any beacon handlers")
+	handler, err := dhttp.New(c.Context, fmt.Sprintf("drand/%s (%s)",
+		version, gitCommit), log.DefaultLogger().Named("relay"))
+	if err != nil {
+		return fmt.Errorf("failed to create rest handler: %w", err)
 	}
 
 	if c.IsSet(accessLogFlag.Name) {
@@ -130,23 +98,12 @@ func Relay(c *cli.Context) error {
 		return err
 	}
 
-	// jumpstart bootup
-	for hash := range hashesMap {
-		if skipHashes[hash] {
-			continue
-		}
-
-		req, _ := http.NewRequest(http.MethodGet, "/public/0", http.NoBody)
-		if hash != common.DefaultChainHash {
-			req, _ = http.NewRequest(http.MethodGet, fmt.Sprintf("/%s/public/0", hash), http.NoBody)
-		}
-
-		rr := httptest.NewRecorder()
-		handler.GetHTTPHandler().ServeHTTP(rr, req)
-		if rr.Code != http.StatusOK {
-			log.DefaultLogger().Warnw("", "binary", "relay", "chain-hash", hash, "startup failed", rr.Code)
-		}
+	// registering the handlers also jumpstarts them
+	chains := newChainSet(c, handler, log.DefaultLogger().Named("relay"), c.IsSet(metricsFlag.Name))
+	if chains.reload(c.Context) == 0 {
+		return fmt.Errorf("failed to create any beacon handlers")
 	}
+	go chains.watch(c.Context, c.Duration(chainsRefreshFlag.Name))
 
 	fmt.Printf("Listening at %s\n", listener.Addr())
 	return http.Serve(listener, handler.GetHTTPHandler())
@@ -159,8 +116,9 @@ func main() {
 		Name:    "relay",
 		Version: version.String(),
 		Usage:   "Relay a Drand group to a public HTTP Rest API",
-		Flags:   append(lib.ClientFlags, lib.HashListFlag, listenFlag, accessLogFlag, metricsFlag),
-		Action:  Relay,
+		Flags: append(lib.ClientFlags, lib.HashListFlag, listenFlag, accessLogFlag, metricsFlag,
+			chainsFileFlag, discoverChainsFlag, chainsRefreshFlag),
+		Action: Relay,
 	}
 	cli.VersionPrinter = func(c *cli.Context) {
 		fmt.Printf("drand HTTP relay %v (date %v, commit %v)\n", version, buildDate, gitCommit)
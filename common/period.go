@@ -0,0 +1,23 @@
+package common
+
+import "time"
+
+// PeriodToProto returns the wire format of a period: whole seconds, and the
+// period in milliseconds when it isn't a whole number of seconds. Nodes that
+// don't know the milliseconds only see whole-second periods.
+func PeriodToProto(d time.Duration) (seconds, millis uint32) {
+	seconds = uint32(d / time.Second)
+	if d%time.Second != 0 {
+		millis = uint32(d / time.Millisecond)
+	}
+	return seconds, millis
+}
+
+// PeriodFromProto returns the period from its wire format, preferring the
+// milliseconds when they are set.
+func PeriodFromProto(seconds, millis uint32) time.Duration {
+	if millis != 0 {
+		return time.Duration(millis) * time.Millisecond
+	}
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,26 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeriodProto(t *testing.T) {
+	for _, v := range []struct {
+		period  time.Duration
+		seconds uint32
+		millis  uint32
+	}{
+		{30 * time.Second, 30, 0},
+		{time.Second, 1, 0},
+		{500 * time.Millisecond, 0, 500},
+		{1500 * time.Millisecond, 1, 1500},
+	} {
+		seconds, millis := PeriodToProto(v.period)
+		require.Equal(t, v.seconds, seconds, v.period)
+		require.Equal(t, v.millis, millis, v.period)
+		require.Equal(t, v.period, PeriodFromProto(seconds, millis))
+	}
+}
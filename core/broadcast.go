@@ -55,10 +55,23 @@ type echoBroadcast struct {
 	dispatcher *dispatcher
 	// list of messages already retransmitted comparison by hash
 	hashes set
-	dealCh chan dkg.DealBundle
-	respCh chan dkg.ResponseBundle
-	justCh chan dkg.JustificationBundle
-	verif  verifier
+	// dealCh, respCh and justCh buffer the packets received, until forward
+	// hands them over to the application through the unbuffered appDealCh,
+	// appRespCh and appJustCh
+	dealCh    chan dkg.DealBundle
+	respCh    chan dkg.ResponseBundle
+	justCh    chan dkg.JustificationBundle
+	appDealCh chan dkg.DealBundle
+	appRespCh chan dkg.ResponseBundle
+	appJustCh chan dkg.JustificationBundle
+	verif     verifier
+	// journal persists the packets sent and received, can be nil
+	journal *dkgJournal
+	// progress tracks the packets sent and received, can be nil
+	progress *dkgProgress
+	stopped  bool
+	// done is closed when the broadcast is stopped
+	done chan struct{}
 }
 
 type packet = dkg.Packet
@@ -70,8 +83,8 @@ var _ Broadcast = (*echoBroadcast)(nil)
 type verifier func(packet) error
 
 func newEchoBroadcast(l log.Logger, version commonutils.Version, beaconID string,
-	c net.ProtocolClient, own string, to []*key.Node, v verifier) *echoBroadcast {
-	return &echoBroadcast{
+	c net.ProtocolClient, own string, to []*key.Node, v verifier, j *dkgJournal, pr *dkgProgress) *echoBroadcast {
+	b := &echoBroadcast{
 		l:          l,
 		version:    version,
 		beaconID:   beaconID,
@@ -79,12 +92,42 @@ func newEchoBroadcast(l log.Logger, version commonutils.Version, beaconID string
 		dealCh:     make(chan dkg.DealBundle, len(to)),
 		respCh:     make(chan dkg.ResponseBundle, len(to)),
 		justCh:     make(chan dkg.JustificationBundle, len(to)),
+		appDealCh:  make(chan dkg.DealBundle),
+		appRespCh:  make(chan dkg.ResponseBundle),
+		appJustCh:  make(chan dkg.JustificationBundle),
 		hashes:     new(arraySet),
 		verif:      v,
+		journal:    j,
+		progress:   pr,
+		done:       make(chan struct{}),
 	}
+	go b.forward()
+	return b
+}
+
+// own records a packet of this node before it is pushed, and returns the
+// packet to push instead: the one of the same phase this node sent before a
+// restart, if any. It returns nil once the broadcast is stopped.
+func (b *echoBroadcast) own(p packet) packet {
+	b.Lock()
+	defer b.Unlock()
+	if b.stopped {
+		return nil
+	}
+	p, err := b.journal.ownPacket(p)
+	if err != nil {
+		b.l.Errorw("", "echoBroadcast", "can't persist packet", "err", err)
+	}
+	b.progress.received(p)
+	return p
 }
 
 func (b *echoBroadcast) PushDeals(bundle *dkg.DealBundle) {
+	p := b.own(bundle)
+	if p == nil {
+		return
+	}
+	bundle = p.(*dkg.DealBundle)
 	b.dealCh <- *bundle
 	b.Lock()
 	defer b.Unlock()
@@ -94,6 +137,11 @@ func (b *echoBroadcast) PushDeals(bundle *dkg.DealBundle) {
 }
 
 func (b *echoBroadcast) PushResponses(bundle *dkg.ResponseBundle) {
+	p := b.own(bundle)
+	if p == nil {
+		return
+	}
+	bundle = p.(*dkg.ResponseBundle)
 	b.respCh <- *bundle
 	b.Lock()
 	defer b.Unlock()
@@ -103,6 +151,11 @@ func (b *echoBroadcast) PushResponses(bundle *dkg.ResponseBundle) {
 }
 
 func (b *echoBroadcast) PushJustifications(bundle *dkg.JustificationBundle) {
+	p := b.own(bundle)
+	if p == nil {
+		return
+	}
+	bundle = p.(*dkg.JustificationBundle)
 	b.justCh <- *bundle
 	b.Lock()
 	defer b.Unlock()
@@ -136,12 +189,91 @@ func (b *echoBroadcast) BroadcastDKG(c context.Context, p *drand.DKGPacket) (*dr
 
 	b.l.Debugw("", "echoBroadcast",
 		"received new packet to echoBroadcast", "from", addr, "index", dkgPacket.Index(), "type", fmt.Sprintf("%T", dkgPacket))
+	if err := b.journal.recordReceived(dkgPacket); err != nil {
+		b.l.Errorw("", "echoBroadcast", "can't persist packet", "err", err)
+	}
 	b.sendout(hash, dkgPacket, false) // we're using the rate limiting
 	b.passToApplication(dkgPacket)
 	return new(drand.Empty), nil
 }
 
+// replay passes the packets received before a restart to the application
+// again, and returns once it read them, so that they are processed before the
+// phaser moves the protocol to the next phases. The packets this node sent
+// before are marked as seen, since they are pushed again by the protocol.
+func (b *echoBroadcast) replay(sent, received []packet) {
+	b.Lock()
+	for _, p := range sent {
+		b.hashes.put(hash(p.Hash()))
+	}
+	var replayed []packet
+	for _, p := range received {
+		h := hash(p.Hash())
+		if b.hashes.exists(h) {
+			continue
+		}
+		b.hashes.put(h)
+		replayed = append(replayed, p)
+	}
+	b.Unlock()
+
+	for _, p := range replayed {
+		b.progress.received(p)
+		if !b.handOver(p) {
+			return
+		}
+	}
+}
+
+// forward hands the packets received over to the application, until the
+// broadcast is stopped.
+func (b *echoBroadcast) forward() {
+	for {
+		var p packet
+		select {
+		case d := <-b.dealCh:
+			p = &d
+		case r := <-b.respCh:
+			p = &r
+		case j := <-b.justCh:
+			p = &j
+		case <-b.done:
+			return
+		}
+		if !b.handOver(p) {
+			return
+		}
+	}
+}
+
+// handOver blocks until the application reads the packet. It returns false
+// if the broadcast is stopped before.
+func (b *echoBroadcast) handOver(p packet) bool {
+	switch pp := p.(type) {
+	case *dkg.DealBundle:
+		select {
+		case b.appDealCh <- *pp:
+		case <-b.done:
+			return false
+		}
+	case *dkg.ResponseBundle:
+		select {
+		case b.appRespCh <- *pp:
+		case <-b.done:
+			return false
+		}
+	case *dkg.JustificationBundle:
+		select {
+		case b.appJustCh <- *pp:
+		case <-b.done:
+			return false
+		}
+	}
+	return true
+}
+
 func (b *echoBroadcast) passToApplication(p packet) {
+	b.progress.received(p)
 	switch pp := p.(type) {
 	case *dkg.DealBundle:
 		b.dealCh <- *pp
@@ -183,18 +315,25 @@ func (b *echoBroadcast) sendout(h []byte, p packet, bypass bool) {
 }
 
 func (b *echoBroadcast) IncomingDeal() <-chan dkg.DealBundle {
-	return b.dealCh
+	return b.appDealCh
 }
 
 func (b *echoBroadcast) IncomingResponse() <-chan dkg.ResponseBundle {
-	return b.respCh
+	return b.appRespCh
 }
 
 func (b *echoBroadcast) IncomingJustification() <-chan dkg.JustificationBundle {
-	return b.justCh
+	return b.appJustCh
 }
 
 func (b *echoBroadcast) Stop() {
+	b.Lock()
+	defer b.Unlock()
+	if b.stopped {
+		return
+	}
+	b.stopped = true
+	close(b.done)
 	b.dispatcher.stop()
 }
 
@@ -83,7 +83,7 @@ func TestBroadcast(t *testing.T) {
 		id := d.priv.Public.Address()
 		version := common.GetAppVersion()
 		b := newEchoBroadcast(d.log, version, beaconID, d.privGateway.ProtocolClient,
-			id, group.Nodes, func(dkg.Packet) error { return nil })
+			id, group.Nodes, func(dkg.Packet) error { return nil }, nil, nil)
 
 		d.dkgInfo = &dkgInfo{
 			board:   withCallback(id, b, callback),
@@ -113,8 +113,7 @@ func TestBroadcast(t *testing.T) {
 	waitForAll(exp)
 	for _, b := range broads {
 		require.True(t, b.hashes.exists(hash))
-		require.True(t, len(b.dealCh) == 1, "len of channel is %d", len(b.dealCh))
-		drain(t, b.dealCh)
+		require.Equal(t, 1, drain(t, b.appDealCh))
 	}
 
 	// try again to broadcast but it shouldn't actually do it because the first
@@ -122,7 +121,7 @@ func TestBroadcast(t *testing.T) {
 	_, err := broads[0].BroadcastDKG(context.Background(), dealPacket)
 	require.NoError(t, err)
 	checkEmpty(t, incPackets)
-	require.Len(t, broads[0].dealCh, 0)
+	require.Zero(t, drain(t, broads[0].appDealCh))
 
 	// let's make everyone broadcast a different packet
 	hashes := make([][]byte, 0, n-1)
@@ -141,10 +140,19 @@ func TestBroadcast(t *testing.T) {
 	}
 
 	// check that it dispatches to the correct channel
+	require.Equal(t, n-1, drain(t, broads[0].appDealCh))
 	broads[0].passToApplication(&dkg.ResponseBundle{})
-	require.True(t, len(broads[0].respCh) == 1)
+	select {
+	case <-broads[0].IncomingResponse():
+	case <-time.After(time.Second):
+		require.Fail(t, "response not passed to the application")
+	}
 	broads[0].passToApplication(&dkg.JustificationBundle{})
-	require.True(t, len(broads[0].justCh) == 1)
+	select {
+	case <-broads[0].IncomingJustification():
+	case <-time.After(time.Second):
+		require.Fail(t, "justification not passed to the application")
+	}
 }
 
 func sendNewDeal(t *testing.T, b *echoBroadcast) (packet *drand.DKGPacket, hash []byte) {
@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/drand/drand/chain"
+	"github.com/drand/drand/chain/beacon"
 	"github.com/drand/drand/common"
 	"github.com/drand/drand/key"
 	"github.com/drand/drand/log"
@@ -35,6 +36,10 @@ type Config struct {
 	dkgCallback       func(*key.Share, *key.Group)
 	certPath          string
 	keyPath           string
+	keyPassphrase     []byte
+	signer            beacon.PartialSigner
+	overrideProtect   bool
+	streamPartials    bool
 	certmanager       *net.CertManager
 	logger            log.Logger
 	clock             clock.Clock
@@ -69,12 +74,42 @@ func (d *Config) ConfigFolderMB() string {
 	return path.Join(d.configFolder, common.MultiBeaconFolder)
 }
 
+// KeyStore returns the key store of the given beacon, encrypted if a
+// passphrase is set.
+func (d *Config) KeyStore(beaconID string) key.Store {
+	if len(d.keyPassphrase) > 0 {
+		return key.NewEncryptedFileStore(d.ConfigFolderMB(), beaconID, d.keyPassphrase)
+	}
+	return key.NewFileStore(d.ConfigFolderMB(), beaconID)
+}
+
+// KeyStores returns the key stores of all the beacons found in the
+// configuration folder, encrypted if a passphrase is set.
+func (d *Config) KeyStores() (map[string]key.Store, error) {
+	if len(d.keyPassphrase) > 0 {
+		return key.NewEncryptedFileStores(d.ConfigFolderMB(), d.keyPassphrase)
+	}
+	return key.NewFileStores(d.ConfigFolderMB())
+}
+
 // DBFolder returns the folder under which drand stores db file specifically.
 // If beacon id is empty, it will use the default value
 func (d *Config) DBFolder(beaconID string) string {
 	return path.Join(d.ConfigFolderMB(), common.GetCanonicalBeaconID(beaconID), DefaultDBFolder)
 }
 
+// DKGFolder returns the folder under which drand persists the state of the DKG
+// in progress, if any. If beacon id is empty, it will use the default value
+func (d *Config) DKGFolder(beaconID string) string {
+	return path.Join(d.ConfigFolderMB(), common.GetCanonicalBeaconID(beaconID), DefaultDKGFolder)
+}
+
+// ProtectionFolder returns the folder under which drand records the partial
+// beacons signed last. If beacon id is empty, it will use the default value
+func (d *Config) ProtectionFolder(beaconID string) string {
+	return path.Join(d.ConfigFolderMB(), common.GetCanonicalBeaconID(beaconID), DefaultProtectionFolder)
+}
+
 // Certs returns all custom certs currently being trusted by drand.
 func (d *Config) Certs() *net.CertManager {
 	return d.certmanager
@@ -183,6 +218,40 @@ func WithDKGCallback(fn func(*key.Share, *key.Group)) ConfigOption {
 	}
 }
 
+// WithKeyPassphrase sets the passphrase encrypting the private key and the
+// share of each beacon. Files in plain text are still loaded, and encrypted
+// when saved again.
+func WithKeyPassphrase(passphrase []byte) ConfigOption {
+	return func(d *Config) {
+		d.keyPassphrase = passphrase
+	}
+}
+
+// WithPartialSigner sets the signer that signs the partial beacons of every
+// beacon process, instead of signing them with the shares directly.
+func WithPartialSigner(signer beacon.PartialSigner) ConfigOption {
+	return func(d *Config) {
+		d.signer = signer
+	}
+}
+
+// WithSigningProtectionOverride makes drand sign the partial beacons which
+// conflict with the ones it signed before, instead of refusing to. Conflicts
+// are still logged and counted.
+func WithSigningProtectionOverride() ConfigOption {
+	return func(d *Config) {
+		d.overrideProtect = true
+	}
+}
+
+// WithPartialStreams makes drand send the partial beacons to each node of the
+// group over a long-lived stream, instead of a call per partial beacon.
+func WithPartialStreams() ConfigOption {
+	return func(d *Config) {
+		d.streamPartials = true
+	}
+}
+
 // WithInsecure allows drand to listen on standard non-encrypted port and to
 // contact other nodes over non-encrypted TCP connections.
 func WithInsecure() ConfigOption {
@@ -22,6 +22,23 @@ func DefaultConfigFolder() string {
 // It is relative to the DefaultConfigFolder path.
 const DefaultDBFolder = "db"
 
+// DefaultDKGFolder is the name of the folder in which the state of a DKG in
+// progress is saved, so that it can be resumed after a restart. It is relative
+// to the folder of the beacon.
+const DefaultDKGFolder = "dkg"
+
+// DefaultProtectionFolder is the name of the folder in which the partial
+// beacons signed last are recorded, to never sign conflicting ones. It is
+// relative to the folder of the beacon.
+const DefaultProtectionFolder = "protection"
+
+// protectionFileName is the name of the database of the signed partial beacons
+const protectionFileName = "signed.db"
+
+// haltFileName is the name of the file in which the votes to halt the chain
+// are recorded. It is stored in the folder of the chain database.
+const haltFileName = "halt.json"
+
 // DefaultBeaconPeriod is the period in which the beacon logic creates new
 // random beacon.
 const DefaultBeaconPeriod time.Duration = 1 * time.Minute
@@ -3,8 +3,10 @@ package core
 import (
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/drand/drand/chain"
+	"github.com/drand/drand/chain/beacon"
 	"github.com/drand/drand/key"
 	pdkg "github.com/drand/drand/protobuf/crypto/dkg"
 	"github.com/drand/drand/protobuf/drand"
@@ -21,6 +23,20 @@ func beaconToProto(b *chain.Beacon) *drand.PublicRandResponse {
 	}
 }
 
+func participationToProto(r *beacon.RoundParticipation) *drand.RoundParticipation {
+	partials := make([]*drand.PartialArrival, 0, len(r.Partials))
+	for index, latency := range r.Partials {
+		partials = append(partials, &drand.PartialArrival{Index: index, Latency: int64(latency)})
+	}
+	sort.Slice(partials, func(i, j int) bool { return partials[i].Index < partials[j].Index })
+	return &drand.RoundParticipation{
+		Round:      r.Round,
+		Partials:   partials,
+		Invalid:    uint32(r.Invalid),
+		Aggregated: r.Aggregated,
+	}
+}
+
 func protoToDKGPacket(d *pdkg.Packet) (dkg.Packet, error) {
 	switch packet := d.GetBundle().(type) {
 	case *pdkg.Packet_Deal:
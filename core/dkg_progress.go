@@ -0,0 +1,182 @@
+package core
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/drand/drand/key"
+	"github.com/drand/drand/protobuf/drand"
+	"github.com/drand/kyber/share/dkg"
+)
+
+// dkgProgress tracks the progress of a DKG or resharing as seen by this node:
+// its phase, the packets received from each node and the outcome. Watchers
+// are notified of each change. All its methods can be called on a nil
+// progress, in which case nothing is tracked.
+type dkgProgress struct {
+	sync.Mutex
+	reshare  bool
+	dealers  []*key.Node
+	holders  []*key.Node
+	phase    dkg.Phase
+	deadline time.Time
+	// indexes of the nodes whose packets were received, per kind
+	deals          map[uint32]bool
+	responses      map[uint32]bool
+	justifications map[uint32]bool
+	complaints     []*drand.DKGComplaint
+	qual           []uint32
+	done           bool
+	err            error
+	watchers       map[chan struct{}]bool
+}
+
+// newDKGProgress returns the progress of a DKG where dealers deal shares to
+// holders. They are the same nodes in a fresh DKG.
+func newDKGProgress(reshare bool, dealers, holders []*key.Node) *dkgProgress {
+	return &dkgProgress{
+		reshare:        reshare,
+		dealers:        dealers,
+		holders:        holders,
+		deals:          make(map[uint32]bool),
+		responses:      make(map[uint32]bool),
+		justifications: make(map[uint32]bool),
+		watchers:       make(map[chan struct{}]bool),
+	}
+}
+
+// setPhase records the phase the protocol moved to, and the time it ends at.
+func (p *dkgProgress) setPhase(phase dkg.Phase, deadline time.Time) {
+	if p == nil {
+		return
+	}
+	p.Lock()
+	defer p.Unlock()
+	if p.done {
+		return
+	}
+	p.phase = phase
+	p.deadline = deadline
+	p.notify()
+}
+
+// received records a packet sent by this node or received from another one.
+func (p *dkgProgress) received(pkt packet) {
+	if p == nil {
+		return
+	}
+	p.Lock()
+	defer p.Unlock()
+	switch b := pkt.(type) {
+	case *dkg.DealBundle:
+		p.deals[b.DealerIndex] = true
+	case *dkg.ResponseBundle:
+		if p.responses[b.ShareIndex] {
+			return
+		}
+		p.responses[b.ShareIndex] = true
+		for _, r := range b.Responses {
+			if r.Status == dkg.Complaint {
+				p.complaints = append(p.complaints, &drand.DKGComplaint{
+					ShareHolder: b.ShareIndex,
+					Dealer:      r.DealerIndex,
+				})
+			}
+		}
+	case *dkg.JustificationBundle:
+		p.justifications[b.DealerIndex] = true
+	default:
+		return
+	}
+	p.notify()
+}
+
+// finish records the outcome of the protocol.
+func (p *dkgProgress) finish(res dkg.OptionResult) {
+	if p == nil {
+		return
+	}
+	p.Lock()
+	defer p.Unlock()
+	p.done = true
+	p.phase = dkg.FinishPhase
+	p.deadline = time.Time{}
+	p.err = res.Error
+	if res.Result != nil {
+		for _, n := range res.Result.QUAL {
+			p.qual = append(p.qual, n.Index)
+		}
+		sort.Slice(p.qual, func(i, j int) bool { return p.qual[i] < p.qual[j] })
+	}
+	p.notify()
+}
+
+// watch returns a channel that receives a value after each change of the
+// progress, and the function to call to stop watching it. Changes happening
+// before the value is read are coalesced.
+func (p *dkgProgress) watch() (changes <-chan struct{}, stop func()) {
+	ch := make(chan struct{}, 1)
+	p.Lock()
+	p.watchers[ch] = true
+	p.Unlock()
+	return ch, func() {
+		p.Lock()
+		delete(p.watchers, ch)
+		p.Unlock()
+	}
+}
+
+// notify requires the lock of the progress.
+func (p *dkgProgress) notify() {
+	for ch := range p.watchers {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// isDone returns true once the protocol finished, successfully or not.
+func (p *dkgProgress) isDone() bool {
+	p.Lock()
+	defer p.Unlock()
+	return p.done
+}
+
+// toProto returns a snapshot of the progress.
+func (p *dkgProgress) toProto() *drand.DKGProgress {
+	p.Lock()
+	defer p.Unlock()
+	out := &drand.DKGProgress{
+		Reshare:      p.reshare,
+		Phase:        uint32(p.phase),
+		Complaints:   append([]*drand.DKGComplaint(nil), p.complaints...),
+		Qual:         append([]uint32(nil), p.qual...),
+		Done:         p.done,
+		Dealers:      make([]*drand.DKGParticipant, len(p.dealers)),
+		ShareHolders: make([]*drand.DKGParticipant, len(p.holders)),
+	}
+	if !p.deadline.IsZero() {
+		out.PhaseDeadline = p.deadline.Unix()
+	}
+	if p.err != nil {
+		out.Error = p.err.Error()
+	}
+	for i, n := range p.dealers {
+		out.Dealers[i] = &drand.DKGParticipant{
+			Index:         n.Index,
+			Address:       n.Address(),
+			Deal:          p.deals[n.Index],
+			Justification: p.justifications[n.Index],
+		}
+	}
+	for i, n := range p.holders {
+		out.ShareHolders[i] = &drand.DKGParticipant{
+			Index:    n.Index,
+			Address:  n.Address(),
+			Response: p.responses[n.Index],
+		}
+	}
+	return out
+}
@@ -0,0 +1,79 @@
+package core
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/common/scheme"
+	"github.com/drand/drand/test"
+	"github.com/drand/kyber/share/dkg"
+)
+
+func TestDKGProgress(t *testing.T) {
+	_, group := test.BatchIdentities(4, scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv())
+	p := newDKGProgress(false, group.Nodes, group.Nodes)
+	changes, stop := p.watch()
+	defer stop()
+
+	status := p.toProto()
+	require.Equal(t, uint32(dkg.InitPhase), status.Phase)
+	require.Zero(t, status.PhaseDeadline)
+	require.Len(t, status.Dealers, 4)
+	require.Len(t, status.ShareHolders, 4)
+
+	deadline := time.Unix(1000, 0)
+	p.setPhase(dkg.DealPhase, deadline)
+	<-changes
+	p.received(testDeal("deal"))
+	p.received(&dkg.ResponseBundle{
+		ShareIndex: 2,
+		Responses:  []dkg.Response{{DealerIndex: 1, Status: dkg.Success}, {DealerIndex: 3, Status: dkg.Complaint}},
+	})
+	// a duplicate response doesn't add the complaints twice
+	p.received(&dkg.ResponseBundle{
+		ShareIndex: 2,
+		Responses:  []dkg.Response{{DealerIndex: 3, Status: dkg.Complaint}},
+	})
+	<-changes
+
+	status = p.toProto()
+	require.Equal(t, uint32(dkg.DealPhase), status.Phase)
+	require.Equal(t, deadline.Unix(), status.PhaseDeadline)
+	for _, n := range status.Dealers {
+		require.Equal(t, n.Index == 1, n.Deal, "dealer %d", n.Index)
+		require.False(t, n.Justification)
+	}
+	for _, n := range status.ShareHolders {
+		require.Equal(t, n.Index == 2, n.Response, "share holder %d", n.Index)
+	}
+	require.Len(t, status.Complaints, 1)
+	require.Equal(t, uint32(2), status.Complaints[0].ShareHolder)
+	require.Equal(t, uint32(3), status.Complaints[0].Dealer)
+
+	p.finish(dkg.OptionResult{Result: &dkg.Result{QUAL: []dkg.Node{{Index: 2}, {Index: 0}, {Index: 1}}}})
+	<-changes
+	// the phaser can't move a finished protocol
+	p.setPhase(dkg.JustifPhase, deadline)
+	status = p.toProto()
+	require.True(t, status.Done)
+	require.Empty(t, status.Error)
+	require.Equal(t, uint32(dkg.FinishPhase), status.Phase)
+	require.Equal(t, []uint32{0, 1, 2}, status.Qual)
+
+	failed := newDKGProgress(true, group.Nodes[:3], group.Nodes)
+	failed.finish(dkg.OptionResult{Error: errors.New("not enough qualified nodes")})
+	status = failed.toProto()
+	require.True(t, status.Reshare)
+	require.True(t, status.Done)
+	require.Len(t, status.Dealers, 3)
+	require.Equal(t, "not enough qualified nodes", status.Error)
+
+	// nothing is tracked without a progress
+	var none *dkgProgress
+	none.setPhase(dkg.DealPhase, deadline)
+	none.received(testDeal("deal"))
+	none.finish(dkg.OptionResult{})
+}
@@ -0,0 +1,373 @@
+package core
+
+import (
+	"bufio"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	commonutils "github.com/drand/drand/common"
+	"github.com/drand/drand/fs"
+	"github.com/drand/drand/key"
+	pdkg "github.com/drand/drand/protobuf/crypto/dkg"
+	"github.com/drand/drand/protobuf/drand"
+	"github.com/drand/kyber/share/dkg"
+	"github.com/drand/kyber/util/random"
+	"github.com/drand/kyber/xof/blake2xb"
+)
+
+const (
+	// dkgStateFile is the name of the file holding the parameters of the DKG
+	// in progress, in the DKG folder of a beacon.
+	dkgStateFile = "state.json"
+	// dkgPacketsFile is the name of the file the packets sent and received
+	// during the DKG in progress are appended to.
+	dkgPacketsFile = "packets"
+	// dkgSeedLength is the length of the seed the randomness of a node in a
+	// DKG is derived from.
+	dkgSeedLength = 32
+)
+
+// kinds of the records of the packets file
+const (
+	sentRecord byte = iota
+	receivedRecord
+)
+
+// dkgState is the persisted state of a DKG or resharing in progress, from
+// which a node that restarts can rejoin it before its phases are over.
+type dkgState struct {
+	Reshare bool
+	// Timeout is the duration of each phase, in seconds
+	Timeout uint32
+	// Created is the unix time the DKG was set up at
+	Created int64
+	// Start is the unix time the phaser of this node started at, zero until
+	// then
+	Start int64
+	// Seed is the seed of the secret and polynomial of this node, so that it
+	// deals the same shares after a restart. It is only persisted in plain
+	// text when the key store is not encrypted.
+	Seed []byte `json:",omitempty"`
+	// EncryptedSeed is the seed encrypted with the passphrase of the key store
+	EncryptedSeed *key.EncryptedTOML `json:",omitempty"`
+	// Target is the group being created, and Previous the group reshared
+	// from, as protobuf
+	Target   []byte
+	Previous []byte
+}
+
+// dkgJournal persists the state of a DKG in progress, and the packets
+// exchanged during it. All its methods can be called on a nil journal, in
+// which case nothing is persisted.
+type dkgJournal struct {
+	sync.Mutex
+	folder   string
+	state    dkgState
+	sent     []packet
+	received []packet
+}
+
+// newDKGJournal creates the journal of a new DKG in folder, replacing any
+// previous one. The seed is encrypted with the passphrase, if any.
+func newDKGJournal(folder string, state *dkgState, passphrase []byte) (*dkgJournal, error) {
+	if err := os.RemoveAll(folder); err != nil {
+		return nil, err
+	}
+	fs.CreateSecureFolder(folder)
+	j := &dkgJournal{folder: folder, state: *state}
+	if len(passphrase) > 0 {
+		sealed, err := key.Encrypt(j.state.Seed, passphrase)
+		if err != nil {
+			return nil, err
+		}
+		j.state.EncryptedSeed = sealed
+	}
+	if err := j.save(); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+// loadDKGJournal loads the journal of the DKG in progress from folder. It
+// returns an error satisfying os.IsNotExist if there is none. An encrypted
+// seed is decrypted with the passphrase.
+func loadDKGJournal(folder string, passphrase []byte) (*dkgJournal, error) {
+	buff, err := os.ReadFile(path.Join(folder, dkgStateFile))
+	if err != nil {
+		return nil, err
+	}
+	j := &dkgJournal{folder: folder}
+	if err := json.Unmarshal(buff, &j.state); err != nil {
+		return nil, fmt.Errorf("invalid dkg state: %w", err)
+	}
+	if j.state.EncryptedSeed != nil {
+		if j.state.Seed, err = j.state.EncryptedSeed.Decrypt(passphrase); err != nil {
+			return nil, fmt.Errorf("can't decrypt dkg seed: %w", err)
+		}
+	}
+
+	f, err := os.Open(path.Join(folder, dkgPacketsFile))
+	if os.IsNotExist(err) {
+		return j, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+	for {
+		var header [5]byte
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			// a record only partially written before a crash is dropped
+			break
+		}
+		buff := make([]byte, binary.BigEndian.Uint32(header[1:]))
+		if _, err := io.ReadFull(r, buff); err != nil {
+			break
+		}
+		pp := new(pdkg.Packet)
+		if err := proto.Unmarshal(buff, pp); err != nil {
+			return nil, fmt.Errorf("invalid dkg packet: %w", err)
+		}
+		p, err := protoToDKGPacket(pp)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dkg packet: %w", err)
+		}
+		if header[0] == sentRecord {
+			j.sent = append(j.sent, p)
+		} else {
+			j.received = append(j.received, p)
+		}
+	}
+	return j, nil
+}
+
+// save writes the state atomically, without the seed in plain text if it is
+// encrypted. It requires the journal lock.
+func (j *dkgJournal) save() error {
+	state := j.state
+	if state.EncryptedSeed != nil {
+		state.Seed = nil
+	}
+	buff, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	tmp := path.Join(j.folder, dkgStateFile+".tmp")
+	if err := os.WriteFile(tmp, buff, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path.Join(j.folder, dkgStateFile))
+}
+
+// append writes a packet record to the packets file. It requires the journal
+// lock.
+func (j *dkgJournal) append(kind byte, p packet) error {
+	pp, err := dkgPacketToProto(p)
+	if err != nil {
+		return err
+	}
+	buff, err := proto.Marshal(pp)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path.Join(j.folder, dkgPacketsFile), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	var header [5]byte
+	header[0] = kind
+	binary.BigEndian.PutUint32(header[1:], uint32(len(buff)))
+	if _, err := f.Write(append(header[:], buff...)); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+// started records the time the phaser started at, unless it already started
+// before a restart.
+func (j *dkgJournal) started(t time.Time) error {
+	if j == nil {
+		return nil
+	}
+	j.Lock()
+	defer j.Unlock()
+	if j.state.Start != 0 {
+		return nil
+	}
+	j.state.Start = t.Unix()
+	return j.save()
+}
+
+// startTime returns the time the phaser started at, or the zero time if it
+// did not start yet.
+func (j *dkgJournal) startTime() time.Time {
+	if j == nil {
+		return time.Time{}
+	}
+	j.Lock()
+	defer j.Unlock()
+	if j.state.Start == 0 {
+		return time.Time{}
+	}
+	return time.Unix(j.state.Start, 0)
+}
+
+// deadline returns the time after which the DKG is over: the end of its last
+// phase, counted from the time it was created if it did not start yet.
+func (j *dkgJournal) deadline() time.Time {
+	j.Lock()
+	defer j.Unlock()
+	from := j.state.Start
+	if from == 0 {
+		from = j.state.Created
+	}
+	return time.Unix(from, 0).Add(3 * dkgPhaseDuration(j.state.Timeout))
+}
+
+// ownPacket returns the packet of the same type as p that this node sent
+// before a restart, or records p and returns it if there is none, so that a
+// node never sends two different packets for the same phase.
+func (j *dkgJournal) ownPacket(p packet) (packet, error) {
+	if j == nil {
+		return p, nil
+	}
+	j.Lock()
+	defer j.Unlock()
+	for _, s := range j.sent {
+		if fmt.Sprintf("%T", s) == fmt.Sprintf("%T", p) {
+			return s, nil
+		}
+	}
+	j.sent = append(j.sent, p)
+	return p, j.append(sentRecord, p)
+}
+
+// recordReceived records a valid packet received from another node.
+func (j *dkgJournal) recordReceived(p packet) error {
+	if j == nil {
+		return nil
+	}
+	j.Lock()
+	defer j.Unlock()
+	j.received = append(j.received, p)
+	return j.append(receivedRecord, p)
+}
+
+// packets returns the packets sent and received before a restart.
+func (j *dkgJournal) packets() (sent, received []packet) {
+	if j == nil {
+		return nil, nil
+	}
+	j.Lock()
+	defer j.Unlock()
+	return append([]packet(nil), j.sent...), append([]packet(nil), j.received...)
+}
+
+// groups returns the group being created and, for a resharing, the group
+// reshared from.
+func (j *dkgJournal) groups() (target, previous *key.Group, err error) {
+	target, err = groupFromBytes(j.state.Target)
+	if err != nil || !j.state.Reshare {
+		return target, nil, err
+	}
+	previous, err = groupFromBytes(j.state.Previous)
+	return target, previous, err
+}
+
+// remove deletes the journal, once the DKG is over.
+func (j *dkgJournal) remove() error {
+	if j == nil {
+		return nil
+	}
+	j.Lock()
+	defer j.Unlock()
+	return os.RemoveAll(j.folder)
+}
+
+// suite returns the suite of the DKG, whose random stream is derived from the
+// seed of the journal.
+func (j *dkgJournal) suite() dkg.Suite {
+	return &seededSuite{
+		Suite: key.KeyGroup.(dkg.Suite),
+		seed:  j.state.Seed,
+	}
+}
+
+// reader returns the entropy source the secret of a fresh DKG is picked from,
+// derived from the seed of the journal.
+func (j *dkgJournal) reader() io.Reader {
+	return blake2xb.New(append([]byte("drand-dkg-secret"), j.state.Seed...))
+}
+
+// seededSuite is a DKG suite whose random stream, that the polynomial of a
+// node is picked from, is derived from a seed.
+type seededSuite struct {
+	dkg.Suite
+	seed []byte
+}
+
+func (s *seededSuite) RandomStream() cipher.Stream {
+	return blake2xb.New(append([]byte("drand-dkg-poly"), s.seed...))
+}
+
+// newDKGSeed picks the seed of the randomness of a node in a DKG from the
+// entropy source given, alone or combined with crypto/rand.
+func newDKGSeed(r io.Reader, userOnly bool) (seed []byte, err error) {
+	// the random stream panics when its sources fail
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("error picking dkg seed: %v", r)
+		}
+	}()
+	var stream cipher.Stream
+	switch {
+	case r == nil:
+		stream = random.New()
+	case userOnly:
+		stream = random.New(r)
+	default:
+		stream = random.New(r, rand.Reader)
+	}
+	seed = make([]byte, dkgSeedLength)
+	stream.XORKeyStream(seed, seed)
+	return seed, nil
+}
+
+// dkgPhaseDuration returns the duration of each phase of a DKG given its
+// timeout in seconds.
+func dkgPhaseDuration(timeout uint32) time.Duration {
+	if timeout == 0 {
+		return DefaultDKGTimeout
+	}
+	return time.Duration(timeout) * time.Second
+}
+
+func groupToBytes(g *key.Group, version commonutils.Version) ([]byte, error) {
+	if g == nil {
+		return nil, nil
+	}
+	return proto.Marshal(g.ToProto(version))
+}
+
+func groupFromBytes(buff []byte) (*key.Group, error) {
+	if len(buff) == 0 {
+		return nil, errors.New("no group")
+	}
+	gp := new(drand.GroupPacket)
+	if err := proto.Unmarshal(buff, gp); err != nil {
+		return nil, err
+	}
+	return key.GroupFromProto(gp)
+}
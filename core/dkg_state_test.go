@@ -0,0 +1,142 @@
+package core
+
+import (
+	"encoding/base64"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/common"
+	"github.com/drand/drand/common/scheme"
+	"github.com/drand/drand/key"
+	"github.com/drand/drand/test"
+	"github.com/drand/kyber"
+	"github.com/drand/kyber/share/dkg"
+	"github.com/drand/kyber/util/random"
+)
+
+func testDeal(sig string) *dkg.DealBundle {
+	return &dkg.DealBundle{
+		DealerIndex: 1,
+		Deals:       []dkg.Deal{{ShareIndex: 2, EncryptedShare: []byte(sig)}},
+		Public:      []kyber.Point{key.KeyGroup.Point().Pick(random.New())},
+		SessionID:   []byte("session"),
+		Signature:   []byte(sig),
+	}
+}
+
+func TestDKGJournal(t *testing.T) {
+	folder := path.Join(t.TempDir(), "dkg")
+	_, group := test.BatchIdentities(4, scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv())
+	target, err := groupToBytes(group, common.GetAppVersion())
+	require.NoError(t, err)
+	seed, err := newDKGSeed(nil, false)
+	require.NoError(t, err)
+
+	now := time.Unix(1000, 0)
+	j, err := newDKGJournal(folder, &dkgState{
+		Timeout: 10,
+		Created: now.Unix(),
+		Seed:    seed,
+		Target:  target,
+	}, nil)
+	require.NoError(t, err)
+	require.True(t, j.startTime().IsZero())
+	require.Equal(t, now.Add(30*time.Second), j.deadline())
+
+	// the first deal is sent, and always sent again afterwards
+	deal := testDeal("first")
+	p, err := j.ownPacket(deal)
+	require.NoError(t, err)
+	require.Equal(t, deal, p)
+	p, err = j.ownPacket(testDeal("second"))
+	require.NoError(t, err)
+	require.Equal(t, deal, p)
+
+	resp := &dkg.ResponseBundle{
+		ShareIndex: 3,
+		Responses:  []dkg.Response{{DealerIndex: 1, Status: dkg.Success}},
+		SessionID:  []byte("session"),
+		Signature:  []byte("sig"),
+	}
+	require.NoError(t, j.recordReceived(resp))
+	require.NoError(t, j.started(now.Add(time.Second)))
+	// the start time is only recorded once
+	require.NoError(t, j.started(now.Add(time.Minute)))
+
+	// a record partially written before a crash is dropped
+	f, err := os.OpenFile(path.Join(folder, dkgPacketsFile), os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{receivedRecord, 0, 0, 1})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	loaded, err := loadDKGJournal(folder, nil)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(time.Second), loaded.startTime())
+	require.Equal(t, now.Add(time.Second+30*time.Second), loaded.deadline())
+	sent, received := loaded.packets()
+	require.Len(t, sent, 1)
+	require.Equal(t, deal.Hash(), sent[0].Hash())
+	require.Equal(t, deal.Signature, sent[0].(*dkg.DealBundle).Signature)
+	require.Len(t, received, 1)
+	require.Equal(t, resp.Hash(), received[0].Hash())
+
+	// the deal sent before the restart is sent again
+	p, err = loaded.ownPacket(testDeal("third"))
+	require.NoError(t, err)
+	require.Equal(t, deal.Hash(), p.Hash())
+
+	g, previous, err := loaded.groups()
+	require.NoError(t, err)
+	require.Nil(t, previous)
+	require.Equal(t, group.Hash(), g.Hash())
+
+	// the same seed gives the same randomness
+	b1, b2 := make([]byte, 32), make([]byte, 32)
+	j.suite().RandomStream().XORKeyStream(b1, b1)
+	loaded.suite().RandomStream().XORKeyStream(b2, b2)
+	require.Equal(t, b1, b2)
+
+	require.NoError(t, loaded.remove())
+	_, err = loadDKGJournal(folder, nil)
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestDKGJournalEncryptedSeed(t *testing.T) {
+	folder := path.Join(t.TempDir(), "dkg")
+	seed, err := newDKGSeed(nil, false)
+	require.NoError(t, err)
+	passphrase := []byte("passphrase")
+	j, err := newDKGJournal(folder, &dkgState{Timeout: 10, Seed: seed}, passphrase)
+	require.NoError(t, err)
+	require.NoError(t, j.started(time.Now()))
+
+	// the seed is not written in plain text
+	buff, err := os.ReadFile(path.Join(folder, dkgStateFile))
+	require.NoError(t, err)
+	require.NotContains(t, string(buff), base64.StdEncoding.EncodeToString(seed))
+
+	_, err = loadDKGJournal(folder, nil)
+	require.ErrorIs(t, err, key.ErrEncryptedKey)
+	_, err = loadDKGJournal(folder, []byte("wrong"))
+	require.ErrorIs(t, err, key.ErrWrongPassphrase)
+	loaded, err := loadDKGJournal(folder, passphrase)
+	require.NoError(t, err)
+	require.Equal(t, seed, loaded.state.Seed)
+}
+
+func TestDKGJournalNil(t *testing.T) {
+	var j *dkgJournal
+	deal := testDeal("deal")
+	p, err := j.ownPacket(deal)
+	require.NoError(t, err)
+	require.Equal(t, deal, p)
+	require.NoError(t, j.recordReceived(deal))
+	require.NoError(t, j.started(time.Now()))
+	require.True(t, j.startTime().IsZero())
+	require.NoError(t, j.remove())
+}
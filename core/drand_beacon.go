@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path"
 	"strings"
 	"sync"
 	"time"
 
+	clock "github.com/jonboulle/clockwork"
+
 	"github.com/drand/drand/chain"
 	"github.com/drand/drand/chain/beacon"
 	"github.com/drand/drand/chain/boltdb"
@@ -33,6 +36,9 @@ type BeaconProcess struct {
 	group *key.Group
 	index int
 
+	// previous key pairs of this node, archived when it rotated its key
+	archived []*key.Pair
+
 	store       key.Store
 	privGateway *net.PrivateGateway
 	pubGateway  *net.PublicGateway
@@ -53,12 +59,17 @@ type BeaconProcess struct {
 	// dkgInfo contains all the information related to an upcoming or in
 	// progress dkg protocol. It is nil for the rest of the time.
 	dkgInfo *dkgInfo
+	// dkgProgress is the progress of the DKG in progress, or of the last one.
+	// It is kept once the DKG is over, so that its outcome can be inspected.
+	dkgProgress *dkgProgress
 	// general logger
 	log dlog.Logger
 
 	// global state lock
-	state  sync.Mutex
-	exitCh chan bool
+	state sync.Mutex
+	// haltLock serializes the updates of the votes to end the chain
+	haltLock sync.Mutex
+	exitCh   chan bool
 
 	// that cancel function is set when the drand process is following a chain
 	// but not participating. Drand calls the cancel func when the node
@@ -84,12 +95,17 @@ func NewBeaconProcess(log dlog.Logger, store key.Store, beaconID string, opts *C
 	if err := priv.Public.ValidSignature(); err != nil {
 		return nil, fmt.Errorf("INVALID SELF SIGNATURE %w. Action: run `drand util self-sign`", err)
 	}
+	archived, err := store.LoadArchivedKeyPairs()
+	if err != nil {
+		return nil, fmt.Errorf("could not load archived keys: %w", err)
+	}
 
 	bp := &BeaconProcess{
 		beaconID:    commonutils.GetCanonicalBeaconID(beaconID),
 		store:       store,
 		log:         log,
 		priv:        priv,
+		archived:    archived,
 		version:     commonutils.GetAppVersion(),
 		opts:        opts,
 		privGateway: privGateway,
@@ -117,7 +133,8 @@ func (bp *BeaconProcess) Load() (bool, error) {
 	if bp.group == nil {
 		bp.dkgDone = false
 		metrics.DKGStateChange(metrics.DKGNotStarted, beaconID, false)
-		return false, nil
+		// no group was saved yet, there is no beacon to serve
+		return true, nil
 	}
 
 	bp.state.Lock()
@@ -131,10 +148,13 @@ func (bp *BeaconProcess) Load() (bool, error) {
 		return false, err
 	}
 
-	thisBeacon := bp.group.Find(bp.priv.Public)
+	thisBeacon := bp.findSelf(bp.group)
 	if thisBeacon == nil {
 		return false, fmt.Errorf("could not restore beacon info for the given identity - this can happen if you updated the group file manually")
 	}
+	if err := bp.group.CheckShare(bp.share, thisBeacon); err != nil {
+		return false, fmt.Errorf("the share of this node doesn't match its group - the share or the group file is corrupted: %w", err)
+	}
 	bp.index = int(thisBeacon.Index)
 	bp.log = bp.log.Named(fmt.Sprint(bp.index))
 
@@ -154,6 +174,7 @@ func (bp *BeaconProcess) WaitDKG() (*key.Group, error) {
 		bp.state.Unlock()
 		return nil, errors.New("no dkg info set")
 	}
+	dkgInfo := bp.dkgInfo
 
 	beaconID := bp.getBeaconID()
 	defer func() {
@@ -168,12 +189,21 @@ func (bp *BeaconProcess) WaitDKG() (*key.Group, error) {
 	bp.state.Unlock()
 
 	res := <-waitCh
-	if res.Error != nil {
-		return nil, fmt.Errorf("drand: error from dkg: %w", res.Error)
-	}
+	dkgInfo.progress.finish(res)
 
 	bp.state.Lock()
 	defer bp.state.Unlock()
+	if bp.dkgInfo != dkgInfo {
+		// the node stopped, or another DKG started since
+		return nil, errors.New("drand: dkg aborted")
+	}
+	// the DKG is over, it can't be resumed anymore
+	if err := dkgInfo.journal.remove(); err != nil {
+		bp.log.Errorw("", "dkg_end", "can't remove dkg state", "err", err)
+	}
+	if res.Error != nil {
+		return nil, fmt.Errorf("drand: error from dkg: %w", res.Error)
+	}
 	// filter the nodes that are not present in the target group
 	var qualNodes []*key.Node
 	for _, node := range bp.dkgInfo.target.Nodes {
@@ -281,9 +311,13 @@ func (bp *BeaconProcess) transition(oldGroup *key.Group, oldPresent, newPresent
 	}
 }
 
-// Stop simply stops all drand operations.
+// Stop simply stops all drand operations. A DKG in progress is abandoned, and
+// can be resumed after a restart.
 func (bp *BeaconProcess) Stop(ctx context.Context) {
 	bp.StopBeacon()
+	bp.state.Lock()
+	bp.cleanupDKG()
+	bp.state.Unlock()
 	bp.exitCh <- true
 }
 
@@ -301,34 +335,122 @@ func (bp *BeaconProcess) createBoltStore() (chain.Store, error) {
 	return boltdb.NewBoltStore(dbPath, bp.opts.boltOpts)
 }
 
+// openProtection opens the record of the partial beacons signed last, so that
+// the beacon never signs conflicting ones.
+func (bp *BeaconProcess) openProtection() (*beacon.Protection, error) {
+	folder := bp.opts.ProtectionFolder(bp.beaconID)
+	fs.CreateSecureFolder(folder)
+	return beacon.OpenProtection(path.Join(folder, protectionFileName), bp.opts.boltOpts)
+}
+
+// openHaltVotes loads the votes to end the chain of the group. It requires the
+// halt lock.
+func (bp *BeaconProcess) openHaltVotes(group *key.Group) (*haltVotes, error) {
+	folder := bp.opts.DBFolder(bp.beaconID)
+	fs.CreateSecureFolder(folder)
+	return loadHaltVotes(folder, group, chain.NewChainInfo(group).Hash())
+}
+
+// addHaltVote records a vote to end the chain, and halts the beacon handler
+// once enough nodes agree. A vote for a round which already happened is
+// refused while the end isn't agreed.
+func (bp *BeaconProcess) addHaltVote(inst *beacon.Handler, group *key.Group, vote *haltVote) (*haltVotes, error) {
+	bp.haltLock.Lock()
+	defer bp.haltLock.Unlock()
+	votes, err := bp.openHaltVotes(group)
+	if err != nil {
+		return nil, err
+	}
+	if votes.End() == 0 {
+		current := chain.CurrentRoundAt(bp.opts.clock.Now(), group.Period, group.GenesisTime)
+		if vote.Round <= current {
+			return nil, fmt.Errorf("drand: can't end the chain at round %d, the current round is %d", vote.Round, current)
+		}
+	}
+	end, err := votes.Add(vote)
+	if err != nil {
+		return nil, err
+	}
+	if end != 0 && inst.EndRound() != end {
+		inst.HaltAt(end)
+	}
+	return votes, nil
+}
+
+// pairOf returns the key pair of this node matching the node of a group, which
+// is an archived key pair for a group created before a key rotation.
+func (bp *BeaconProcess) pairOf(node *key.Node) *key.Pair {
+	for _, pair := range bp.archived {
+		if pair.Public.Key.Equal(node.Key) {
+			return pair
+		}
+	}
+	return bp.priv
+}
+
+// findSelf returns the node of the group with the key of this node or, for a
+// group created before a key rotation, with one of its archived keys.
+func (bp *BeaconProcess) findSelf(group *key.Group) *key.Node {
+	if node := group.Find(bp.priv.Public); node != nil {
+		return node
+	}
+	for _, pair := range bp.archived {
+		if node := group.Find(pair.Public); node != nil {
+			return node
+		}
+	}
+	return nil
+}
+
 func (bp *BeaconProcess) newBeacon() (*beacon.Handler, error) {
 	bp.state.Lock()
 	defer bp.state.Unlock()
 
 	pub := bp.priv.Public
-	node := bp.group.Find(pub)
+	node := bp.findSelf(bp.group)
 
 	if node == nil {
 		return nil, fmt.Errorf("public key %s not found in group", pub)
 	}
-	conf := &beacon.Config{
-		Public: node,
-		Group:  bp.group,
-		Share:  bp.share,
-		Clock:  bp.opts.clock,
-	}
-
 	store, err := bp.createBoltStore()
 	if err != nil {
 		return nil, err
 	}
+	if err := chain.NewChainInfo(bp.group).CheckStore(store); err != nil {
+		store.Close()
+		return nil, fmt.Errorf("the distributed key of the group doesn't match the chain stored: %w", err)
+	}
+	protection, err := bp.openProtection()
+	if err != nil {
+		store.Close()
+		return nil, err
+	}
 
+	conf := &beacon.Config{
+		Public:             node,
+		Group:              bp.group,
+		Share:              bp.share,
+		Clock:              bp.opts.clock,
+		Signer:             bp.opts.signer,
+		Protection:         protection,
+		OverrideProtection: bp.opts.overrideProtect,
+		StreamPartials:     bp.opts.streamPartials,
+	}
 	b, err := beacon.NewHandler(bp.privGateway.ProtocolClient, store, conf, bp.log, bp.version)
 	if err != nil {
+		protection.Close()
+		store.Close()
 		return nil, err
 	}
 	bp.beacon = b
 	bp.beacon.AddCallback("opts", bp.opts.callbacks)
+	bp.haltLock.Lock()
+	if votes, err := bp.openHaltVotes(bp.group); err != nil {
+		bp.log.Errorw("", "chain_halt", "loading votes", "err", err)
+	} else if end := votes.End(); end != 0 {
+		b.HaltAt(end)
+	}
+	bp.haltLock.Unlock()
 	// cancel any sync operations
 	if bp.syncerCancel != nil {
 		bp.syncerCancel()
@@ -396,10 +518,27 @@ func (bp *BeaconProcess) newMetadata() *common.Metadata {
 // dkgInfo is a simpler wrapper that keeps the relevant config and logic
 // necessary during the DKG protocol.
 type dkgInfo struct {
-	target  *key.Group
-	board   Broadcast
-	phaser  *dkg.TimePhaser
-	conf    *dkg.Config
-	proto   *dkg.Protocol
-	started bool
+	target   *key.Group
+	board    Broadcast
+	phaser   *dkg.TimePhaser
+	conf     *dkg.Config
+	proto    *dkg.Protocol
+	journal  *dkgJournal
+	progress *dkgProgress
+	started  bool
+}
+
+// startPhaser starts the phases of the protocol, and records the time they
+// started at, if they did not start before a restart. When the start time was
+// agreed on beforehand, as in a leaderless DKG, the phases wait for it.
+func (d *dkgInfo) startPhaser(l dlog.Logger, c clock.Clock) {
+	if err := d.journal.started(c.Now()); err != nil {
+		l.Errorw("", "dkg", "can't persist start time", "err", err)
+	}
+	go func() {
+		if wait := d.journal.startTime().Sub(c.Now()); wait > 0 {
+			c.Sleep(wait)
+		}
+		d.phaser.Start()
+	}()
 }
@@ -9,7 +9,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	clock "github.com/jonboulle/clockwork"
@@ -33,6 +36,12 @@ import (
 // errPreempted is returned on reshares when a subsequent reshare is started concurrently
 var errPreempted = errors.New("time out: pre-empted")
 
+// errRotatedLeader is returned when a node leads a resharing after rotating its
+// key: the DKG only deals with the current key, so its share of the old group
+// can't be dealt.
+var errRotatedLeader = errors.New("control: this node rotated its key since the old group, so it can't deal " +
+	"its share and can't lead the resharing, let a node of the old group that didn't rotate its key lead it")
+
 // Control services
 
 // InitDKG take a InitDKGPacket, extracts the information needed and wait for
@@ -68,8 +77,8 @@ func (bp *BeaconProcess) InitDKG(c context.Context, in *drand.InitDKGPacket) (*d
 				d.log.Named("setupManager"),
 				d.opts.clock,
 				d.priv.Public,
-				in.GetBeaconPeriod(),
-				in.GetCatchupPeriod(),
+				commonutils.PeriodFromProto(in.GetBeaconPeriod(), in.GetBeaconPeriodMs()),
+				commonutils.PeriodFromProto(in.GetCatchupPeriod(), in.GetCatchupPeriodMs()),
 				bp.getBeaconID(),
 				in.GetSchemeID(),
 				in.GetInfo(),
@@ -104,7 +113,7 @@ func (bp *BeaconProcess) InitDKG(c context.Context, in *drand.InitDKGPacket) (*d
 	bp.log.Debugw("Starting to use proper node index for logging")
 	bp.log = bp.log.Named(fmt.Sprint(bp.index))
 	bp.state.Unlock()
-	finalGroup, err := bp.runDKG(true, group, in.GetInfo().GetTimeout(), in.GetEntropy())
+	finalGroup, err := bp.runDKG(true, group, in.GetInfo().GetTimeout(), 0, in.GetEntropy())
 	if err != nil {
 		return nil, err
 	}
@@ -114,6 +123,108 @@ func (bp *BeaconProcess) InitDKG(c context.Context, in *drand.InitDKGPacket) (*d
 	return response, nil
 }
 
+// InitDKGLeaderless runs a fresh DKG or a resharing from a proposal signed by
+// all its participants. Every participant calls it before the start time of
+// the proposal, and they all start the DKG at that time, without a leader.
+func (bp *BeaconProcess) InitDKGLeaderless(c context.Context, in *drand.InitDKGLeaderlessPacket) (*drand.GroupPacket, error) {
+	proposal, err := key.ProposalFromProto(in.GetProposal())
+	if err != nil {
+		return nil, fmt.Errorf("drand: invalid proposal: %w", err)
+	}
+	if err := proposal.Verify(); err != nil {
+		return nil, fmt.Errorf("drand: invalid proposal: %w", err)
+	}
+	group := proposal.Group
+	if commonutils.GetCanonicalBeaconID(group.ID) != bp.getBeaconID() {
+		return nil, fmt.Errorf("drand: proposal is for beacon %s, not %s", group.ID, bp.getBeaconID())
+	}
+	end := time.Unix(proposal.Start, 0).Add(proposal.Timeout)
+	if !bp.opts.clock.Now().Before(end) {
+		return nil, fmt.Errorf("drand: deal phase of the proposal ended at %d", end.Unix())
+	}
+	if proposal.Reshare() {
+		return bp.initReshareLeaderless(proposal, in.GetOld())
+	}
+
+	node := group.Find(bp.priv.Public)
+	if node == nil {
+		return nil, errors.New("drand: this node is not part of the proposal")
+	}
+
+	bp.state.Lock()
+	if bp.dkgDone {
+		bp.state.Unlock()
+		return nil, errors.New("dkg phase already done - call reshare")
+	}
+	if bp.dkgInfo != nil {
+		bp.state.Unlock()
+		return nil, errors.New("drand: dkg already in progress")
+	}
+	bp.index = int(node.Index)
+	bp.log = bp.log.Named(fmt.Sprint(bp.index))
+	bp.state.Unlock()
+
+	metrics.DKGStateChange(metrics.DKGWaiting, bp.getBeaconID(), false)
+	metrics.GroupSize.WithLabelValues(bp.getBeaconID()).Set(float64(group.Len()))
+	metrics.GroupThreshold.WithLabelValues(bp.getBeaconID()).Set(float64(group.Threshold))
+
+	bp.log.Infow("", "init_dkg", "begin", "leaderless", true, "start", proposal.Start,
+		"group", hex.EncodeToString(group.Hash()))
+
+	finalGroup, err := bp.runDKG(false, group, uint32(proposal.Timeout.Seconds()), proposal.Start, in.GetEntropy())
+	if err != nil {
+		return nil, err
+	}
+	return finalGroup.ToProto(bp.version), nil
+}
+
+// initReshareLeaderless runs the resharing of a proposal, from the old group
+// given or, if there is none, from the group this node has stored. Nodes of
+// the old group that are not in the new one take part in it without signing
+// the proposal.
+func (bp *BeaconProcess) initReshareLeaderless(proposal *key.Proposal, old *drand.GroupInfo) (*drand.GroupPacket, error) {
+	oldGroup, err := bp.extractGroup(old)
+	if err != nil {
+		return nil, err
+	}
+	newGroup := proposal.Group
+	if err := bp.validateGroupTransition(oldGroup, newGroup, nil); err != nil {
+		return nil, err
+	}
+	if oldGroup.PublicKey == nil {
+		return nil, errors.New("drand: can't reshare from a group without distributed key")
+	}
+	newNode := newGroup.Find(bp.priv.Public)
+	if newNode == nil && bp.findSelf(oldGroup) == nil {
+		return nil, errors.New("drand: this node is not part of the proposal")
+	}
+
+	beaconID := bp.getBeaconID()
+	metrics.ReshareStateChange(metrics.ReshareWaiting, beaconID, false)
+	defer func() {
+		metrics.ReshareStateChange(metrics.ReshareIdle, bp.getBeaconID(), false)
+	}()
+
+	if newNode != nil {
+		bp.state.Lock()
+		oldIdx := bp.index
+		// notice that we are updating the index prior to the actual transition
+		bp.index = int(newNode.Index)
+		bp.log.Debugw("Starting to use new node index for logging", "old", oldIdx, "new", bp.index)
+		bp.log = bp.opts.logger.Named(bp.priv.Public.Addr).Named(bp.getBeaconID()).Named(fmt.Sprint(bp.index))
+		bp.state.Unlock()
+	}
+
+	bp.log.Infow("", "init_reshare", "begin", "leaderless", true, "start", proposal.Start,
+		"target_group", hex.EncodeToString(newGroup.Hash()), "transition", newGroup.TransitionTime)
+
+	finalGroup, err := bp.runResharing(false, oldGroup, newGroup, uint32(proposal.Timeout.Seconds()), proposal.Start)
+	if err != nil {
+		return nil, err
+	}
+	return finalGroup.ToProto(bp.version), nil
+}
+
 // InitReshare receives information about the old and new group from which to
 // operate the resharing protocol.
 //
@@ -135,7 +246,15 @@ func (bp *BeaconProcess) InitReshare(c context.Context, in *drand.InitResharePac
 			"received group file (%s) ; beaconProcess (%s)", oldBeaconID, bp.getBeaconID())
 	}
 
+	evicted, err := bp.evictedNodes(oldGroup, in.GetEvict())
+	if err != nil {
+		return nil, err
+	}
+
 	isLeader := in.GetInfo().GetLeader()
+	if isLeader && oldGroup.Find(bp.priv.Public) == nil && bp.findSelf(oldGroup) != nil {
+		return nil, errRotatedLeader
+	}
 	beaconID := bp.getBeaconID()
 	metrics.ReshareStateChange(metrics.ReshareWaiting, beaconID, isLeader)
 	defer func() {
@@ -150,10 +269,10 @@ func (bp *BeaconProcess) InitReshare(c context.Context, in *drand.InitResharePac
 	metrics.GroupSize.WithLabelValues(beaconID).Set(float64(in.Info.Nodes))
 	metrics.GroupThreshold.WithLabelValues(beaconID).Set(float64(in.Info.Threshold))
 
-	bp.log.Infow("", "init_reshare", "begin", "leader", true, "time", bp.opts.clock.Now())
+	bp.log.Infow("", "init_reshare", "begin", "leader", true, "time", bp.opts.clock.Now(), "evict", in.GetEvict())
 
 	newSetup := func(d *BeaconProcess) (*setupManager, error) {
-		return newReshareSetup(d.log, d.opts.clock, d.priv.Public, oldGroup, in)
+		return newReshareSetup(d.log, d.opts.clock, d.priv.Public, oldGroup, evicted, in)
 	}
 
 	newGroup, err := bp.leaderRunSetup(newSetup)
@@ -182,9 +301,18 @@ func (bp *BeaconProcess) InitReshare(c context.Context, in *drand.InitResharePac
 	if !bytes.Equal(newGroup.GetGenesisSeed(), oldGroup.GetGenesisSeed()) {
 		return nil, errors.New("control: old and new group have different genesis seed")
 	}
+	if err := bp.validateGroupTransition(oldGroup, newGroup, in.GetEvict()); err != nil {
+		return nil, err
+	}
 
-	// send it to everyone in the group nodes
-	if err := bp.pushDKGInfo(oldGroup.Nodes, newGroup.Nodes,
+	// send it to everyone in the group nodes, except the evicted ones
+	outgoing := make([]*key.Node, 0, len(oldGroup.Nodes))
+	for _, n := range oldGroup.Nodes {
+		if !nodesContainAddr(evicted, n.Address()) {
+			outgoing = append(outgoing, n)
+		}
+	}
+	if err := bp.pushDKGInfo(outgoing, newGroup.Nodes,
 		oldGroup.Threshold,
 		newGroup,
 		in.GetInfo().GetSecret(),
@@ -202,7 +330,7 @@ func (bp *BeaconProcess) InitReshare(c context.Context, in *drand.InitResharePac
 	bp.log = bp.opts.logger.Named(bp.priv.Public.Addr).Named(bp.getBeaconID()).Named(fmt.Sprint(bp.index))
 	bp.state.Unlock()
 
-	finalGroup, err := bp.runResharing(true, oldGroup, newGroup, in.GetInfo().GetTimeout())
+	finalGroup, err := bp.runResharing(true, oldGroup, newGroup, in.GetInfo().GetTimeout(), 0)
 	if err != nil {
 		return nil, err
 	}
@@ -212,6 +340,37 @@ func (bp *BeaconProcess) InitReshare(c context.Context, in *drand.InitResharePac
 	return response, nil
 }
 
+// DKGStatus sends the progress of the DKG in progress, or of the last one. If
+// asked to watch it, it sends it again after each change, until the DKG is
+// over.
+func (bp *BeaconProcess) DKGStatus(in *drand.DKGStatusRequest, stream drand.Control_DKGStatusServer) error {
+	bp.state.Lock()
+	progress := bp.dkgProgress
+	bp.state.Unlock()
+	if progress == nil {
+		return errors.New("no dkg ran since this node started")
+	}
+
+	// watch before taking the first snapshot to not miss any change
+	changes, stop := progress.watch()
+	defer stop()
+	for {
+		status := progress.toProto()
+		status.Metadata = bp.newMetadata()
+		if err := stream.Send(status); err != nil {
+			return err
+		}
+		if !in.GetWatch() || status.Done {
+			return nil
+		}
+		select {
+		case <-changes:
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
 // Share is a functionality of Control Service defined in protobuf/control that requests the private share of the drand node running locally
 func (bp *BeaconProcess) Share(ctx context.Context, in *drand.ShareRequest) (*drand.ShareResponse, error) {
 	share, err := bp.store.LoadShare()
@@ -280,6 +439,102 @@ func (bp *BeaconProcess) GroupFile(ctx context.Context, in *drand.GroupRequest)
 	return protoGroup, nil
 }
 
+// Participation returns the partial beacons received for the last rounds, as
+// recorded by the beacon handler.
+func (bp *BeaconProcess) Participation(c context.Context, in *drand.ParticipationRequest) (*drand.ParticipationResponse, error) {
+	bp.state.Lock()
+	if bp.beacon == nil {
+		bp.state.Unlock()
+		return nil, errors.New("drand: beacon not setup yet")
+	}
+	inst, group := bp.beacon, bp.group
+	bp.state.Unlock()
+
+	resp := &drand.ParticipationResponse{
+		GroupSize: uint32(group.Len()),
+		Threshold: uint32(group.Threshold),
+		Metadata:  bp.newMetadata(),
+	}
+	for _, node := range group.Nodes {
+		resp.Indexes = append(resp.Indexes, node.Index)
+	}
+	for _, r := range inst.Participation(int(in.GetRounds())) {
+		resp.Rounds = append(resp.Rounds, participationToProto(r))
+	}
+	return resp, nil
+}
+
+// ScheduleHalt votes to end the chain at the given round and sends the vote to
+// the other nodes of the group. The chain ends once a threshold of nodes voted
+// for the same round: no beacon is produced after it, and the chain is still
+// served.
+func (bp *BeaconProcess) ScheduleHalt(c context.Context, in *drand.ScheduleHaltRequest) (*drand.ScheduleHaltResponse, error) {
+	bp.state.Lock()
+	if bp.beacon == nil {
+		bp.state.Unlock()
+		return nil, errors.New("drand: beacon not setup yet")
+	}
+	inst, group := bp.beacon, bp.group
+	node := bp.findSelf(group)
+	bp.state.Unlock()
+
+	round := in.GetRound()
+	signature, err := key.AuthScheme.Sign(bp.pairOf(node).Key, haltMessage(chain.NewChainInfo(group).Hash(), round))
+	if err != nil {
+		return nil, fmt.Errorf("drand: error signing the halt vote: %w", err)
+	}
+	votes, err := bp.addHaltVote(inst, group, &haltVote{Round: round, Index: node.Index, Signature: signature})
+	if err != nil {
+		return nil, err
+	}
+
+	packet := &drand.HaltPacket{
+		Round:     round,
+		Index:     node.Index,
+		Signature: signature,
+		Metadata:  bp.newMetadata(),
+	}
+	var unreachable []string
+	for res := range bp.pushHaltPacket(c, group.Nodes, packet) {
+		if res.err != nil {
+			bp.log.Errorw("", "chain_halt", "failed to push", "to", res.address, "err", res.err)
+			unreachable = append(unreachable, res.address)
+		}
+	}
+	sort.Strings(unreachable)
+
+	return &drand.ScheduleHaltResponse{
+		Round:       round,
+		Votes:       votes.Votes(round),
+		Threshold:   uint32(group.Threshold),
+		Unreachable: unreachable,
+		Metadata:    bp.newMetadata(),
+	}, nil
+}
+
+// pushHaltPacket sends the halt vote to the other nodes, and returns the
+// results once they all replied.
+func (bp *BeaconProcess) pushHaltPacket(c context.Context, nodes []*key.Node, packet *drand.HaltPacket) chan pushResult {
+	results := make(chan pushResult, len(nodes))
+	var wg sync.WaitGroup
+	for _, node := range nodes {
+		if node.Address() == bp.priv.Public.Address() {
+			continue
+		}
+		wg.Add(1)
+		go func(i *key.Identity) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(c, callMaxTimeout)
+			defer cancel()
+			err := bp.privGateway.ProtocolClient.Halt(ctx, i, packet)
+			results <- pushResult{i.Address(), err}
+		}(node.Identity)
+	}
+	wg.Wait()
+	close(results)
+	return results
+}
+
 // BackupDatabase triggers a backup of the primary database.
 func (bp *BeaconProcess) BackupDatabase(ctx context.Context, req *drand.BackupDBRequest) (*drand.BackupDBResponse, error) {
 	bp.state.Lock()
@@ -359,54 +614,97 @@ func (bp *BeaconProcess) leaderRunSetup(newSetup func(d *BeaconProcess) (*setupM
 }
 
 // runDKG setups the proper structures and protocol to run the DKG and waits
-// until it finishes. If leader is true, this node sends the first packet.
-func (bp *BeaconProcess) runDKG(leader bool, group *key.Group, timeout uint32, randomness *drand.EntropyInfo) (*key.Group, error) {
+// until it finishes. If leader is true, this node sends the first packet. If
+// start is set, all nodes start the DKG at that unix time without a leader.
+func (bp *BeaconProcess) runDKG(leader bool, group *key.Group, timeout uint32, start int64,
+	randomness *drand.EntropyInfo) (*key.Group, error) {
+	reader, user := extractEntropy(randomness)
+	seed, err := newDKGSeed(reader, user)
+	if err != nil {
+		return nil, err
+	}
+	target, err := groupToBytes(group, bp.version)
+	if err != nil {
+		return nil, err
+	}
+	journal, err := newDKGJournal(bp.opts.DKGFolder(bp.beaconID), &dkgState{
+		Timeout: timeout,
+		Created: bp.opts.clock.Now().Unix(),
+		Start:   start,
+		Seed:    seed,
+		Target:  target,
+	}, bp.opts.keyPassphrase)
+	if err != nil {
+		return nil, fmt.Errorf("drand: can't persist dkg state: %w", err)
+	}
+
+	info, err := bp.setupDKG(leader, group, journal)
+	if err != nil {
+		return nil, err
+	}
+	return bp.finishDKG(leader, info)
+}
+
+// setupDKG setups the protocol of a fresh DKG creating the given group, from
+// its journal, and replays the packets received before a restart.
+func (bp *BeaconProcess) setupDKG(leader bool, group *key.Group, journal *dkgJournal) (*dkgInfo, error) {
 	beaconID := commonutils.GetCanonicalBeaconID(group.ID)
 
-	reader, user := extractEntropy(randomness)
 	config := &dkg.Config{
-		Suite:          key.KeyGroup.(dkg.Suite),
+		Suite:          journal.suite(),
 		NewNodes:       group.DKGNodes(),
 		Longterm:       bp.priv.Key,
-		Reader:         reader,
-		UserReaderOnly: user,
+		Reader:         journal.reader(),
+		UserReaderOnly: true,
 		FastSync:       true,
 		Threshold:      group.Threshold,
 		Nonce:          getNonce(group),
 		Auth:           key.DKGAuthScheme,
 		Log:            bp.log,
 	}
-	phaser := bp.getPhaser(timeout)
+	progress := newDKGProgress(false, group.Nodes, group.Nodes)
+	phaser := bp.getPhaser(journal.state.Timeout, journal.startTime(), progress)
 	board := newEchoBroadcast(bp.log, bp.version, beaconID, bp.privGateway.ProtocolClient,
 		bp.priv.Public.Address(), group.Nodes, func(p dkg.Packet) error {
 			return dkg.VerifyPacketSignature(config, p)
-		})
+		}, journal, progress)
 	dkgProto, err := dkg.NewProtocol(config, board, phaser, true)
 	if err != nil {
 		return nil, err
 	}
+	board.replay(journal.packets())
 
 	bp.state.Lock()
 	dkgInfo := &dkgInfo{
-		target: group,
-		board:  board,
-		phaser: phaser,
-		conf:   config,
-		proto:  dkgProto,
+		target:   group,
+		board:    board,
+		phaser:   phaser,
+		conf:     config,
+		proto:    dkgProto,
+		journal:  journal,
+		progress: progress,
 	}
 	bp.dkgInfo = dkgInfo
-	if leader {
+	bp.dkgProgress = progress
+	if leader || !journal.startTime().IsZero() {
 		bp.dkgInfo.started = true
 	}
 	metrics.DKGStateChange(metrics.DKGInProgress, beaconID, leader)
 	bp.state.Unlock()
 
-	if leader {
+	if dkgInfo.started {
 		// phaser will kick off the first phase for every other nodes so
 		// nodes will send their deals
 		bp.log.Infow("", "init_dkg", "START_DKG")
-		go phaser.Start()
+		dkgInfo.startPhaser(bp.log, bp.opts.clock)
 	}
+	return dkgInfo, nil
+}
+
+// finishDKG waits until the DKG finishes, and then starts the beacon.
+func (bp *BeaconProcess) finishDKG(leader bool, dkgInfo *dkgInfo) (*key.Group, error) {
+	beaconID := commonutils.GetCanonicalBeaconID(dkgInfo.target.ID)
+
 	bp.log.Infow("", "init_dkg", "wait_dkg_end")
 	finalGroup, err := bp.WaitDKG()
 	if err != nil {
@@ -425,7 +723,7 @@ func (bp *BeaconProcess) runDKG(leader bool, group *key.Group, timeout uint32, r
 
 	metrics.DKGStateChange(metrics.DKGDone, beaconID, false)
 	bp.log.Infow("", "init_dkg", "dkg_done",
-		"starting_beacon_time", finalGroup.GenesisTime, "now", bp.opts.clock.Now().Unix())
+		"starting_beacon_time", finalGroup.GenesisTime, "now", bp.opts.clock.Now().Unix(), "leader", leader)
 
 	// beacon will start at the genesis time specified
 	go bp.StartBeacon(false)
@@ -442,24 +740,63 @@ func (bp *BeaconProcess) cleanupDKG() {
 
 // runResharing setups all necessary structures to run the resharing protocol
 // and waits until it finishes (or timeouts). If leader is true, it sends the
-// first packet so other nodes will start as soon as they receive it.
+// first packet so other nodes will start as soon as they receive it. If start
+// is set, all nodes start the resharing at that unix time without a leader.
+func (bp *BeaconProcess) runResharing(leader bool, oldGroup, newGroup *key.Group, timeout uint32, start int64) (*key.Group, error) {
+	if leader && oldGroup.Find(bp.priv.Public) == nil {
+		bp.log.Errorw("", "run_reshare", "invalid", "leader", leader, "old_present", false)
+		if bp.findSelf(oldGroup) != nil {
+			return nil, errRotatedLeader
+		}
+		return nil, errors.New("can not be a leader if not present in the old group")
+	}
+
+	seed, err := newDKGSeed(nil, false)
+	if err != nil {
+		return nil, err
+	}
+	target, err := groupToBytes(newGroup, bp.version)
+	if err != nil {
+		return nil, err
+	}
+	previous, err := groupToBytes(oldGroup, bp.version)
+	if err != nil {
+		return nil, err
+	}
+	journal, err := newDKGJournal(bp.opts.DKGFolder(bp.beaconID), &dkgState{
+		Reshare:  true,
+		Timeout:  timeout,
+		Created:  bp.opts.clock.Now().Unix(),
+		Start:    start,
+		Seed:     seed,
+		Target:   target,
+		Previous: previous,
+	}, bp.opts.keyPassphrase)
+	if err != nil {
+		return nil, fmt.Errorf("drand: can't persist dkg state: %w", err)
+	}
+
+	info, err := bp.setupResharing(leader, oldGroup, newGroup, journal)
+	if err != nil {
+		return nil, err
+	}
+	return bp.finishResharing(leader, oldGroup, info)
+}
+
+// setupResharing setups the protocol of a resharing from oldGroup to
+// newGroup, from its journal, and replays the packets received before a
+// restart.
 //
 //nolint:funlen
-func (bp *BeaconProcess) runResharing(leader bool, oldGroup, newGroup *key.Group, timeout uint32) (*key.Group, error) {
+func (bp *BeaconProcess) setupResharing(leader bool, oldGroup, newGroup *key.Group, journal *dkgJournal) (*dkgInfo, error) {
 	oldBeaconID := commonutils.GetCanonicalBeaconID(oldGroup.ID)
 
 	oldNode := oldGroup.Find(bp.priv.Public)
 	oldPresent := oldNode != nil
 
-	if leader && !oldPresent {
-		bp.log.Errorw("", "run_reshare", "invalid", "leader", leader, "old_present", oldPresent)
-		return nil, errors.New("can not be a leader if not present in the old group")
-	}
-
 	newNode := newGroup.Find(bp.priv.Public)
-	newPresent := newNode != nil
 	config := &dkg.Config{
-		Suite:        key.KeyGroup.(dkg.Suite),
+		Suite:        journal.suite(),
 		NewNodes:     newGroup.DKGNodes(),
 		OldNodes:     oldGroup.DKGNodes(),
 		Longterm:     bp.priv.Key,
@@ -495,44 +832,60 @@ func (bp *BeaconProcess) runResharing(leader bool, oldGroup, newGroup *key.Group
 	}
 
 	allNodes := nodeUnion(oldGroup.Nodes, newGroup.Nodes)
-	var board Broadcast = newEchoBroadcast(bp.log, bp.version, oldBeaconID, bp.privGateway.ProtocolClient,
+	progress := newDKGProgress(true, oldGroup.Nodes, newGroup.Nodes)
+	echo := newEchoBroadcast(bp.log, bp.version, oldBeaconID, bp.privGateway.ProtocolClient,
 		bp.priv.Public.Address(), allNodes, func(p dkg.Packet) error {
 			return dkg.VerifyPacketSignature(config, p)
-		})
+		}, journal, progress)
+	var board Broadcast = echo
 
 	if bp.dkgBoardSetup != nil {
 		board = bp.dkgBoardSetup(board)
 	}
-	phaser := bp.getPhaser(timeout)
+	phaser := bp.getPhaser(journal.state.Timeout, journal.startTime(), progress)
 
 	dkgProto, err := dkg.NewProtocol(config, board, phaser, true)
 	if err != nil {
 		return nil, err
 	}
+	echo.replay(journal.packets())
+
 	info := &dkgInfo{
-		target: newGroup,
-		board:  board,
-		phaser: phaser,
-		conf:   config,
-		proto:  dkgProto,
+		target:   newGroup,
+		board:    board,
+		phaser:   phaser,
+		conf:     config,
+		proto:    dkgProto,
+		journal:  journal,
+		progress: progress,
 	}
 	bp.state.Lock()
 	bp.dkgInfo = info
+	bp.dkgProgress = progress
 	if leader {
 		bp.log.Infow("", "dkg_reshare", "leader_start",
 			"target_group", hex.EncodeToString(newGroup.Hash()), "index", newNode.Index)
+	}
+	if leader || !journal.startTime().IsZero() {
 		bp.dkgInfo.started = true
 	}
 
 	metrics.ReshareStateChange(metrics.ReshareInProgess, oldBeaconID, leader)
 	bp.state.Unlock()
 
-	if leader {
+	if info.started {
 		// start the protocol so everyone else follows
 		// it sends to all previous and new nodes. old nodes will start their
 		// phaser so they will send the deals as soon as they receive this.
-		go phaser.Start()
+		info.startPhaser(bp.log, bp.opts.clock)
 	}
+	return info, nil
+}
+
+// finishResharing waits until the resharing finishes, and then runs the
+// transition of the beacon to the new group.
+func (bp *BeaconProcess) finishResharing(leader bool, oldGroup *key.Group, info *dkgInfo) (*key.Group, error) {
+	oldBeaconID := commonutils.GetCanonicalBeaconID(oldGroup.ID)
 
 	bp.log.Infow("", "dkg_reshare", "wait_dkg_end")
 	finalGroup, err := bp.WaitDKG()
@@ -547,11 +900,64 @@ func (bp *BeaconProcess) runResharing(leader bool, oldGroup, newGroup *key.Group
 	bp.log.Infow("", "dkg_reshare", "finished", "leader", leader)
 	metrics.ReshareStateChange(metrics.ReshareIdle, oldBeaconID, leader)
 
-	// runs the transition of the beacon
+	// runs the transition of the beacon. A node that rotated its key took part
+	// in the resharing as a new node only, but its beacon still runs the old
+	// group with its archived key until the transition, and goes on with the
+	// new one.
+	oldPresent := bp.findSelf(oldGroup) != nil
+	newPresent := info.target.Find(bp.priv.Public) != nil
 	go bp.transition(oldGroup, oldPresent, newPresent)
 	return finalGroup, nil
 }
 
+// ResumeDKG rejoins the DKG or resharing that was in progress when this node
+// stopped, from the state persisted in the beacon folder, if its phases are not
+// over yet. The protocol runs in the background, as it would have, had the node
+// not stopped.
+func (bp *BeaconProcess) ResumeDKG() error {
+	journal, err := loadDKGJournal(bp.opts.DKGFolder(bp.beaconID), bp.opts.keyPassphrase)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("drand: can't load dkg state: %w", err)
+	}
+
+	if deadline := journal.deadline(); !bp.opts.clock.Now().Before(deadline) {
+		bp.log.Infow("", "resume_dkg", "expired", "deadline", deadline.Unix())
+		return journal.remove()
+	}
+	target, previous, err := journal.groups()
+	if err != nil {
+		return fmt.Errorf("drand: invalid dkg state: %w", err)
+	}
+
+	sent, received := journal.packets()
+	bp.log.Infow("", "resume_dkg", "resuming", "reshare", journal.state.Reshare,
+		"target_group", hex.EncodeToString(target.Hash()), "sent", len(sent), "received", len(received))
+
+	if !journal.state.Reshare {
+		info, err := bp.setupDKG(false, target, journal)
+		if err != nil {
+			return err
+		}
+		go func() {
+			_, _ = bp.finishDKG(false, info)
+		}()
+		return nil
+	}
+
+	info, err := bp.setupResharing(false, previous, target, journal)
+	if err != nil {
+		return err
+	}
+	go func() {
+		if _, err := bp.finishResharing(false, previous, info); err != nil {
+			bp.log.Errorw("", "resume_dkg", "reshare failed", "err", err)
+		}
+	}()
+	return nil
+}
+
 // This method sends the public key to the denoted leader address and then waits
 // to receive the group file. After receiving it, it starts the DKG process in
 // "waiting" mode, waiting for the leader to send the first packet.
@@ -635,7 +1041,7 @@ func (bp *BeaconProcess) setupAutomaticDKG(_ context.Context, in *drand.InitDKGP
 	bp.state.Unlock()
 
 	// run the dkg
-	finalGroup, err := bp.runDKG(false, group, dkgTimeout, in.GetEntropy())
+	finalGroup, err := bp.runDKG(false, group, dkgTimeout, 0, in.GetEntropy())
 	if err != nil {
 		return nil, err
 	}
@@ -717,7 +1123,7 @@ func (bp *BeaconProcess) setupAutomaticResharing(_ context.Context, oldGroup *ke
 	}
 
 	// some assertions that should be true but never too safe
-	if err := bp.validateGroupTransition(oldGroup, newGroup); err != nil {
+	if err := bp.validateGroupTransition(oldGroup, newGroup, in.GetEvict()); err != nil {
 		return nil, err
 	}
 
@@ -741,7 +1147,7 @@ func (bp *BeaconProcess) setupAutomaticResharing(_ context.Context, oldGroup *ke
 	bp.state.Unlock()
 
 	// run the dkg !
-	finalGroup, err := bp.runResharing(false, oldGroup, newGroup, dkgTimeout)
+	finalGroup, err := bp.runResharing(false, oldGroup, newGroup, dkgTimeout, 0)
 	if err != nil {
 		bp.log.Errorw("", "setup_reshare", "failed to run resharing", "err", err)
 		return nil, err
@@ -749,7 +1155,13 @@ func (bp *BeaconProcess) setupAutomaticResharing(_ context.Context, oldGroup *ke
 	return finalGroup.ToProto(bp.version), nil
 }
 
-func (bp *BeaconProcess) validateGroupTransition(oldGroup, newGroup *key.Group) error {
+// validateGroupTransition checks the new group of a resharing continues the
+// chain of the old group, and that none of the nodes of the old group at the
+// evicted addresses is part of it. The nodes that rotated their key only take
+// part in the resharing as new nodes, since the DKG deals with their current
+// key, so enough nodes of the old group must keep their key to deal the
+// shares.
+func (bp *BeaconProcess) validateGroupTransition(oldGroup, newGroup *key.Group, evict []string) error {
 	if oldGroup.GenesisTime != newGroup.GenesisTime {
 		bp.log.Errorw("", "setup_reshare", "invalid genesis time in received group")
 		return errors.New("control: old and new group have different genesis time")
@@ -774,9 +1186,85 @@ func (bp *BeaconProcess) validateGroupTransition(oldGroup, newGroup *key.Group)
 		bp.log.Errorw("", "setup_reshare", "invalid_transition", "given", newGroup.TransitionTime, "now", now)
 		return errors.New("control: new group with transition time in the past")
 	}
+
+	evicted, err := bp.evictedNodes(oldGroup, evict)
+	if err != nil {
+		return err
+	}
+	for _, n := range evicted {
+		for _, nn := range newGroup.Nodes {
+			if nn.Address() == n.Address() || nn.Key.Equal(n.Key) {
+				bp.log.Errorw("", "setup_reshare", "evicted node in received group", "addr", n.Address())
+				return fmt.Errorf("control: evicted node %s is part of the new group", n.Address())
+			}
+		}
+	}
+	rotated := 0
+	for _, n := range newGroup.Nodes {
+		if err := validKeyRotation(oldGroup, n.Identity); err != nil {
+			bp.log.Errorw("", "setup_reshare", "invalid key rotation", "addr", n.Address(), "err", err)
+			return fmt.Errorf("control: %w", err)
+		}
+		for _, old := range oldGroup.Nodes {
+			if old.Address() == n.Address() && !old.Key.Equal(n.Key) {
+				rotated++
+			}
+		}
+	}
+	if dealers := oldGroup.Len() - len(evicted) - rotated; rotated > 0 && dealers < oldGroup.Threshold {
+		bp.log.Errorw("", "setup_reshare", "too many key rotations", "rotated", rotated, "dealers", dealers)
+		return fmt.Errorf("control: %d nodes rotated their key and %d are evicted, which leaves %d nodes of the old "+
+			"group to deal their share, below its threshold of %d: rotate fewer keys at once", rotated, len(evicted),
+			dealers, oldGroup.Threshold)
+	}
 	return nil
 }
 
+// validKeyRotation checks that a node of the old group only comes back with a
+// different key if it signed off the rotation with its previous key.
+func validKeyRotation(oldGroup *key.Group, id *key.Identity) error {
+	for _, n := range oldGroup.Nodes {
+		if n.Address() != id.Address() || n.Key.Equal(id.Key) {
+			continue
+		}
+		if err := id.ValidRotation(n.Key); err != nil {
+			return fmt.Errorf("node %s changed its key without a valid rotation signature: %w", id.Address(), err)
+		}
+	}
+	return nil
+}
+
+// evictedNodes returns the nodes of the old group at the evicted addresses.
+// Enough nodes of the old group must remain to run the resharing without
+// them, and this node can't evict itself.
+func (bp *BeaconProcess) evictedNodes(oldGroup *key.Group, evict []string) ([]*key.Node, error) {
+	evicted := make([]*key.Node, 0, len(evict))
+	for _, addr := range evict {
+		if addr == bp.priv.Public.Address() {
+			return nil, errors.New("control: a node can't evict itself")
+		}
+		if nodesContainAddr(evicted, addr) {
+			continue
+		}
+		var node *key.Node
+		for _, n := range oldGroup.Nodes {
+			if n.Address() == addr {
+				node = n
+				break
+			}
+		}
+		if node == nil {
+			return nil, fmt.Errorf("control: evicted node %s is not part of the old group", addr)
+		}
+		evicted = append(evicted, node)
+	}
+	if remaining := oldGroup.Len() - len(evicted); remaining < oldGroup.Threshold {
+		return nil, fmt.Errorf("control: evicting %d nodes leaves %d nodes of the old group, below its threshold of %d",
+			len(evicted), remaining, oldGroup.Threshold)
+	}
+	return evicted, nil
+}
+
 func (bp *BeaconProcess) extractGroup(old *drand.GroupInfo) (*key.Group, error) {
 	bp.state.Lock()
 	defer bp.state.Unlock()
@@ -886,6 +1374,7 @@ func (bp *BeaconProcess) Status(c context.Context, in *drand.StatusRequest) (*dr
 			chainStore.LastRound = lastBeacon.GetRound()
 			chainStore.Length = uint64(bp.beacon.Store().Len())
 		}
+		chainStore.EndRound = bp.beacon.EndRound()
 	}
 
 	// remote network connectivity
@@ -966,15 +1455,21 @@ func extractGroup(i *drand.GroupInfo) (*key.Group, error) {
 	return g, nil
 }
 
-func (bp *BeaconProcess) getPhaser(timeout uint32) *dkg.TimePhaser {
-	tDuration := time.Duration(timeout) * time.Second
-	if timeout == 0 {
-		tDuration = DefaultDKGTimeout
-	}
+// getPhaser returns the phaser of a DKG. If start is set, the DKG started at
+// that time before a restart, and the phaser moves to the phase it is at now,
+// and on at the same deadlines as the other nodes. Each phase is recorded in
+// the progress of the DKG.
+func (bp *BeaconProcess) getPhaser(timeout uint32, start time.Time, progress *dkgProgress) *dkg.TimePhaser {
+	tDuration := dkgPhaseDuration(timeout)
 	// We create a copy of the logger to avoid races when the logger changes
 	logger := bp.log
 	return dkg.NewTimePhaserFunc(func(phase dkg.Phase) {
-		bp.opts.clock.Sleep(tDuration)
+		deadline := bp.opts.clock.Now().Add(tDuration)
+		if !start.IsZero() {
+			deadline = start.Add(time.Duration(phase) * tDuration)
+		}
+		progress.setPhase(phase, deadline)
+		bp.opts.clock.Sleep(deadline.Sub(bp.opts.clock.Now()))
 		logger.Debugw("phaser timeout", "phaser_finished", phase)
 	})
 }
@@ -1351,7 +1846,7 @@ func sendProgressCallback(
 	l log.Logger,
 ) (cb func(b *chain.Beacon), done chan struct{}) {
 	logger := l.Named("progressCb")
-	targ := chain.CurrentRound(clk.Now().Unix(), info.Period, info.GenesisTime)
+	targ := chain.CurrentRoundAt(clk.Now(), info.Period, info.GenesisTime)
 	if upTo != 0 && upTo < targ {
 		targ = upTo
 	}
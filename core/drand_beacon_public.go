@@ -6,6 +6,9 @@ import (
 	"errors"
 	"fmt"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/drand/drand/chain"
 	"github.com/drand/drand/chain/beacon"
 	"github.com/drand/drand/entropy"
@@ -31,7 +34,7 @@ func (bp *BeaconProcess) BroadcastDKG(c context.Context, in *drand.DKGPacket) (*
 		bp.log.Infow("", "init_dkg", "START DKG",
 			"signal from leader", addr, "group", hex.EncodeToString(bp.dkgInfo.target.Hash()))
 		bp.dkgInfo.started = true
-		go bp.dkgInfo.phaser.Start()
+		bp.dkgInfo.startPhaser(bp.log, bp.opts.clock)
 	}
 	if _, err := bp.dkgInfo.board.BroadcastDKG(c, in); err != nil {
 		return nil, err
@@ -56,6 +59,25 @@ func (bp *BeaconProcess) PartialBeacon(c context.Context, in *drand.PartialBeaco
 	return &drand.Empty{Metadata: bp.newMetadata()}, err
 }
 
+// Halt records the vote of another node of the group to end the chain.
+func (bp *BeaconProcess) Halt(c context.Context, in *drand.HaltPacket) (*drand.Empty, error) {
+	bp.state.Lock()
+	if bp.beacon == nil {
+		bp.state.Unlock()
+		return nil, errors.New("DKG not finished yet")
+	}
+	inst, group := bp.beacon, bp.group
+	bp.state.Unlock()
+
+	vote := &haltVote{Round: in.GetRound(), Index: in.GetIndex(), Signature: in.GetSignature()}
+	if _, err := bp.addHaltVote(inst, group, vote); err != nil {
+		bp.log.Errorw("", "chain_halt", "invalid vote", "from", in.GetIndex(), "round", in.GetRound(), "err", err)
+		return nil, err
+	}
+	bp.log.Infow("", "chain_halt", "vote", "from", in.GetIndex(), "round", in.GetRound())
+	return &drand.Empty{Metadata: bp.newMetadata()}, nil
+}
+
 // PublicRand returns a public random beacon according to the request. If the Round
 // field is 0, then it returns the last one generated.
 func (bp *BeaconProcess) PublicRand(c context.Context, in *drand.PublicRandRequest) (*drand.PublicRandResponse, error) {
@@ -67,6 +89,10 @@ func (bp *BeaconProcess) PublicRand(c context.Context, in *drand.PublicRandReque
 	if bp.beacon == nil || len(bp.chainHash) == 0 {
 		return nil, errors.New("drand: beacon generation not started yet")
 	}
+	if err := bp.chainInfo().CheckRound(in.GetRound()); err != nil {
+		bp.log.Debugw("", "public_rand", "after_end", "round", in.GetRound(), "from", addr)
+		return nil, status.Error(codes.OutOfRange, err.Error())
+	}
 	var beaconResp *chain.Beacon
 	var err error
 	if in.GetRound() == 0 {
@@ -100,16 +126,23 @@ func (p *proxyRequest) GetFromRound() uint64 {
 
 type proxyStream struct {
 	drand.Public_PublicRandStreamServer
+	// end is the last round of the chain if it is halted: the stream stops
+	// once it is sent
+	end uint64
 }
 
 func (p *proxyStream) Send(b *drand.BeaconPacket) error {
-	return p.Public_PublicRandStreamServer.Send(&drand.PublicRandResponse{
+	err := p.Public_PublicRandStreamServer.Send(&drand.PublicRandResponse{
 		Round:             b.Round,
 		Signature:         b.Signature,
 		PreviousSignature: b.PreviousSig,
 		Randomness:        chain.RandomnessFromSignature(b.Signature),
 		Metadata:          b.Metadata,
 	})
+	if err == nil && p.end != 0 && b.Round >= p.end {
+		return chain.ErrChainEnded
+	}
+	return err
 }
 
 // PublicRandStream exports a stream of new beacons as they are generated over gRPC
@@ -120,15 +153,36 @@ func (bp *BeaconProcess) PublicRandStream(req *drand.PublicRandRequest, stream d
 		return errors.New("beacon has not started on this node yet")
 	}
 	store := bp.beacon.Store()
+	info := bp.chainInfo()
 
 	proxyReq := &proxyRequest{
 		req,
 	}
 	// make sure we have the correct metadata
 	proxyReq.Metadata = bp.newMetadata()
-	proxyStr := &proxyStream{stream}
+	proxyStr := &proxyStream{Public_PublicRandStreamServer: stream, end: info.EndRound}
 	bp.state.Unlock()
-	return beacon.SyncChain(bp.log.Named("PublicRand"), store, proxyReq, proxyStr)
+
+	// the stream of a retired chain ends with its last round
+	if info.Retired() {
+		if err := info.CheckRound(req.GetRound()); err != nil {
+			return status.Error(codes.OutOfRange, err.Error())
+		}
+		if last, err := store.Last(); req.GetRound() == 0 && err == nil && last.Round >= info.EndRound {
+			return chainEndedError(info)
+		}
+	}
+	err := beacon.SyncChain(bp.log.Named("PublicRand"), store, proxyReq, proxyStr)
+	if errors.Is(err, chain.ErrChainEnded) {
+		return chainEndedError(info)
+	}
+	return err
+}
+
+// chainEndedError is the error telling clients a retired chain has no round
+// after its last one.
+func chainEndedError(info *chain.Info) error {
+	return status.Error(codes.OutOfRange, info.CheckRound(info.EndRound+1).Error())
 }
 
 // PrivateRand returns an ECIES encrypted random blob of 32 bytes from /dev/urandom
@@ -172,18 +226,26 @@ func (bp *BeaconProcess) Home(c context.Context, in *drand.HomeRequest) (*drand.
 // ChainInfo replies with the chain information this node participates to
 func (bp *BeaconProcess) ChainInfo(ctx context.Context, in *drand.ChainInfoRequest) (*drand.ChainInfoPacket, error) {
 	bp.state.Lock()
-	group := bp.group
-	chainHash := bp.chainHash
-	bp.state.Unlock()
-	if group == nil || len(chainHash) == 0 {
+	defer bp.state.Unlock()
+	if bp.group == nil || len(bp.chainHash) == 0 {
 		return nil, errors.New("no dkg group setup yet")
 	}
 
-	response := chain.NewChainInfo(group).ToProto(bp.newMetadata())
+	response := bp.chainInfo().ToProto(bp.newMetadata())
 
 	return response, nil
 }
 
+// chainInfo returns the information of the chain, with its last round if it is
+// halted. It requires the state lock.
+func (bp *BeaconProcess) chainInfo() *chain.Info {
+	info := chain.NewChainInfo(bp.group)
+	if bp.beacon != nil {
+		info.EndRound = bp.beacon.EndRound()
+	}
+	return info
+}
+
 // SignalDKGParticipant receives a dkg signal packet from another member
 func (bp *BeaconProcess) SignalDKGParticipant(ctx context.Context, p *drand.SignalDKGPacket) (*drand.Empty, error) {
 	bp.state.Lock()
@@ -1,13 +1,19 @@
 package core
 
 import (
+	"context"
+	"path/filepath"
 	"testing"
 	"time"
 
 	clock "github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
 
+	"github.com/drand/drand/common/scheme"
 	"github.com/drand/drand/key"
 	"github.com/drand/drand/log"
+	"github.com/drand/drand/protobuf/drand"
+	"github.com/drand/drand/test"
 	"github.com/drand/kyber"
 	"github.com/drand/kyber/util/random"
 )
@@ -19,7 +25,7 @@ func TestValidateGroupTransitionGenesisTime(t *testing.T) {
 	oldgrp = key.Group{GenesisTime: 0}
 	newgrp = key.Group{GenesisTime: 1}
 
-	err := d.validateGroupTransition(&oldgrp, &newgrp)
+	err := d.validateGroupTransition(&oldgrp, &newgrp, nil)
 	if err == nil {
 		t.Fatal("expected error validating group genesis time")
 	}
@@ -35,7 +41,7 @@ func TestValidateGroupTransitionPeriod(t *testing.T) {
 	oldgrp = key.Group{Period: 10}
 	newgrp = key.Group{Period: 20}
 
-	err := d.validateGroupTransition(&oldgrp, &newgrp)
+	err := d.validateGroupTransition(&oldgrp, &newgrp, nil)
 	if err == nil {
 		t.Fatal("expected error validating group period")
 	}
@@ -51,7 +57,7 @@ func TestValidateGroupTransitionBeaconID(t *testing.T) {
 	oldgrp = key.Group{ID: "beacon_test_1"}
 	newgrp = key.Group{ID: "beacon_test_2"}
 
-	err := d.validateGroupTransition(&oldgrp, &newgrp)
+	err := d.validateGroupTransition(&oldgrp, &newgrp, nil)
 	if err == nil {
 		t.Fatal("expected error validating group period")
 	}
@@ -76,7 +82,7 @@ func TestValidateGroupTransitionGenesisSeed(t *testing.T) {
 	oldgrp = key.Group{PublicKey: randomDistPublic(4)}
 	newgrp = key.Group{PublicKey: randomDistPublic(3)}
 
-	err := d.validateGroupTransition(&oldgrp, &newgrp)
+	err := d.validateGroupTransition(&oldgrp, &newgrp, nil)
 	if err == nil {
 		t.Fatal("expected error validating group genesis seed")
 	}
@@ -95,7 +101,7 @@ func TestValidateGroupTransitionTime(t *testing.T) {
 	oldgrp = key.Group{TransitionTime: time.Now().Unix()}
 	newgrp = key.Group{TransitionTime: time.Now().Unix() - 1, GenesisSeed: oldgrp.GetGenesisSeed()}
 
-	err := d.validateGroupTransition(&oldgrp, &newgrp)
+	err := d.validateGroupTransition(&oldgrp, &newgrp, nil)
 	if err == nil {
 		t.Fatal("expected error validating group period")
 	}
@@ -103,3 +109,109 @@ func TestValidateGroupTransitionTime(t *testing.T) {
 		t.Fatal("unexpected validation error", err)
 	}
 }
+
+func TestValidateGroupTransitionEvict(t *testing.T) {
+	pairs, oldgrp := test.BatchIdentities(4, scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv())
+	d := BeaconProcess{
+		log:  log.DefaultLogger(),
+		opts: &Config{clock: clock.NewRealClock()},
+	}
+	for _, p := range pairs {
+		if p.Public.Address() == oldgrp.Nodes[0].Address() {
+			d.priv = p
+		}
+	}
+	newgrp := *oldgrp
+	newgrp.Nodes = oldgrp.Nodes[:3]
+	newgrp.TransitionTime = time.Now().Unix() + 60
+	newgrp.GenesisSeed = oldgrp.GetGenesisSeed()
+
+	require.NoError(t, d.validateGroupTransition(oldgrp, &newgrp, []string{oldgrp.Nodes[3].Address()}))
+
+	vectors := []struct {
+		evict []string
+		err   string
+	}{
+		{[]string{oldgrp.Nodes[2].Address()}, "is part of the new group"},
+		{[]string{"127.0.0.1:1"}, "is not part of the old group"},
+		{[]string{oldgrp.Nodes[0].Address()}, "can't evict itself"},
+		{[]string{oldgrp.Nodes[2].Address(), oldgrp.Nodes[3].Address()}, "below its threshold"},
+	}
+	for i, v := range vectors {
+		require.ErrorContains(t, d.validateGroupTransition(oldgrp, &newgrp, v.evict), v.err, "vector %d", i)
+	}
+}
+
+func TestValidateGroupTransitionRotation(t *testing.T) {
+	pairs, oldgrp := test.BatchIdentities(4, scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv())
+	d := BeaconProcess{
+		log:  log.DefaultLogger(),
+		opts: &Config{clock: clock.NewRealClock()},
+		priv: pairs[0],
+	}
+	var previous *key.Pair
+	for _, p := range pairs {
+		if p.Public.Address() == oldgrp.Nodes[3].Address() {
+			previous = p
+		}
+	}
+	next := key.NewTLSKeyPair(previous.Public.Address())
+
+	newgrp := *oldgrp
+	newgrp.Nodes = append(append([]*key.Node{}, oldgrp.Nodes[:3]...), &key.Node{Identity: next.Public, Index: 3})
+	newgrp.TransitionTime = time.Now().Unix() + 60
+	newgrp.GenesisSeed = oldgrp.GetGenesisSeed()
+	require.ErrorContains(t, d.validateGroupTransition(oldgrp, &newgrp, nil), "without a valid rotation signature")
+
+	pairs[1].SignRotation(next.Public)
+	require.ErrorContains(t, d.validateGroupTransition(oldgrp, &newgrp, nil), "without a valid rotation signature")
+
+	previous.SignRotation(next.Public)
+	require.NoError(t, d.validateGroupTransition(oldgrp, &newgrp, nil))
+
+	// rotating a second key leaves 2 nodes of the old group to deal their
+	// share, below its threshold of 3
+	require.Equal(t, 3, oldgrp.Threshold)
+	var other *key.Pair
+	for _, p := range pairs {
+		if p.Public.Address() == oldgrp.Nodes[2].Address() {
+			other = p
+		}
+	}
+	otherNext := key.NewTLSKeyPair(other.Public.Address())
+	other.SignRotation(otherNext.Public)
+	tooMany := newgrp
+	tooMany.Nodes = append(append([]*key.Node{}, oldgrp.Nodes[:2]...),
+		&key.Node{Identity: otherNext.Public, Index: 2}, newgrp.Nodes[3])
+	require.ErrorContains(t, d.validateGroupTransition(oldgrp, &tooMany, nil), "rotate fewer keys at once")
+
+	// the node finds itself in the old group with its archived key
+	d.priv = next
+	require.Nil(t, d.findSelf(oldgrp))
+	d.archived = []*key.Pair{previous}
+	require.Equal(t, oldgrp.Nodes[3], d.findSelf(oldgrp))
+	require.Equal(t, newgrp.Nodes[3], d.findSelf(&newgrp))
+}
+
+func TestInitReshareRotatedLeader(t *testing.T) {
+	pairs, oldgrp := test.BatchIdentities(4, scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv())
+	groupFile := filepath.Join(t.TempDir(), "group.toml")
+	require.NoError(t, key.Save(groupFile, oldgrp, false))
+
+	previous := pairs[0]
+	next := key.NewTLSKeyPair(previous.Public.Address())
+	previous.SignRotation(next.Public)
+	d := BeaconProcess{
+		log:      log.DefaultLogger(),
+		opts:     &Config{clock: clock.NewRealClock()},
+		beaconID: oldgrp.ID,
+		priv:     next,
+		archived: []*key.Pair{previous},
+	}
+
+	_, err := d.InitReshare(context.Background(), &drand.InitResharePacket{
+		Old:  &drand.GroupInfo{Location: &drand.GroupInfo_Path{Path: groupFile}},
+		Info: &drand.SetupInfoPacket{Leader: true},
+	})
+	require.ErrorIs(t, err, errRotatedLeader)
+}
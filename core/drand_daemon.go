@@ -244,7 +244,7 @@ func (dd *DrandDaemon) RemoveBeaconHandler(beaconID string, bp *BeaconProcess) {
 // accordingly to each stored BeaconID
 func (dd *DrandDaemon) LoadBeaconsFromDisk(metricsFlag string) error {
 	// Load possible existing stores
-	stores, err := key.NewFileStores(dd.opts.ConfigFolderMB())
+	stores, err := dd.opts.KeyStores()
 	if err != nil {
 		return err
 	}
@@ -272,7 +272,7 @@ func (dd *DrandDaemon) LoadBeaconsFromDisk(metricsFlag string) error {
 }
 
 func (dd *DrandDaemon) LoadBeaconFromDisk(beaconID string) (*BeaconProcess, error) {
-	store := key.NewFileStore(dd.opts.ConfigFolderMB(), beaconID)
+	store := dd.opts.KeyStore(beaconID)
 	return dd.LoadBeaconFromStore(beaconID, store)
 }
 
@@ -303,5 +303,10 @@ func (dd *DrandDaemon) LoadBeaconFromStore(beaconID string, store key.Store) (*B
 		bp.StartBeacon(catchup)
 	}
 
+	// rejoin the DKG or resharing that was in progress before the restart, if any
+	if err := bp.ResumeDKG(); err != nil {
+		dd.log.Errorw("", "beacon id", beaconID, "resume_dkg", err)
+	}
+
 	return bp, nil
 }
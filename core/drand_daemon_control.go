@@ -5,7 +5,6 @@ import (
 	"fmt"
 
 	"github.com/drand/drand/common/scheme"
-	"github.com/drand/drand/key"
 	"github.com/drand/drand/metrics"
 	"github.com/drand/drand/protobuf/common"
 	"github.com/drand/drand/protobuf/drand"
@@ -26,7 +25,7 @@ func (dd *DrandDaemon) InitDKG(c context.Context, in *drand.InitDKGPacket) (*dra
 		if !isStoreLoaded {
 			dd.log.Infow("", "init_dkg", "loading store from disk")
 
-			newStore := key.NewFileStore(dd.opts.ConfigFolderMB(), beaconID)
+			newStore := dd.opts.KeyStore(beaconID)
 			store = &newStore
 		}
 
@@ -40,6 +39,34 @@ func (dd *DrandDaemon) InitDKG(c context.Context, in *drand.InitDKGPacket) (*dra
 	return bp.InitDKG(c, in)
 }
 
+// InitDKGLeaderless takes a proposal signed by all the participants of a DKG,
+// and runs the DKG it describes without a leader.
+func (dd *DrandDaemon) InitDKGLeaderless(c context.Context, in *drand.InitDKGLeaderlessPacket) (*drand.GroupPacket, error) {
+	beaconID, err := dd.readBeaconID(in.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+
+	bp, err := dd.getBeaconProcessByID(beaconID)
+	if err != nil {
+		store, isStoreLoaded := dd.initialStores[beaconID]
+		if !isStoreLoaded {
+			dd.log.Infow("", "init_dkg", "loading store from disk")
+
+			newStore := dd.opts.KeyStore(beaconID)
+			store = &newStore
+		}
+
+		dd.log.Infow("", "init_dkg", "instantiating a new beacon process")
+		bp, err = dd.InstantiateBeaconProcess(beaconID, *store)
+		if err != nil {
+			return nil, fmt.Errorf("something went wrong try to initiate DKG. err: %w", err)
+		}
+	}
+
+	return bp.InitDKGLeaderless(c, in)
+}
+
 // InitReshare receives information about the old and new group from which to
 // operate the resharing protocol.
 func (dd *DrandDaemon) InitReshare(ctx context.Context, in *drand.InitResharePacket) (*drand.GroupPacket, error) {
@@ -58,7 +85,7 @@ func (dd *DrandDaemon) InitReshare(ctx context.Context, in *drand.InitResharePac
 		if !isStoreLoaded {
 			dd.log.Infow("", "init_reshare", "loading store from disk")
 
-			newStore := key.NewFileStore(dd.opts.ConfigFolderMB(), beaconID)
+			newStore := dd.opts.KeyStore(beaconID)
 			store = &newStore
 		}
 
@@ -75,6 +102,16 @@ func (dd *DrandDaemon) InitReshare(ctx context.Context, in *drand.InitResharePac
 	return bp.InitReshare(ctx, in)
 }
 
+// DKGStatus streams the progress of the DKG of a beacon.
+func (dd *DrandDaemon) DKGStatus(in *drand.DKGStatusRequest, stream drand.Control_DKGStatusServer) error {
+	bp, err := dd.getBeaconProcessFromRequest(in.GetMetadata())
+	if err != nil {
+		return err
+	}
+
+	return bp.DKGStatus(in, stream)
+}
+
 // PingPong simply responds with an empty packet, proving that this drand node
 // is up and alive.
 func (dd *DrandDaemon) PingPong(ctx context.Context, in *drand.Ping) (*drand.Pong, error) {
@@ -108,6 +145,28 @@ func (dd *DrandDaemon) Share(ctx context.Context, in *drand.ShareRequest) (*dran
 	return bp.Share(ctx, in)
 }
 
+// Participation is a functionality of Control Service defined in
+// protobuf/control that requests the partial beacons received for the last
+// rounds
+func (dd *DrandDaemon) Participation(ctx context.Context, in *drand.ParticipationRequest) (*drand.ParticipationResponse, error) {
+	bp, err := dd.getBeaconProcessFromRequest(in.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+
+	return bp.Participation(ctx, in)
+}
+
+// ScheduleHalt votes to end the chain at a round
+func (dd *DrandDaemon) ScheduleHalt(ctx context.Context, in *drand.ScheduleHaltRequest) (*drand.ScheduleHaltResponse, error) {
+	bp, err := dd.getBeaconProcessFromRequest(in.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+
+	return bp.ScheduleHalt(ctx, in)
+}
+
 // PublicKey is a functionality of Control Service defined in protobuf/control
 // that requests the long term public key of the drand node running locally
 func (dd *DrandDaemon) PublicKey(ctx context.Context, in *drand.PublicKeyRequest) (*drand.PublicKeyResponse, error) {
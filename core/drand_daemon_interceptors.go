@@ -24,30 +24,8 @@ func (dd *DrandDaemon) NodeVersionValidator(ctx context.Context, req interface{}
 		return handler(ctx, req)
 	}
 
-	metadata := reqWithContext.GetMetadata()
-	if metadata == nil {
-		return handler(ctx, req)
-	}
-
-	v := metadata.GetNodeVersion()
-	if v == nil {
-		return handler(ctx, req)
-	}
-
-	prerelease := ""
-	if v.Prerelease != nil {
-		prerelease = *v.Prerelease
-	}
-	rcvVer := commonutils.Version{
-		Major:      v.Major,
-		Minor:      v.Minor,
-		Patch:      v.Patch,
-		Prerelease: prerelease,
-	}
-	if !dd.version.IsCompatible(rcvVer) {
-		dd.log.Warnw("", "node_version_interceptor", "node version rcv is no compatible --> rejecting message", "version", rcvVer)
-		msg := fmt.Sprintf("Incompatible node version. Current: %v, received: %v", dd.version, rcvVer)
-		return nil, status.Error(codes.PermissionDenied, msg)
+	if err := dd.checkNodeVersion(reqWithContext.GetMetadata()); err != nil {
+		return nil, err
 	}
 
 	return handler(ctx, req)
@@ -61,14 +39,24 @@ func (dd *DrandDaemon) NodeVersionStreamValidator(srv interface{}, ss grpc.Serve
 		return handler(srv, ss)
 	}
 
-	metadata := reqWithContext.GetMetadata()
+	if err := dd.checkNodeVersion(reqWithContext.GetMetadata()); err != nil {
+		return err
+	}
+
+	return handler(srv, ss)
+}
+
+// checkNodeVersion returns an error if the metadata of a request carries a node
+// version incompatible with the version of this node. Requests without metadata
+// or version are accepted.
+func (dd *DrandDaemon) checkNodeVersion(metadata *common.Metadata) error {
 	if metadata == nil {
-		return handler(srv, ss)
+		return nil
 	}
 
 	v := metadata.GetNodeVersion()
 	if v == nil {
-		return handler(srv, ss)
+		return nil
 	}
 
 	prerelease := ""
@@ -82,5 +70,5 @@ func (dd *DrandDaemon) NodeVersionStreamValidator(srv interface{}, ss grpc.Serve
 		return status.Error(codes.PermissionDenied, msg)
 	}
 
-	return handler(srv, ss)
+	return nil
 }
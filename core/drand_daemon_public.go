@@ -2,6 +2,8 @@ package core
 
 import (
 	"context"
+	"errors"
+	"io"
 
 	"github.com/drand/drand/protobuf/common"
 	"github.com/drand/drand/protobuf/drand"
@@ -28,6 +30,52 @@ func (dd *DrandDaemon) PartialBeacon(c context.Context, in *drand.PartialBeaconP
 	return bp.PartialBeacon(c, in)
 }
 
+// Halt records the vote of another node to end the chain
+func (dd *DrandDaemon) Halt(c context.Context, in *drand.HaltPacket) (*drand.Empty, error) {
+	bp, err := dd.getBeaconProcessFromRequest(in.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+
+	return bp.Halt(c, in)
+}
+
+// PartialBeaconStream receives the partial beacons of a peer over a stream, and
+// acknowledges each of them in order. The node version of each partial beacon
+// is validated as the interceptors do for unary calls.
+func (dd *DrandDaemon) PartialBeaconStream(stream drand.Protocol_PartialBeaconStreamServer) error {
+	for {
+		in, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		ack := &drand.PartialBeaconAck{Round: in.GetRound(), Metadata: common.NewMetadata(dd.version.ToProto())}
+		ack.Metadata.BeaconID = in.GetMetadata().GetBeaconID()
+		if err := dd.processStreamedPartial(stream.Context(), in); err != nil {
+			ack.Error = err.Error()
+		}
+		if err := stream.Send(ack); err != nil {
+			return err
+		}
+	}
+}
+
+func (dd *DrandDaemon) processStreamedPartial(c context.Context, in *drand.PartialBeaconPacket) error {
+	if err := dd.checkNodeVersion(in.GetMetadata()); err != nil {
+		return err
+	}
+	bp, err := dd.getBeaconProcessFromRequest(in.GetMetadata())
+	if err != nil {
+		return err
+	}
+	_, err = bp.PartialBeacon(c, in)
+	return err
+}
+
 // PublicRand returns a public random beacon according to the request. If the Round
 // field is 0, then it returns the last one generated.
 func (dd *DrandDaemon) PublicRand(c context.Context, in *drand.PublicRandRequest) (*drand.PublicRandResponse, error) {
@@ -74,7 +74,7 @@ func (d *drandProxy) RoundAt(t time.Time) uint64 {
 	if err != nil {
 		return 0
 	}
-	return chain.CurrentRound(t.Unix(), info.Period, info.GenesisTime)
+	return chain.CurrentRoundAt(t, info.Period, info.GenesisTime)
 }
 
 func (d *drandProxy) Close() error {
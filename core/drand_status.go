@@ -81,6 +81,9 @@ func StatusResponseToString(status *drand.StatusResponse) string {
 	fmt.Fprintf(output, "* ChainStore \n")
 	fmt.Fprintf(output, " - IsEmpty: %t \n", status.ChainStore.IsEmpty)
 	fmt.Fprintf(output, " - LastRound: %d \n", status.ChainStore.LastRound)
+	if end := status.ChainStore.GetEndRound(); end != 0 {
+		fmt.Fprintf(output, " - EndRound: %d \n", end)
+	}
 	fmt.Fprintf(output, "* BeaconProcess \n")
 	fmt.Fprintf(output, " - Status: %s \n", beaconStatus)
 	fmt.Fprintf(output, " - Stopped: %t \n", status.Beacon.IsStopped)
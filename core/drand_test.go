@@ -22,6 +22,9 @@ import (
 	"github.com/drand/drand/protobuf/common"
 	"github.com/drand/drand/protobuf/drand"
 	"github.com/drand/drand/test"
+	"github.com/drand/kyber"
+	"github.com/drand/kyber/share"
+	"github.com/drand/kyber/share/dkg"
 )
 
 func setFDLimit(t *testing.T) {
@@ -174,6 +177,226 @@ func TestDrandDKGFresh(t *testing.T) {
 	assert.Equal(t, uint64(2), response.Round)
 }
 
+// Test nodes streaming their partial beacons run the chain along with nodes
+// sending them with unary calls
+func TestDrandPartialStreams(t *testing.T) {
+	n := 4
+	beaconPeriod := 1 * time.Second
+	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
+
+	dt := NewDrandTestScenario(t, n, key.DefaultThreshold(n), beaconPeriod, sch, beaconID)
+	defer dt.Cleanup()
+	for _, node := range dt.nodes[:2] {
+		node.drand.opts.streamPartials = true
+	}
+
+	group := dt.RunDKG()
+	dt.SetMockClock(t, group.GenesisTime)
+	dt.CheckBeaconLength(t, dt.nodes, 2)
+	for round := 3; round <= 5; round++ {
+		dt.AdvanceMockClock(t, beaconPeriod)
+		dt.CheckBeaconLength(t, dt.nodes, round)
+	}
+
+	// the partials of the streaming nodes reached the others
+	last := dt.nodes[n-1].drand
+	require.Eventually(t, func() bool {
+		for _, r := range last.beacon.Participation(0) {
+			if r.Round == 4 {
+				return len(r.Partials) == n
+			}
+		}
+		return false
+	}, 5*time.Second, 100*time.Millisecond)
+}
+
+// Test the nodes record the partial beacons each of them sent for a round
+func TestDrandParticipation(t *testing.T) {
+	n := 4
+	beaconPeriod := 1 * time.Second
+	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
+
+	dt := NewDrandTestScenario(t, n, key.DefaultThreshold(n), beaconPeriod, sch, beaconID)
+	defer dt.Cleanup()
+
+	group := dt.RunDKG()
+	dt.SetMockClock(t, group.GenesisTime)
+	dt.CheckBeaconLength(t, dt.nodes, 2)
+	dt.AdvanceMockClock(t, beaconPeriod)
+	dt.CheckBeaconLength(t, dt.nodes, 3)
+
+	client, err := net.NewControlClient(dt.nodes[0].drand.opts.controlPort)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		resp, err := client.Participation(beaconID, 0)
+		require.NoError(t, err)
+		require.Equal(t, uint32(n), resp.GetGroupSize())
+		for _, r := range resp.GetRounds() {
+			if r.GetRound() == 2 {
+				return r.GetAggregated() && len(r.GetPartials()) == n && r.GetInvalid() == 0
+			}
+		}
+		return false
+	}, 5*time.Second, 100*time.Millisecond)
+
+	resp, err := client.Participation(beaconID, 1)
+	require.NoError(t, err)
+	require.Len(t, resp.GetRounds(), 1)
+	indexes := make([]uint32, 0, n)
+	for _, node := range group.Nodes {
+		indexes = append(indexes, node.Index)
+	}
+	require.Equal(t, indexes, resp.GetIndexes())
+}
+
+// Test a chain with a sub-second period, created through the DKG of a leader
+func TestDrandSubSecondPeriod(t *testing.T) {
+	n := 3
+	beaconPeriod := 500 * time.Millisecond
+	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
+
+	dt := NewDrandTestScenario(t, n, key.DefaultThreshold(n), beaconPeriod, sch, beaconID)
+	defer dt.Cleanup()
+
+	group := dt.RunDKG()
+	require.Equal(t, beaconPeriod, group.Period)
+
+	chainInfo := chain.NewChainInfo(group)
+	client := NewGrpcClientFromCert(chainInfo.Hash(), dt.nodes[0].drand.opts.certmanager)
+	received, err := client.ChainInfo(dt.nodes[1].drand.priv.Public)
+	require.NoError(t, err)
+	require.Equal(t, beaconPeriod, received.Period)
+	require.Equal(t, chainInfo.Hash(), received.Hash())
+
+	dt.SetMockClock(t, group.GenesisTime)
+	dt.CheckBeaconLength(t, dt.nodes, 2)
+	dt.AdvanceMockClock(t, beaconPeriod)
+	dt.CheckBeaconLength(t, dt.nodes, 3)
+	dt.AdvanceMockClock(t, beaconPeriod)
+	dt.CheckBeaconLength(t, dt.nodes, 4)
+}
+
+// Test the chain ends at the round a threshold of nodes voted for, and is still
+// served after it
+func TestDrandScheduleHalt(t *testing.T) {
+	n := 4
+	thr := key.DefaultThreshold(n)
+	p := 1 * time.Second
+	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
+
+	dt := NewDrandTestScenario(t, n, thr, p, sch, beaconID)
+	defer dt.Cleanup()
+
+	group := dt.RunDKG()
+	dt.SetMockClock(t, group.GenesisTime)
+	dt.CheckBeaconLength(t, dt.nodes, 2)
+
+	end := uint64(4)
+	for i, node := range dt.nodes[:thr] {
+		ctrl, err := net.NewControlClient(node.drand.opts.controlPort)
+		require.NoError(t, err)
+		resp, err := ctrl.ScheduleHalt(beaconID, end)
+		require.NoError(t, err)
+		require.Equal(t, end, resp.GetRound())
+		require.Len(t, resp.GetVotes(), i+1)
+		require.Equal(t, uint32(thr), resp.GetThreshold())
+		require.Empty(t, resp.GetUnreachable())
+	}
+
+	// the end can't change once agreed, and can't be in the past
+	ctrl, err := net.NewControlClient(dt.nodes[n-1].drand.opts.controlPort)
+	require.NoError(t, err)
+	_, err = ctrl.ScheduleHalt(beaconID, end+10)
+	require.Error(t, err)
+	_, err = ctrl.ScheduleHalt(beaconID, 1)
+	require.Error(t, err)
+
+	// the node which didn't vote learned the end too
+	chainInfo := chain.NewChainInfo(group)
+	client := NewGrpcClientFromCert(chainInfo.Hash(), dt.nodes[0].drand.opts.certmanager)
+	received, err := client.ChainInfo(dt.nodes[n-1].drand.priv.Public)
+	require.NoError(t, err)
+	require.Equal(t, end, received.EndRound)
+	require.Equal(t, chainInfo.Hash(), received.Hash())
+
+	for round := uint64(2); round <= end; round++ {
+		dt.AdvanceMockClock(t, p)
+		dt.CheckBeaconLength(t, dt.nodes, int(round)+1)
+	}
+	// no beacon is produced after the end
+	for i := 0; i < 2; i++ {
+		dt.AdvanceMockClock(t, p)
+		time.Sleep(getSleepDuration())
+	}
+	dt.CheckBeaconLength(t, dt.nodes, int(end)+1)
+
+	status, err := ctrl.Status(beaconID)
+	require.NoError(t, err)
+	require.Equal(t, end, status.GetChainStore().GetEndRound())
+
+	// the chain is still served, and has no round after the end
+	root := dt.nodes[0].drand
+	pub := net.NewGrpcClientFromCertManager(root.opts.certmanager)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	resp, err := pub.PublicRand(ctx, root.priv.Public, &drand.PublicRandRequest{Round: end})
+	require.NoError(t, err)
+	require.Equal(t, end, resp.GetRound())
+	_, err = pub.PublicRand(ctx, root.priv.Public, &drand.PublicRandRequest{Round: end + 1})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "chain ended at round 4")
+
+	// the stream ends with the last round
+	stream, err := pub.PublicRandStream(ctx, root.priv.Public, &drand.PublicRandRequest{Round: 2})
+	require.NoError(t, err)
+	var rounds []uint64
+	for b := range stream {
+		rounds = append(rounds, b.GetRound())
+	}
+	require.Equal(t, []uint64{2, 3, 4}, rounds)
+}
+
+// Test a node refuses to load a share that doesn't match its group, and to
+// start a beacon whose distributed key doesn't verify the chain stored
+func TestDrandCheckShareOnLoad(t *testing.T) {
+	n := 4
+	beaconPeriod := 1 * time.Second
+	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
+
+	dt := NewDrandTestScenario(t, n, key.DefaultThreshold(n), beaconPeriod, sch, beaconID)
+	defer dt.Cleanup()
+
+	group := dt.RunDKG()
+	dt.SetMockClock(t, group.GenesisTime)
+	dt.CheckBeaconLength(t, dt.nodes, 2)
+
+	node := dt.nodes[0]
+	dt.StopMockNode(node.addr, false)
+	bp := node.drand
+	_, err := bp.Load()
+	require.NoError(t, err)
+
+	// a corrupted private share
+	valid := bp.share
+	corrupted := &key.Share{
+		Commits: valid.Commits,
+		Share:   &share.PriShare{I: valid.Share.I, V: key.KeyGroup.Scalar().Add(valid.Share.V, key.KeyGroup.Scalar().One())},
+	}
+	require.NoError(t, bp.store.SaveShare(corrupted))
+	_, err = bp.Load()
+	require.ErrorContains(t, err, "doesn't match the commitment")
+	require.NoError(t, bp.store.SaveShare(valid))
+	_, err = bp.Load()
+	require.NoError(t, err)
+
+	// a group whose distributed key didn't sign the chain stored
+	other := *bp.group
+	other.PublicKey = &key.DistPublic{Coefficients: []kyber.Point{key.KeyGroup.Point().Base()}}
+	bp.group = &other
+	_, err = bp.newBeacon()
+	require.ErrorContains(t, err, "doesn't match the chain stored")
+}
+
 // Test dkg when two nodes cannot broadcast messages between them. The rest of the nodes
 // will be able to broadcast messages, so the process should finish successfully
 // Given 4 nodes = [ 0, 1, 2, 3]
@@ -217,6 +440,281 @@ func TestRunDKGBroadcastDeny(t *testing.T) {
 	t.Log("Resharing complete")
 }
 
+// Test that a node killed in the middle of a DKG rejoins it after a restart.
+// Given 4 nodes = [0, 1, 2, 3]
+// 1. Node 3 can't send anything: the others wait for its deal
+// 2. Kill node 3 once it received the deals of the others
+// 3. Restart node 3, that resumes the DKG from its persisted state
+// 4. The DKG finishes with the same group for every node
+func TestRunDKGResumeAfterRestart(t *testing.T) {
+	n := 4
+	beaconPeriod := 1 * time.Second
+	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
+
+	dt := NewDrandTestScenario(t, n, key.DefaultThreshold(n), beaconPeriod, sch, beaconID)
+	defer dt.Cleanup()
+
+	secret := "thisisdkg"
+	leader := dt.nodes[0]
+	killed := dt.nodes[n-1]
+	client := killed.drand.privGateway.ProtocolClient
+	killed.drand.DenyBroadcastTo(t, dt.Ids(n-1, false)...)
+
+	groups := make(chan *key.Group, n-1)
+	go func() {
+		controlClient, err := net.NewControlClient(leader.drand.opts.controlPort)
+		require.NoError(t, err)
+		groupPacket, err := controlClient.InitDKGLeader(n, dt.thr, dt.period, dt.catchupPeriod, testDkgTimeout,
+			nil, secret, testBeaconOffset, sch.ID, dt.beaconID)
+		require.NoError(t, err)
+		group, err := key.GroupFromProto(groupPacket)
+		require.NoError(t, err)
+		groups <- group
+	}()
+	for _, node := range dt.nodes[1:] {
+		go func(node *MockNode) {
+			controlClient, err := net.NewControlClient(node.drand.opts.controlPort)
+			require.NoError(t, err)
+			groupPacket, err := controlClient.InitDKG(leader.drand.priv.Public, nil, secret, dt.beaconID)
+			if node == killed {
+				// the DKG is aborted when the node is killed
+				return
+			}
+			require.NoError(t, err)
+			group, err := key.GroupFromProto(groupPacket)
+			require.NoError(t, err)
+			groups <- group
+		}(node)
+	}
+
+	// the killed node persisted the deals of the others before it stops
+	folder := killed.drand.opts.DKGFolder(dt.beaconID)
+	require.Eventually(t, func() bool {
+		journal, err := loadDKGJournal(folder, nil)
+		if err != nil {
+			return false
+		}
+		sent, received := journal.packets()
+		return len(sent) > 0 && len(received) >= n-1 && !journal.startTime().IsZero()
+	}, 10*time.Second, 50*time.Millisecond)
+
+	t.Log("Killing node", killed.addr)
+	killed.drand.Stop(context.Background())
+	killed.drand.privGateway.ProtocolClient = client
+
+	t.Log("Restarting node", killed.addr)
+	bp, err := killed.daemon.LoadBeaconFromStore(dt.beaconID, killed.drand.store)
+	require.NoError(t, err)
+	killed.drand = bp
+
+	var group *key.Group
+	for i := 0; i < n-1; i++ {
+		select {
+		case g := <-groups:
+			if group == nil {
+				group = g
+			}
+			require.Equal(t, group.Hash(), g.Hash())
+		case <-time.After(20 * time.Second):
+			t.Fatal("dkg did not finish")
+		}
+	}
+	require.Len(t, group.Nodes, n)
+
+	require.Eventually(t, func() bool {
+		g, err := bp.store.LoadGroup()
+		return err == nil && g.PublicKey != nil
+	}, 10*time.Second, 50*time.Millisecond)
+	g, err := bp.store.LoadGroup()
+	require.NoError(t, err)
+	require.Equal(t, group.Hash(), g.Hash())
+
+	// the state is removed once the DKG is over
+	_, err = os.Stat(folder)
+	require.True(t, os.IsNotExist(err))
+}
+
+// Test the dkg runs without a leader from a proposal signed by all nodes, and
+// starts at the time of the proposal
+func TestRunDKGLeaderless(t *testing.T) {
+	n := 4
+	beaconPeriod := 1 * time.Second
+	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
+
+	dt := NewDrandTestScenario(t, n, key.DefaultThreshold(n), beaconPeriod, sch, beaconID)
+	defer dt.Cleanup()
+
+	ids := make([]*key.Identity, n)
+	for i, node := range dt.nodes {
+		ids[i] = node.drand.priv.Public
+	}
+	start := dt.Now().Add(10 * time.Second).Unix()
+	genesis := start + int64(3*testDkgTimeout/time.Second) + int64(testBeaconOffset)
+	proposal := key.NewProposal(ids, dt.thr, genesis, start, beaconPeriod, 0, testDkgTimeout, sch, dt.beaconID)
+	for _, node := range dt.nodes[1:] {
+		require.NoError(t, proposal.Sign(node.drand.priv))
+	}
+
+	// a proposal not signed by all nodes is rejected
+	controlClient, err := net.NewControlClient(dt.nodes[0].drand.opts.controlPort)
+	require.NoError(t, err)
+	_, err = controlClient.InitDKGLeaderless(proposal.ToProto(dt.nodes[0].drand.version), nil, "", dt.beaconID)
+	require.ErrorContains(t, err, "missing or invalid signatures")
+	_, errCh, err := controlClient.DKGStatus(context.Background(), false, dt.beaconID)
+	require.NoError(t, err)
+	require.ErrorContains(t, <-errCh, "no dkg ran")
+
+	require.NoError(t, proposal.Sign(dt.nodes[0].drand.priv))
+	groups := make(chan *key.Group, n)
+	for _, node := range dt.nodes {
+		go func(node *MockNode) {
+			controlClient, err := net.NewControlClient(node.drand.opts.controlPort)
+			require.NoError(t, err)
+			groupPacket, err := controlClient.InitDKGLeaderless(proposal.ToProto(node.drand.version), nil, "", dt.beaconID)
+			require.NoError(t, err)
+			group, err := key.GroupFromProto(groupPacket)
+			require.NoError(t, err)
+			groups <- group
+		}(node)
+	}
+
+	// nodes wait for the start of the proposal to send their deals
+	require.Eventually(t, func() bool {
+		for _, node := range dt.nodes {
+			node.drand.state.Lock()
+			info := node.drand.dkgInfo
+			node.drand.state.Unlock()
+			if info == nil {
+				return false
+			}
+		}
+		return true
+	}, 10*time.Second, 50*time.Millisecond)
+	for _, node := range dt.nodes {
+		node.drand.state.Lock()
+		sent, _ := node.drand.dkgInfo.journal.packets()
+		node.drand.state.Unlock()
+		require.Empty(t, sent)
+	}
+	progressCh, errCh, err := controlClient.DKGStatus(context.Background(), true, dt.beaconID)
+	require.NoError(t, err)
+	progress := <-progressCh
+	require.Equal(t, uint32(dkg.InitPhase), progress.Phase)
+	require.False(t, progress.Done)
+	dt.SetMockClock(t, start)
+
+	// the progress is streamed until the DKG is over
+	for p := range progressCh {
+		progress = p
+	}
+	require.NoError(t, <-errCh)
+	require.True(t, progress.Done)
+	require.Empty(t, progress.Error)
+	require.Len(t, progress.Qual, n)
+	for _, dealer := range progress.Dealers {
+		require.True(t, dealer.Deal)
+	}
+
+	var group *key.Group
+	for i := 0; i < n; i++ {
+		select {
+		case g := <-groups:
+			if group == nil {
+				group = g
+			}
+			require.Equal(t, group.Hash(), g.Hash())
+		case <-time.After(20 * time.Second):
+			t.Fatal("dkg did not finish")
+		}
+	}
+	require.Len(t, group.Nodes, n)
+	require.Len(t, group.PublicKey.Coefficients, dt.thr)
+	require.Equal(t, genesis, group.GenesisTime)
+}
+
+// Test a resharing runs without a leader from a proposal signed by all nodes of
+// the new group, with a new node joining
+func TestRunDKGLeaderlessReshare(t *testing.T) {
+	oldNodes, oldThreshold := 3, 2
+	beaconPeriod := 2 * time.Second
+	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
+
+	dt := NewDrandTestScenario(t, oldNodes, oldThreshold, beaconPeriod, sch, beaconID)
+	defer dt.Cleanup()
+
+	group1 := dt.RunDKG()
+	dt.SetMockClock(t, group1.GenesisTime)
+	require.NoError(t, dt.WaitUntilChainIsServing(t, dt.nodes[0]))
+
+	newNode := dt.SetupNewNodes(t, 1)[0]
+	nodes := append(append([]*MockNode{}, dt.nodes...), newNode)
+	ids := make([]*key.Identity, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.drand.priv.Public
+	}
+
+	start := dt.Now().Add(10 * time.Second).Unix()
+	proposal := key.NewProposal(ids, 3, group1.GenesisTime, start, beaconPeriod, group1.CatchupPeriod,
+		testDkgTimeout, sch, dt.beaconID)
+	proposal.Group.GenesisSeed = group1.GetGenesisSeed()
+	_, proposal.Group.TransitionTime = chain.NextRound(start+int64(3*testDkgTimeout/time.Second)+int64(testBeaconOffset),
+		beaconPeriod, group1.GenesisTime)
+	for _, node := range nodes {
+		require.NoError(t, proposal.Sign(node.drand.priv))
+	}
+	require.NoError(t, proposal.Verify())
+
+	groups := make(chan *key.Group, len(nodes))
+	for _, node := range nodes {
+		go func(node *MockNode) {
+			controlClient, err := net.NewControlClient(node.drand.opts.controlPort)
+			require.NoError(t, err)
+			// only the new node needs the current group
+			oldPath := ""
+			if node == newNode {
+				oldPath = dt.groupPath
+			}
+			groupPacket, err := controlClient.InitDKGLeaderless(proposal.ToProto(node.drand.version), nil,
+				oldPath, dt.beaconID)
+			require.NoError(t, err)
+			group, err := key.GroupFromProto(groupPacket)
+			require.NoError(t, err)
+			groups <- group
+		}(node)
+	}
+
+	require.Eventually(t, func() bool {
+		for _, node := range nodes {
+			node.drand.state.Lock()
+			info := node.drand.dkgInfo
+			node.drand.state.Unlock()
+			if info == nil {
+				return false
+			}
+		}
+		return true
+	}, 10*time.Second, 50*time.Millisecond)
+	dt.SetMockClock(t, start)
+
+	var group *key.Group
+	for range nodes {
+		select {
+		case g := <-groups:
+			if group == nil {
+				group = g
+			}
+			require.Equal(t, group.Hash(), g.Hash())
+		case <-time.After(20 * time.Second):
+			t.Fatal("resharing did not finish")
+		}
+	}
+	require.Len(t, group.Nodes, len(nodes))
+	require.Equal(t, 3, group.Threshold)
+	require.NotNil(t, group.Find(newNode.drand.priv.Public))
+	require.Equal(t, proposal.Group.TransitionTime, group.TransitionTime)
+	require.True(t, group.PublicKey.Key().Equal(group1.PublicKey.Key()))
+}
+
 // Test the dkg reshare can be forced to restart and finish successfully
 // when another dkg reshare was running before
 func TestRunDKGReshareForce(t *testing.T) {
@@ -356,6 +854,86 @@ func TestRunDKGReshareAbsentNode(t *testing.T) {
 	require.Nil(t, newGroup.Find(missingPublic), "missing public is found", missingPublic)
 }
 
+// Test a node of the old group is evicted by a resharing it does not take part
+// in
+func TestRunDKGReshareEvict(t *testing.T) {
+	oldNodes, oldThreshold, newThreshold := 4, 3, 2
+	timeout, beaconPeriod := 1*time.Second, 2*time.Second
+	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
+
+	dt := NewDrandTestScenario(t, oldNodes, oldThreshold, beaconPeriod, sch, beaconID)
+	defer dt.Cleanup()
+
+	group1 := dt.RunDKG()
+	dt.SetMockClock(t, group1.GenesisTime)
+	require.NoError(t, dt.WaitUntilChainIsServing(t, dt.nodes[0]))
+
+	// the remaining nodes must be able to reshare without the evicted ones
+	client, err := net.NewControlClient(dt.nodes[0].drand.opts.controlPort)
+	require.NoError(t, err)
+	_, err = client.InitReshareLeader(2, 2, timeout, 0, "secret", "", testBeaconOffset, beaconID,
+		dt.nodes[2].addr, dt.nodes[3].addr)
+	require.ErrorContains(t, err, "below its threshold")
+
+	evicted := dt.nodes[3]
+	newGroup, err := dt.RunReshare(t, &reshareConfig{
+		oldRun:  oldNodes - 1,
+		newThr:  newThreshold,
+		timeout: timeout,
+		evict:   []string{evicted.addr},
+	})
+	require.NoError(t, err)
+	require.Len(t, newGroup.Nodes, oldNodes-1)
+	require.Nil(t, newGroup.Find(evicted.drand.priv.Public))
+	require.True(t, group1.PublicKey.Key().Equal(newGroup.PublicKey.Key()))
+}
+
+// Test a node rotates its key in a resharing that keeps the distributed key
+func TestRunDKGReshareRotateKey(t *testing.T) {
+	oldNodes, oldThreshold := 4, 3
+	timeout, beaconPeriod := 1*time.Second, 2*time.Second
+	sch, beaconID := scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv()
+
+	dt := NewDrandTestScenario(t, oldNodes, oldThreshold, beaconPeriod, sch, beaconID)
+	defer dt.Cleanup()
+
+	group1 := dt.RunDKG()
+	dt.SetMockClock(t, group1.GenesisTime)
+	require.NoError(t, dt.WaitUntilChainIsServing(t, dt.nodes[0]))
+
+	rotated := dt.nodes[oldNodes-1].drand
+	previous := rotated.priv
+	next := key.NewKeyPair(previous.Public.Address())
+	next.Public.TLS = previous.Public.TLS
+	previous.SignRotation(next.Public)
+	require.NoError(t, rotated.store.ArchiveKeyPair(previous))
+	require.NoError(t, rotated.store.SaveKeyPair(next))
+	rotated.state.Lock()
+	rotated.priv = next
+	rotated.archived = []*key.Pair{previous}
+	rotated.state.Unlock()
+
+	newGroup, err := dt.RunReshare(t, &reshareConfig{
+		oldRun:  oldNodes,
+		newThr:  oldThreshold,
+		timeout: timeout,
+	})
+	require.NoError(t, err)
+	require.Len(t, newGroup.Nodes, oldNodes)
+	require.Nil(t, newGroup.Find(previous.Public))
+	require.NotNil(t, newGroup.Find(next.Public))
+	require.True(t, group1.PublicKey.Key().Equal(newGroup.PublicKey.Key()))
+
+	// the node keeps producing beacons with its new key after the transition
+	transition := chain.CurrentRound(newGroup.TransitionTime, newGroup.Period, newGroup.GenesisTime)
+	for round := chain.CurrentRound(dt.Now().Unix(), group1.Period, group1.GenesisTime); round <= transition; round++ {
+		dt.AdvanceMockClock(t, beaconPeriod)
+		for _, n := range dt.nodes {
+			require.NoError(t, dt.WaitUntilRound(t, n, round+1))
+		}
+	}
+}
+
 // The test creates the scenario where one node made a complaint during the DKG, at the second phase, so normally,
 // there should be a "Justification" at the third phase. In this case, there is not. This scenario
 // can happen if there is an offline node right at the beginning of DKG that don't even send any message.
@@ -61,6 +61,8 @@ type setupManager struct {
 	isResharing bool
 	oldGroup    *key.Group
 	oldHash     []byte
+	// evicted are the nodes of the old group that can't join the new group
+	evicted []*key.Node
 
 	startDKG     chan *key.Group
 	pushKeyCh    chan pushKey
@@ -72,8 +74,8 @@ type setupConfig struct {
 	l             log.Logger
 	c             clock.Clock
 	leaderKey     *key.Identity
-	beaconPeriod  uint32
-	catchupPeriod uint32
+	beaconPeriod  time.Duration
+	catchupPeriod time.Duration
 	beaconID      string
 	schemeID      string
 	info          *drand.SetupInfoPacket
@@ -104,8 +106,8 @@ func newDKGSetup(c *setupConfig) (*setupManager, error) {
 		expected:      n,
 		thr:           thr,
 		beaconOffset:  offset,
-		beaconPeriod:  time.Duration(c.beaconPeriod) * time.Second,
-		catchupPeriod: time.Duration(c.catchupPeriod) * time.Second,
+		beaconPeriod:  c.beaconPeriod,
+		catchupPeriod: c.catchupPeriod,
 		scheme:        sch,
 		beaconID:      c.beaconID,
 		dkgTimeout:    dkgTimeout,
@@ -126,17 +128,18 @@ func newReshareSetup(
 	c clock.Clock,
 	leaderKey *key.Identity,
 	oldGroup *key.Group,
+	evicted []*key.Node,
 	in *drand.InitResharePacket,
 ) (*setupManager, error) {
 	// period isn't included for resharing since we keep the same period
-	beaconPeriod := uint32(oldGroup.Period.Seconds())
+	beaconPeriod := oldGroup.Period
 	schemeID := oldGroup.Scheme.ID
 	// we know it was properly set and verified earlier
 	beaconID := oldGroup.ID
 
-	catchupPeriod := in.CatchupPeriod
+	catchupPeriod := time.Duration(in.CatchupPeriod) * time.Second
 	if !in.CatchupPeriodChanged {
-		catchupPeriod = uint32(oldGroup.CatchupPeriod.Seconds())
+		catchupPeriod = oldGroup.CatchupPeriod
 	}
 
 	sm, err := newDKGSetup(&setupConfig{
@@ -152,6 +155,7 @@ func newReshareSetup(
 	sm.oldGroup = oldGroup
 	sm.oldHash = oldGroup.Hash()
 	sm.isResharing = true
+	sm.evicted = evicted
 
 	offset := time.Duration(in.GetInfo().GetBeaconOffset()) * time.Second
 	if offset == 0 {
@@ -191,6 +195,20 @@ func (s *setupManager) ReceivedKey(addr string, p *drand.SignalDKGPacket) error
 		return fmt.Errorf("invalid sig: %w", err)
 	}
 
+	for _, n := range s.evicted {
+		if n.Address() == newID.Address() || n.Key.Equal(newID.Key) {
+			s.l.Infow("", "setup", "evicted", "id", addr, "addr", newID.Address())
+			return errors.New("node is evicted from the group")
+		}
+	}
+
+	if s.isResharing {
+		if err := validKeyRotation(s.oldGroup, newID); err != nil {
+			s.l.Infow("", "setup", "invalid_rotation", "id", addr, "err", err)
+			return err
+		}
+	}
+
 	s.l.Debugw("", "setup", "received_new_key", "id", newID.String())
 
 	s.pushKeyCh <- pushKey{
@@ -253,8 +271,12 @@ func (s *setupManager) createAndSend(keys []*key.Identity) {
 	totalDKG := s.dkgTimeout*3 + s.beaconOffset
 	if !s.isResharing {
 		genesis := s.clock.Now().Add(totalDKG).Unix()
-		// round the genesis time to a period modulo
-		ps := int64(s.beaconPeriod.Seconds())
+		// round the genesis time to a period modulo, or to the next second
+		// for sub-second periods
+		ps := int64(s.beaconPeriod / time.Second)
+		if ps == 0 {
+			ps = 1
+		}
 		genesis += (ps - genesis%ps)
 		group = key.NewGroup(keys, s.thr, genesis, s.beaconPeriod, s.catchupPeriod, s.scheme, s.beaconID)
 	} else {
@@ -0,0 +1,136 @@
+package core
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+
+	"github.com/drand/drand/key"
+)
+
+// haltVote is the vote of a node of the group to end the chain at a round.
+type haltVote struct {
+	Round     uint64 `json:"round"`
+	Index     uint32 `json:"index"`
+	Signature []byte `json:"signature"`
+}
+
+// haltMessage returns the message a node signs to vote to end the chain with
+// the given hash at the given round.
+func haltMessage(chainHash []byte, round uint64) []byte {
+	h := sha256.New()
+	_, _ = h.Write([]byte("drand-halt"))
+	_, _ = h.Write(chainHash)
+	_ = binary.Write(h, binary.BigEndian, round)
+	return h.Sum(nil)
+}
+
+// haltVotes records the votes of the nodes of the group to end the chain. The
+// chain ends at the round a threshold of nodes voted for; since the threshold
+// is more than half of the group, there is at most one such round. Only the
+// last vote of each node counts, and it can't change once the end is agreed.
+type haltVotes struct {
+	file      string
+	group     *key.Group
+	chainHash []byte
+	votes     map[uint32]*haltVote
+	end       uint64
+}
+
+// loadHaltVotes loads the votes recorded in the folder. The votes of nodes
+// which are no longer in the group are dropped.
+func loadHaltVotes(folder string, group *key.Group, chainHash []byte) (*haltVotes, error) {
+	h := &haltVotes{
+		file:      path.Join(folder, haltFileName),
+		group:     group,
+		chainHash: chainHash,
+		votes:     make(map[uint32]*haltVote),
+	}
+	buff, err := os.ReadFile(h.file)
+	if os.IsNotExist(err) {
+		return h, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var votes []*haltVote
+	if err := json.Unmarshal(buff, &votes); err != nil {
+		return nil, fmt.Errorf("invalid halt votes: %w", err)
+	}
+	for _, v := range votes {
+		if h.verify(v) == nil {
+			h.record(v)
+		}
+	}
+	return h, nil
+}
+
+// Add verifies and records the vote. It returns the round at which the chain
+// ends, zero while not enough nodes agree.
+func (h *haltVotes) Add(v *haltVote) (uint64, error) {
+	if h.end != 0 && v.Round != h.end {
+		return 0, fmt.Errorf("the chain already ends at round %d", h.end)
+	}
+	if err := h.verify(v); err != nil {
+		return 0, err
+	}
+	h.record(v)
+	return h.end, h.save()
+}
+
+// End returns the round at which the chain ends, zero while not enough nodes
+// agree.
+func (h *haltVotes) End() uint64 {
+	return h.end
+}
+
+// Votes returns the indexes of the nodes voting to end the chain at the round.
+func (h *haltVotes) Votes(round uint64) []uint32 {
+	var indexes []uint32
+	for _, v := range h.votes {
+		if v.Round == round {
+			indexes = append(indexes, v.Index)
+		}
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+	return indexes
+}
+
+func (h *haltVotes) verify(v *haltVote) error {
+	node := h.group.Node(v.Index)
+	if node == nil {
+		return fmt.Errorf("no node with index %d in the group", v.Index)
+	}
+	if err := key.AuthScheme.Verify(node.Key, haltMessage(h.chainHash, v.Round), v.Signature); err != nil {
+		return fmt.Errorf("invalid halt vote from node %d: %w", v.Index, err)
+	}
+	return nil
+}
+
+func (h *haltVotes) record(v *haltVote) {
+	h.votes[v.Index] = v
+	if h.end == 0 && len(h.Votes(v.Round)) >= h.group.Threshold {
+		h.end = v.Round
+	}
+}
+
+// save writes the votes atomically.
+func (h *haltVotes) save() error {
+	votes := make([]*haltVote, 0, len(h.votes))
+	for _, v := range h.votes {
+		votes = append(votes, v)
+	}
+	sort.Slice(votes, func(i, j int) bool { return votes[i].Index < votes[j].Index })
+	buff, err := json.Marshal(votes)
+	if err != nil {
+		return err
+	}
+	tmp := h.file + ".tmp"
+	if err := os.WriteFile(tmp, buff, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, h.file)
+}
@@ -0,0 +1,74 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/common/scheme"
+	"github.com/drand/drand/key"
+	"github.com/drand/drand/test"
+)
+
+func TestHaltVotes(t *testing.T) {
+	folder := t.TempDir()
+	privs, group := test.BatchIdentities(5, scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv())
+	require.Equal(t, 3, group.Threshold)
+	chainHash := chain.NewChainInfo(group).Hash()
+	vote := func(pair *key.Pair, round uint64) *haltVote {
+		sig, err := key.AuthScheme.Sign(pair.Key, haltMessage(chainHash, round))
+		require.NoError(t, err)
+		return &haltVote{Round: round, Index: group.Find(pair.Public).Index, Signature: sig}
+	}
+
+	votes, err := loadHaltVotes(folder, group, chainHash)
+	require.NoError(t, err)
+	require.Zero(t, votes.End())
+
+	end, err := votes.Add(vote(privs[0], 10))
+	require.NoError(t, err)
+	require.Zero(t, end)
+	// a node changes its vote
+	_, err = votes.Add(vote(privs[1], 12))
+	require.NoError(t, err)
+	_, err = votes.Add(vote(privs[1], 10))
+	require.NoError(t, err)
+	require.Len(t, votes.Votes(10), 2)
+	require.Empty(t, votes.Votes(12))
+
+	// a vote signed for another round, or by another node, is refused
+	bad := vote(privs[2], 11)
+	bad.Round = 10
+	_, err = votes.Add(bad)
+	require.Error(t, err)
+	bad = vote(privs[3], 10)
+	bad.Index = group.Find(privs[2].Public).Index
+	_, err = votes.Add(bad)
+	require.Error(t, err)
+	require.Len(t, votes.Votes(10), 2)
+
+	end, err = votes.Add(vote(privs[2], 10))
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), end)
+
+	// the end can't change once agreed
+	_, err = votes.Add(vote(privs[0], 20))
+	require.Error(t, err)
+	end, err = votes.Add(vote(privs[3], 10))
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), end)
+
+	// the votes are persisted
+	loaded, err := loadHaltVotes(folder, group, chainHash)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), loaded.End())
+	require.Equal(t, votes.Votes(10), loaded.Votes(10))
+
+	// the votes of nodes no longer in the group are dropped
+	_, newGroup := test.BatchIdentities(5, scheme.GetSchemeFromEnv(), test.GetBeaconIDFromEnv())
+	loaded, err = loadHaltVotes(folder, newGroup, chainHash)
+	require.NoError(t, err)
+	require.Zero(t, loaded.End())
+	require.Empty(t, loaded.Votes(10))
+}
@@ -548,7 +548,7 @@ func (d *DrandTestScenario) WaitUntilChainIsServing(t *testing.T, node *MockNode
 	}
 }
 
-func (d *DrandTestScenario) runNodeReshare(n *MockNode, errCh chan error, force bool) {
+func (d *DrandTestScenario) runNodeReshare(n *MockNode, errCh chan error, force bool, evict []string) {
 	secret := "thisistheresharing"
 
 	leader := d.nodes[0]
@@ -558,7 +558,7 @@ func (d *DrandTestScenario) runNodeReshare(n *MockNode, errCh chan error, force
 	require.NoError(d.t, err)
 
 	d.t.Logf("[reshare:node] init reshare")
-	_, err = client.InitReshare(leader.drand.priv.Public, secret, d.groupPath, force, d.beaconID)
+	_, err = client.InitReshare(leader.drand.priv.Public, secret, d.groupPath, force, d.beaconID, evict...)
 	if err != nil {
 		d.t.Log("[reshare:node] error in NON LEADER: ", err)
 		errCh <- err
@@ -568,7 +568,8 @@ func (d *DrandTestScenario) runNodeReshare(n *MockNode, errCh chan error, force
 	d.t.Logf("[reshare]  non-leader drand %s DONE - %s", n.drand.priv.Public.Address(), n.drand.priv.Public.Key)
 }
 
-func (d *DrandTestScenario) runLeaderReshare(timeout time.Duration, errCh chan error, groupReceivedCh chan *key.Group) {
+func (d *DrandTestScenario) runLeaderReshare(timeout time.Duration, evict []string, errCh chan error,
+	groupReceivedCh chan *key.Group) {
 	secret := "thisistheresharing"
 	leader := d.nodes[0]
 
@@ -586,7 +587,7 @@ func (d *DrandTestScenario) runLeaderReshare(timeout time.Duration, errCh chan e
 	d.Lock()
 	nn, nt := d.newN, d.newThr
 	d.Unlock()
-	finalGroup, err := client.InitReshareLeader(nn, nt, timeout, 0, secret, "", testBeaconOffset, d.beaconID)
+	finalGroup, err := client.InitReshareLeader(nn, nt, timeout, 0, secret, "", testBeaconOffset, d.beaconID, evict...)
 	if err != nil {
 		d.t.Log("[reshare:leader] error: ", err)
 		errCh <- err
@@ -612,6 +613,7 @@ type reshareConfig struct {
 	stateCh    chan int      // pass info on the state of the resharing
 	expDeals   int           // how many deals a receiving node should receive
 	expResps   int           // how many resps a receiving node should receive
+	evict      []string      // addresses of the nodes of the old group to evict
 }
 
 func (r *reshareConfig) RelyOnTimeout() bool {
@@ -685,7 +687,7 @@ func (d *DrandTestScenario) RunReshare(t *testing.T, c *reshareConfig) (*key.Gro
 	leader.drand.dkgBoardSetup = broadcastSetup
 	// first run the leader, then the other nodes will send their PK to the
 	// leader and then the leader will answer back with the new group
-	go d.runLeaderReshare(c.timeout, errCh, leaderGroupReadyCh)
+	go d.runLeaderReshare(c.timeout, c.evict, errCh, leaderGroupReadyCh)
 	d.resharedNodes = append(d.resharedNodes, leader)
 
 	// wait until leader is listening
@@ -707,7 +709,7 @@ func (d *DrandTestScenario) RunReshare(t *testing.T, c *reshareConfig) (*key.Gro
 		if !c.onlyLeader {
 			node.drand.dkgBoardSetup = broadcastSetup
 			d.t.Logf("[reshare] run node reshare %s", node.addr)
-			go d.runNodeReshare(node, errCh, c.force)
+			go d.runNodeReshare(node, errCh, c.force, c.evict)
 		}
 	}
 
@@ -718,7 +720,7 @@ func (d *DrandTestScenario) RunReshare(t *testing.T, c *reshareConfig) (*key.Gro
 			if !c.onlyLeader {
 				node.drand.dkgBoardSetup = broadcastSetup
 				d.t.Logf("[reshare] run node reshare %s (new)", node.addr)
-				go d.runNodeReshare(node, errCh, c.force)
+				go d.runNodeReshare(node, errCh, c.force, c.evict)
 			}
 		}
 	}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math"
 	"net/http"
@@ -30,6 +31,10 @@ const (
 	roundNumSize        = 64
 	chainHashParamKey   = "chainHash"
 	roundParamKey       = "round"
+	toleranceQueryKey   = "tolerance"
+	// defaultHealthTolerance is how many rounds a beacon handler may lag
+	// behind the expected round while still being reported as healthy.
+	defaultHealthTolerance = 1
 )
 
 var (
@@ -66,8 +71,15 @@ type BeaconHandler struct {
 	startOnce   sync.Once
 	pending     []chan []byte
 	context     context.Context
+	cancel      context.CancelFunc
 	latestRound uint64
-	version     string
+	// latestTime is the time at which latestRound was received
+	latestTime time.Time
+	version    string
+
+	// inFlight tracks the requests being served, so that the handler is only
+	// stopped once they are all done after it has been removed.
+	inFlight sync.WaitGroup
 }
 
 // New creates an HTTP handler for the public Drand API
@@ -96,6 +108,8 @@ func New(ctx context.Context, version string, logger log.Logger) (*DrandHandler,
 	mux.HandleFunc("/info", withCommonHeaders(version, handler.ChainInfo))
 	mux.HandleFunc("/health", withCommonHeaders(version, handler.Health))
 	mux.HandleFunc("/chains", withCommonHeaders(version, handler.ChainHashes))
+	mux.HandleFunc("/livez", withCommonHeaders(version, handler.Livez))
+	mux.HandleFunc("/readyz", withCommonHeaders(version, handler.Readyz))
 
 	handler.httpHandler = promhttp.InstrumentHandlerCounter(
 		metrics.HTTPCallCounter,
@@ -113,8 +127,10 @@ func (h *DrandHandler) RegisterNewBeaconHandler(c client.Client, chainHash strin
 	h.state.Lock()
 	defer h.state.Unlock()
 
+	ctx, cancel := context.WithCancel(h.context)
 	bh := &BeaconHandler{
-		context:     h.context,
+		context:     ctx,
+		cancel:      cancel,
 		client:      c,
 		latestRound: 0,
 		pending:     nil,
@@ -137,11 +153,37 @@ func (h *DrandHandler) SetHTTPHandler(newHandler http.Handler) {
 	h.httpHandler = newHandler
 }
 
-func (h *DrandHandler) RemoveBeaconHandler(chainHash string) {
+// RemoveBeaconHandler stops serving the chain with the given hash. Requests
+// already being served by its handler are not interrupted: the returned channel
+// is closed once they are all done and the handler has stopped watching for new
+// beacons. The handler's client is not closed, as it is owned by the caller.
+func (h *DrandHandler) RemoveBeaconHandler(chainHash string) <-chan struct{} {
 	h.state.Lock()
 	defer h.state.Unlock()
 
+	done := make(chan struct{})
+	bh, exists := h.beacons[chainHash]
 	delete(h.beacons, chainHash)
+	if !exists {
+		close(done)
+		return done
+	}
+
+	// the same handler can be registered as the default one as well
+	for _, other := range h.beacons {
+		if other == bh {
+			close(done)
+			return done
+		}
+	}
+
+	go func() {
+		bh.inFlight.Wait()
+		bh.cancel()
+		close(done)
+	}()
+
+	return done
 }
 
 func (h *DrandHandler) RegisterDefaultBeaconHandler(bh *BeaconHandler) {
@@ -180,6 +222,13 @@ func (h *DrandHandler) Watch(bh *BeaconHandler, ready chan bool) {
 		default:
 		}
 		h.watchWithTimeout(bh, ready)
+
+		// there is nothing left to watch once a retired chain reached its end
+		info, err := h.getChainInfo(bh.context, bh)
+		if err == nil && info.Retired() && chain.CurrentRoundAt(time.Now(), info.Period, info.GenesisTime) > info.EndRound {
+			h.log.Infow("", "http_server", "chain ended", "end_round", info.EndRound)
+			return
+		}
 	}
 }
 
@@ -215,6 +264,10 @@ func (h *DrandHandler) watchWithTimeout(bh *BeaconHandler, ready chan bool) {
 			bh.pendingLk.Lock()
 			bh.latestRound = 0
 			bh.pendingLk.Unlock()
+			// the chain info is fetched again, to learn if the chain ended
+			bh.chainInfoLk.Lock()
+			bh.chainInfo = nil
+			bh.chainInfoLk.Unlock()
 			// backoff on failures a bit to not fall into a tight loop.
 			// TODO: tuning.
 			time.Sleep(watchConnectBackoff)
@@ -230,6 +283,7 @@ func (h *DrandHandler) watchWithTimeout(bh *BeaconHandler, ready chan bool) {
 			b = []byte{}
 		}
 		bh.latestRound = next.Round()
+		bh.latestTime = time.Now()
 		pending := bh.pending
 		bh.pending = make([]chan []byte, 0)
 
@@ -240,12 +294,7 @@ func (h *DrandHandler) watchWithTimeout(bh *BeaconHandler, ready chan bool) {
 	}
 }
 
-func (h *DrandHandler) getChainInfo(ctx context.Context, chainHash []byte) (*chain.Info, error) {
-	bh, err := h.getBeaconHandler(chainHash)
-	if err != nil {
-		return nil, err
-	}
-
+func (h *DrandHandler) getChainInfo(ctx context.Context, bh *BeaconHandler) (*chain.Info, error) {
 	bh.chainInfoLk.RLock()
 	if bh.chainInfo != nil {
 		info := bh.chainInfo
@@ -276,12 +325,7 @@ func (h *DrandHandler) getChainInfo(ctx context.Context, chainHash []byte) (*cha
 	return info, nil
 }
 
-func (h *DrandHandler) getRand(ctx context.Context, chainHash []byte, info *chain.Info, round uint64) ([]byte, error) {
-	bh, err := h.getBeaconHandler(chainHash)
-	if err != nil {
-		return nil, err
-	}
-
+func (h *DrandHandler) getRand(ctx context.Context, bh *BeaconHandler, info *chain.Info, round uint64) ([]byte, error) {
 	bh.startOnce.Do(func() {
 		h.start(bh)
 	})
@@ -326,7 +370,7 @@ func (h *DrandHandler) getRand(ctx context.Context, chainHash []byte, info *chai
 	}
 
 	// make sure we aren't going to ask for a round that doesn't exist yet.
-	if time.Unix(chain.TimeOfRound(info.Period, info.GenesisTime, round), 0).After(time.Now()) {
+	if chain.RoundTime(info.Period, info.GenesisTime, round).After(time.Now()) {
 		return nil, nil
 	}
 
@@ -361,14 +405,27 @@ func (h *DrandHandler) PublicRand(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	info, err := h.getChainInfo(r.Context(), chainHashHex)
+	bh, release, err := h.acquireBeaconHandler(chainHashHex)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	defer release()
+
+	info, err := h.getChainInfo(r.Context(), bh)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		h.log.Warnw("", "http_server", "failed to get randomness", "client", r.RemoteAddr, "req", url.PathEscape(r.URL.Path), "err", err)
 		return
 	}
 
-	roundExpectedTime := time.Unix(chain.TimeOfRound(info.Period, info.GenesisTime, roundN), 0)
+	if err := info.CheckRound(roundN); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		h.log.Warnw("", "http_server", "request after the end of the chain", "client", r.RemoteAddr, "req", url.PathEscape(r.URL.Path))
+		return
+	}
+
+	roundExpectedTime := chain.RoundTime(info.Period, info.GenesisTime, roundN)
 
 	if roundExpectedTime.After(time.Now().Add(info.Period)) {
 		timeToExpected := int(time.Until(roundExpectedTime).Seconds())
@@ -378,7 +435,12 @@ func (h *DrandHandler) PublicRand(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	data, err := h.getRand(r.Context(), chainHashHex, info, roundN)
+	data, err := h.getRand(r.Context(), bh, info, roundN)
+	if errors.Is(err, chain.ErrChainEnded) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		h.log.Warnw("", "http_server", "request after the end of the chain", "client", r.RemoteAddr, "req", url.PathEscape(r.URL.Path))
+		return
+	}
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		h.log.Warnw("", "http_server", "failed to get randomness", "client", r.RemoteAddr, "req", url.PathEscape(r.URL.Path), "err", err)
@@ -405,11 +467,12 @@ func (h *DrandHandler) LatestRand(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	bh, err := h.getBeaconHandler(chainHashHex)
+	bh, release, err := h.acquireBeaconHandler(chainHashHex)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusNotFound)
 		return
 	}
+	defer release()
 
 	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
 	defer cancel()
@@ -429,12 +492,12 @@ func (h *DrandHandler) LatestRand(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	info, err := h.getChainInfo(r.Context(), chainHashHex)
+	info, err := h.getChainInfo(r.Context(), bh)
 	roundTime := time.Now()
 	nextTime := time.Now()
 	if err == nil {
-		roundTime = time.Unix(chain.TimeOfRound(info.Period, info.GenesisTime, resp.Round()), 0)
-		next := time.Unix(chain.TimeOfRound(info.Period, info.GenesisTime, resp.Round()+1), 0)
+		roundTime = chain.RoundTime(info.Period, info.GenesisTime, resp.Round())
+		next := chain.RoundTime(info.Period, info.GenesisTime, resp.Round()+1)
 		if next.After(nextTime) {
 			nextTime = next
 		} else {
@@ -445,6 +508,11 @@ func (h *DrandHandler) LatestRand(w http.ResponseWriter, r *http.Request) {
 	remaining := time.Until(nextTime)
 	if remaining > 0 && remaining < info.Period {
 		seconds := int(math.Ceil(remaining.Seconds()))
+		if info.Period < time.Second {
+			// max-age has a resolution of a second, which goes beyond the
+			// next round of a sub-second period
+			seconds = 0
+		}
 		w.Header().Set("Cache-Control", fmt.Sprintf("max-age:%d, public", seconds))
 	} else {
 		h.log.Warnw("", "http_server", "latest rand in the past",
@@ -464,7 +532,14 @@ func (h *DrandHandler) ChainInfo(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	info, err := h.getChainInfo(r.Context(), chainHashHex)
+	bh, release, err := h.acquireBeaconHandler(chainHashHex)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	defer release()
+
+	info, err := h.getChainInfo(r.Context(), bh)
 	if err != nil {
 		h.log.Warnw("", "http_server", "failed to serve group", "client", r.RemoteAddr, "req", url.PathEscape(r.URL.Path))
 		http.Error(w, "group not found", http.StatusNotFound)
@@ -486,6 +561,87 @@ func (h *DrandHandler) ChainInfo(w http.ResponseWriter, r *http.Request) {
 	http.ServeContent(w, r, "info.json", time.Unix(info.GenesisTime, 0), bytes.NewReader(chainBuff.Bytes()))
 }
 
+// SourceHealth is the status of one of the upstream sources of a beacon
+// handler, as reported by the health endpoint.
+type SourceHealth struct {
+	Name                 string  `json:"name"`
+	Passive              bool    `json:"passive"`
+	RTTMillis            int64   `json:"rtt_ms"`
+	LastSuccessAge       float64 `json:"last_success_age_seconds,omitempty"`
+	LastError            string  `json:"last_error,omitempty"`
+	VerificationFailures uint64  `json:"verification_failures"`
+}
+
+// HealthStatus is the body returned by the health endpoint of a beacon
+// handler.
+type HealthStatus struct {
+	// Current is the latest round seen by the handler.
+	Current uint64 `json:"current"`
+	// Expected is the round the chain should be at now.
+	Expected uint64 `json:"expected"`
+	// Tolerance is the number of rounds Current may lag behind Expected.
+	Tolerance uint64 `json:"tolerance"`
+	// LastBeaconAge is the time in seconds since the latest round was seen.
+	LastBeaconAge float64 `json:"last_beacon_age_seconds,omitempty"`
+	// VerificationFailures is the total of results from all sources that
+	// failed verification.
+	VerificationFailures uint64         `json:"verification_failures"`
+	Sources              []SourceHealth `json:"sources,omitempty"`
+}
+
+// healthy reports whether the handler is close enough to the expected round.
+func (hs *HealthStatus) healthy() bool {
+	return hs.Current <= hs.Expected && hs.Current+hs.Tolerance >= hs.Expected
+}
+
+// chainHealth returns the health status of the given beacon handler.
+func (h *DrandHandler) chainHealth(ctx context.Context, bh *BeaconHandler, tolerance uint64) (*HealthStatus, error) {
+	bh.startOnce.Do(func() {
+		h.start(bh)
+	})
+
+	bh.pendingLk.RLock()
+	lastSeen := bh.latestRound
+	lastSeenTime := bh.latestTime
+	bh.pendingLk.RUnlock()
+
+	status := &HealthStatus{
+		Current:   lastSeen,
+		Tolerance: tolerance,
+	}
+	if !lastSeenTime.IsZero() {
+		status.LastBeaconAge = time.Since(lastSeenTime).Seconds()
+	}
+
+	if hc, ok := bh.client.(client.HealthReportingClient); ok {
+		for _, src := range hc.Sources() {
+			sh := SourceHealth{
+				Name:                 src.Name,
+				Passive:              src.Passive,
+				RTTMillis:            src.RTT.Milliseconds(),
+				LastError:            src.LastError,
+				VerificationFailures: src.VerificationFailures,
+			}
+			if !src.LastSuccess.IsZero() {
+				sh.LastSuccessAge = time.Since(src.LastSuccess).Seconds()
+			}
+			status.VerificationFailures += src.VerificationFailures
+			status.Sources = append(status.Sources, sh)
+		}
+	}
+
+	info, err := h.getChainInfo(ctx, bh)
+	if err != nil {
+		return status, err
+	}
+	status.Expected = info.RoundAt(time.Now())
+
+	return status, nil
+}
+
+// Health reports whether the beacon handler of the requested chain is up to
+// date, within a number of rounds given by the optional "tolerance" query
+// parameter, along with the status of its upstream sources.
 func (h *DrandHandler) Health(w http.ResponseWriter, r *http.Request) {
 	chainHashHex, err := readChainHash(r)
 	if err != nil {
@@ -493,52 +649,105 @@ func (h *DrandHandler) Health(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	bh, err := h.getBeaconHandler(chainHashHex)
+	tolerance, err := readTolerance(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	bh, release, err := h.acquireBeaconHandler(chainHashHex)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusNotFound)
 		return
 	}
+	defer release()
 
-	bh.startOnce.Do(func() {
-		h.start(bh)
-	})
+	status, err := h.chainHealth(r.Context(), bh, tolerance)
 
-	bh.pendingLk.RLock()
-	lastSeen := bh.latestRound
-	bh.pendingLk.RUnlock()
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-cache")
 
-	info, err := h.getChainInfo(r.Context(), chainHashHex)
+	if err != nil || !status.healthy() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	b, _ := json.Marshal(status)
+	_, _ = w.Write(b)
+}
 
+// Livez reports whether the HTTP server is alive. It does not depend on the
+// state of any beacon handler, and is meant to be used as a liveness probe.
+func (h *DrandHandler) Livez(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("Cache-Control", "no-cache")
-	resp := make(map[string]uint64)
-	resp["current"] = lastSeen
-	resp["expected"] = 0
-	var b []byte
 
-	if err != nil {
+	if h.context.Err() != nil {
 		w.WriteHeader(http.StatusServiceUnavailable)
-	} else {
-		expected := chain.CurrentRound(time.Now().Unix(), info.Period, info.GenesisTime)
-		resp["expected"] = expected
-		if lastSeen == expected || lastSeen+1 == expected {
-			w.WriteHeader(http.StatusOK)
-		} else {
-			w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`{"status":"stopping"}`))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"status":"ok"}`))
+}
+
+// Readyz reports whether all the registered beacon handlers are healthy, and
+// is meant to be used as a readiness probe. The optional "tolerance" query
+// parameter applies to every chain.
+func (h *DrandHandler) Readyz(w http.ResponseWriter, r *http.Request) {
+	tolerance, err := readTolerance(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	h.state.RLock()
+	beacons := make(map[string]*BeaconHandler, len(h.beacons))
+	for chainHash, bh := range h.beacons {
+		beacons[chainHash] = bh
+		bh.inFlight.Add(1)
+	}
+	h.state.RUnlock()
+
+	ready := len(beacons) > 0
+	resp := make(map[string]*HealthStatus, len(beacons))
+	for chainHash, bh := range beacons {
+		status, err := h.chainHealth(r.Context(), bh, tolerance)
+		bh.inFlight.Done()
+		if err != nil {
+			h.log.Warnw("", "http_server", "failed to get chain health", "chainHash", chainHash, "err", err)
+		}
+		if err != nil || !status.healthy() {
+			ready = false
+		}
+		if status != nil {
+			resp[chainHash] = status
 		}
 	}
 
-	b, _ = json.Marshal(resp)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-cache")
+	if ready {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	b, _ := json.Marshal(resp)
 	_, _ = w.Write(b)
 }
 
 func (h *DrandHandler) ChainHashes(w http.ResponseWriter, r *http.Request) {
-	chainHashes := make([]string, 0)
+	h.state.RLock()
+	chainHashes := make([]string, 0, len(h.beacons))
 	for chainHash := range h.beacons {
 		if chainHash != common.DefaultChainHash {
 			chainHashes = append(chainHashes, chainHash)
 		}
 	}
+	h.state.RUnlock()
 
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("Cache-Control", "max-age=300")
@@ -563,20 +772,31 @@ func readChainHash(r *http.Request) ([]byte, error) {
 	return chainHashHex, nil
 }
 
+func readTolerance(r *http.Request) (uint64, error) {
+	tolerance := r.URL.Query().Get(toleranceQueryKey)
+	if tolerance == "" {
+		return defaultHealthTolerance, nil
+	}
+	t, err := strconv.ParseUint(tolerance, roundNumBase, roundNumSize)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse tolerance %s: %w", tolerance, err)
+	}
+	return t, nil
+}
+
 func readRound(r *http.Request) (uint64, error) {
 	round := chi.URLParam(r, roundParamKey)
 	return strconv.ParseUint(round, roundNumBase, roundNumSize)
 }
 
-func (h *DrandHandler) getBeaconHandler(chainHash []byte) (*BeaconHandler, error) {
+// lookupBeaconHandler returns the beacon handler for the given chain hash. The
+// caller must hold the state lock.
+func (h *DrandHandler) lookupBeaconHandler(chainHash []byte) (*BeaconHandler, error) {
 	chainHashStr := fmt.Sprintf("%x", chainHash)
 	if chainHashStr == "" {
 		chainHashStr = common.DefaultChainHash
 	}
 
-	h.state.RLock()
-	defer h.state.RUnlock()
-
 	bh, exists := h.beacons[chainHashStr]
 
 	if !exists {
@@ -586,3 +806,19 @@ func (h *DrandHandler) getBeaconHandler(chainHash []byte) (*BeaconHandler, error
 
 	return bh, nil
 }
+
+// acquireBeaconHandler returns the beacon handler for the given chain hash,
+// which is kept running until the returned release function is called, even if
+// it is removed in the meantime.
+func (h *DrandHandler) acquireBeaconHandler(chainHash []byte) (bh *BeaconHandler, release func(), err error) {
+	h.state.RLock()
+	defer h.state.RUnlock()
+
+	bh, err = h.lookupBeaconHandler(chainHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	bh.inFlight.Add(1)
+
+	return bh, bh.inFlight.Done, nil
+}
@@ -3,14 +3,17 @@ package http
 import (
 	"context"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
 	json "github.com/nikkolasg/hexjson"
 	"github.com/stretchr/testify/require"
 
+	"github.com/drand/drand/chain"
 	"github.com/drand/drand/client"
 	"github.com/drand/drand/client/grpc"
 	nhttp "github.com/drand/drand/client/http"
@@ -256,6 +259,65 @@ func TestHTTPWatchFuture(t *testing.T) {
 	}
 }
 
+// retiredClient reports the chain ends at a round
+type retiredClient struct {
+	client.Client
+	end uint64
+}
+
+func (c *retiredClient) Info(ctx context.Context) (*chain.Info, error) {
+	info, err := c.Client.Info(ctx)
+	if err != nil {
+		return nil, err
+	}
+	retired := *info
+	retired.EndRound = c.end
+	return &retired, nil
+}
+
+func TestHTTPRetiredChain(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c, _ := withClient(t)
+	c = &retiredClient{Client: c, end: 1}
+
+	handler, err := New(ctx, "", nil)
+	require.NoError(t, err)
+
+	info, err := c.Info(ctx)
+	require.NoError(t, err)
+
+	handler.RegisterNewBeaconHandler(c, info.HashString())
+
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	server := http.Server{Handler: handler.GetHTTPHandler()}
+	go func() { _ = server.Serve(listener) }()
+	defer func() { _ = server.Shutdown(ctx) }()
+	require.NoError(t, nhttp.IsServerReady(listener.Addr().String()))
+
+	u := fmt.Sprintf("http://%s/%s/info", listener.Addr().String(), info.HashString())
+	resp := getWithCtx(ctx, u, t)
+	cip := new(drand.ChainInfoPacket)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(cip))
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, uint64(1), cip.GetEndRound())
+
+	u = fmt.Sprintf("http://%s/%s/public/2", listener.Addr().String(), info.HashString())
+	resp = getWithCtx(ctx, u, t)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	require.Contains(t, string(body), "chain ended at round 1: no round 2")
+
+	// the http client reports the end of the chain
+	hc, err := nhttp.NewWithInfo("http://"+listener.Addr().String(), info, http.DefaultTransport)
+	require.NoError(t, err)
+	_, err = hc.Get(ctx, 2)
+	require.ErrorIs(t, err, chain.ErrChainEnded)
+}
+
 func TestHTTPHealth(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -309,3 +371,125 @@ func TestHTTPHealth(t *testing.T) {
 	}
 	resp.Body.Close()
 }
+
+func TestHTTPLivezReadyz(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c, push := withClient(t)
+
+	handler, err := New(ctx, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := c.Info(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handler.RegisterNewBeaconHandler(c, info.HashString())
+
+	listener, err := net.Listen("tcp", ":0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := http.Server{Handler: handler.GetHTTPHandler()}
+	go func() { _ = server.Serve(listener) }()
+	defer func() { _ = server.Shutdown(ctx) }()
+
+	err = nhttp.IsServerReady(listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp := getWithCtx(ctx, fmt.Sprintf("http://%s/livez", listener.Addr().String()), t)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	require.NoError(t, resp.Body.Close())
+
+	resp = getWithCtx(ctx, fmt.Sprintf("http://%s/readyz", listener.Addr().String()), t)
+	require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode, "newly started server not expected to be ready")
+	require.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	require.NoError(t, resp.Body.Close())
+
+	resp = getWithCtx(ctx, fmt.Sprintf("http://%s/%s/health?tolerance=nope", listener.Addr().String(), info.HashString()), t)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	require.NoError(t, resp.Body.Close())
+
+	// a large enough tolerance accepts a handler which hasn't seen any round yet
+	resp = getWithCtx(ctx, fmt.Sprintf("http://%s/%s/health?tolerance=1000000", listener.Addr().String(), info.HashString()), t)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	status := new(HealthStatus)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(status))
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, uint64(1000000), status.Tolerance)
+
+	resp = getWithCtx(ctx, fmt.Sprintf("http://%s/%s/public/0", listener.Addr().String(), info.HashString()), t)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	push(false)
+	// give some time for http server to get it
+	time.Sleep(30 * time.Millisecond)
+	require.NoError(t, resp.Body.Close())
+
+	resp = getWithCtx(ctx, fmt.Sprintf("http://%s/readyz", listener.Addr().String()), t)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	statuses := make(map[string]*HealthStatus)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&statuses))
+	require.NoError(t, resp.Body.Close())
+	require.Contains(t, statuses, info.HashString())
+	require.NotZero(t, statuses[info.HashString()].LastBeaconAge)
+}
+
+func TestHTTPLivezStopping(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	handler, err := New(ctx, "", nil)
+	require.NoError(t, err)
+	cancel()
+
+	w := httptest.NewRecorder()
+	handler.Livez(w, httptest.NewRequest(http.MethodGet, "/livez", http.NoBody))
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	require.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	require.JSONEq(t, `{"status":"stopping"}`, w.Body.String())
+}
+
+func TestHTTPRemoveBeaconHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c, _ := withClient(t)
+
+	handler, err := New(ctx, "", nil)
+	require.NoError(t, err)
+
+	info, err := c.Info(ctx)
+	require.NoError(t, err)
+
+	handler.RegisterNewBeaconHandler(c, info.HashString())
+
+	// simulate a request being served while the handler is removed
+	bh, release, err := handler.acquireBeaconHandler(info.Hash())
+	require.NoError(t, err)
+
+	done := handler.RemoveBeaconHandler(info.HashString())
+
+	_, _, err = handler.acquireBeaconHandler(info.Hash())
+	require.Error(t, err, "removed handler should not serve new requests")
+
+	select {
+	case <-done:
+		t.Fatal("handler stopped while a request was in flight")
+	case <-time.After(50 * time.Millisecond):
+	}
+	require.NoError(t, bh.context.Err())
+
+	release()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("handler not stopped once requests were done")
+	}
+	require.Error(t, bh.context.Err())
+
+	// removing an unknown chain is a no-op
+	<-handler.RemoveBeaconHandler(info.HashString())
+}
@@ -0,0 +1,266 @@
+package key
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	kyber "github.com/drand/kyber"
+	"github.com/drand/kyber/share"
+	"github.com/drand/kyber/util/random"
+)
+
+// shardPrefix starts the text encoding of the shards of a split backup
+const shardPrefix = "drand-shard"
+
+// Backup holds the private material of a beacon, so that a node can be
+// restored on another machine: the key pair, the key pairs archived after
+// rotations and, once a DKG ran, the group and the share of the node.
+type Backup struct {
+	Pair     *Pair
+	Archived []*Pair
+	Group    *Group
+	Share    *Share
+	// ChainHash is the hash of the chain info of the group, for the operators
+	// to know which chain a backup belongs to
+	ChainHash string
+}
+
+// BackupPairTOML is the TOML representation of a key pair in a backup
+type BackupPairTOML struct {
+	Key    string
+	Public *PublicTOML
+}
+
+// BackupTOML is the TOML representation of a backup
+type BackupTOML struct {
+	Pair      *BackupPairTOML
+	Archived  []*BackupPairTOML `toml:",omitempty"`
+	ChainHash string            `toml:",omitempty"`
+	Group     *GroupTOML        `toml:",omitempty"`
+	Share     *ShareTOML        `toml:",omitempty"`
+}
+
+// Validate checks the key pair is self signed and, if the backup holds a
+// share, that the node is part of the group and that the share is consistent
+// with the distributed key of the group.
+func (b *Backup) Validate() error {
+	if err := b.Pair.Public.ValidSignature(); err != nil {
+		return fmt.Errorf("backup: invalid self signature of the key: %w", err)
+	}
+	if b.Share == nil && b.Group == nil {
+		return nil
+	}
+	if b.Share == nil || b.Group == nil {
+		return errors.New("backup: a share needs its group, and a group its share")
+	}
+	node := b.Group.Find(b.Pair.Public)
+	for _, p := range b.Archived {
+		if node != nil {
+			break
+		}
+		node = b.Group.Find(p.Public)
+	}
+	if node == nil {
+		return errors.New("backup: the key pair is not part of the group")
+	}
+	return b.Group.CheckShare(b.Share, node)
+}
+
+// TOML returns a struct that can be marshaled using a TOML-encoding library
+func (b *Backup) TOML() interface{} {
+	btoml := &BackupTOML{
+		Pair:      backupPairTOML(b.Pair),
+		ChainHash: b.ChainHash,
+	}
+	for _, p := range b.Archived {
+		btoml.Archived = append(btoml.Archived, backupPairTOML(p))
+	}
+	if b.Group != nil {
+		btoml.Group = b.Group.TOML().(*GroupTOML)
+	}
+	if b.Share != nil {
+		btoml.Share = b.Share.TOML().(*ShareTOML)
+	}
+	return btoml
+}
+
+// FromTOML constructs the backup from an unmarshalled structure from TOML
+func (b *Backup) FromTOML(i interface{}) error {
+	btoml, ok := i.(*BackupTOML)
+	if !ok {
+		return errors.New("backup can't decode from non BackupTOML struct")
+	}
+	if btoml.Pair == nil {
+		return errors.New("backup: no key pair")
+	}
+	var err error
+	if b.Pair, err = backupPairFromTOML(btoml.Pair); err != nil {
+		return err
+	}
+	b.Archived = make([]*Pair, len(btoml.Archived))
+	for i, p := range btoml.Archived {
+		if b.Archived[i], err = backupPairFromTOML(p); err != nil {
+			return fmt.Errorf("archived key %d: %w", i, err)
+		}
+	}
+	b.ChainHash = btoml.ChainHash
+	if btoml.Group != nil {
+		b.Group = new(Group)
+		if err := b.Group.FromTOML(btoml.Group); err != nil {
+			return err
+		}
+	}
+	if btoml.Share != nil {
+		b.Share = new(Share)
+		if err := b.Share.FromTOML(btoml.Share); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TOMLValue returns an empty TOML-compatible interface value
+func (b *Backup) TOMLValue() interface{} {
+	return &BackupTOML{}
+}
+
+func backupPairTOML(p *Pair) *BackupPairTOML {
+	return &BackupPairTOML{
+		Key:    ScalarToString(p.Key),
+		Public: p.Public.TOML().(*PublicTOML),
+	}
+}
+
+func backupPairFromTOML(ptoml *BackupPairTOML) (*Pair, error) {
+	if ptoml.Public == nil {
+		return nil, errors.New("backup: key pair without public key")
+	}
+	p := &Pair{Public: new(Identity)}
+	var err error
+	if p.Key, err = StringToScalar(KeyGroup, ptoml.Key); err != nil {
+		return nil, fmt.Errorf("backup: decoding private key: %w", err)
+	}
+	if err := p.Public.FromTOML(ptoml.Public); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// SaveBackup saves the backup at the given path, encrypted with a key derived
+// from the passphrase.
+func SaveBackup(filePath string, b *Backup, passphrase []byte) error {
+	if len(passphrase) == 0 {
+		return errors.New("backup: empty passphrase")
+	}
+	return SaveEncrypted(filePath, b, passphrase)
+}
+
+// LoadBackup loads and validates the backup saved at the given path with
+// SaveBackup.
+func LoadBackup(filePath string, passphrase []byte) (*Backup, error) {
+	if len(passphrase) == 0 {
+		return nil, errors.New("backup: empty passphrase")
+	}
+	b := new(Backup)
+	if err := LoadEncrypted(filePath, b, passphrase); err != nil {
+		return nil, err
+	}
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// SaveSplitBackup saves the backup at the given path, encrypted with a random
+// key split into n shards, any threshold of which restore the backup. The
+// shards are short enough to be written down on paper, and are useless without
+// the backup file.
+func SaveSplitBackup(filePath string, b *Backup, threshold, n int) ([]string, error) {
+	if threshold < 1 || threshold > n {
+		return nil, fmt.Errorf("backup: invalid threshold %d of %d shards", threshold, n)
+	}
+	secret := KeyGroup.Scalar().Pick(random.New())
+	if err := SaveEncrypted(filePath, b, shardsPassphrase(secret)); err != nil {
+		return nil, err
+	}
+	id := shardsID(secret)
+	shares := share.NewPriPoly(KeyGroup, threshold, secret, random.New()).Shares(n)
+	shards := make([]string, n)
+	for i, s := range shares {
+		shards[i] = fmt.Sprintf("%s:%s:%d:%d:%s", shardPrefix, id, threshold, s.I+1, ScalarToString(s.V))
+	}
+	return shards, nil
+}
+
+// LoadSplitBackup loads and validates the backup saved at the given path with
+// SaveSplitBackup, from a threshold of its shards.
+func LoadSplitBackup(filePath string, shards []string) (*Backup, error) {
+	secret, err := recoverShards(shards)
+	if err != nil {
+		return nil, err
+	}
+	b := new(Backup)
+	if err := LoadEncrypted(filePath, b, shardsPassphrase(secret)); err != nil {
+		return nil, err
+	}
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func recoverShards(shards []string) (kyber.Scalar, error) {
+	var id string
+	var threshold int
+	shares := make([]*share.PriShare, 0, len(shards))
+	for _, shard := range shards {
+		parts := strings.Split(strings.TrimSpace(shard), ":")
+		if len(parts) != 5 || parts[0] != shardPrefix {
+			return nil, fmt.Errorf("backup: invalid shard %q", shard)
+		}
+		t, err := strconv.Atoi(parts[2])
+		if err != nil {
+			return nil, fmt.Errorf("backup: invalid threshold of shard %q: %w", shard, err)
+		}
+		if id == "" {
+			id, threshold = parts[1], t
+		} else if parts[1] != id || t != threshold {
+			return nil, errors.New("backup: the shards belong to different backups")
+		}
+		i, err := strconv.Atoi(parts[3])
+		if err != nil || i < 1 {
+			return nil, fmt.Errorf("backup: invalid index of shard %q", shard)
+		}
+		v, err := StringToScalar(KeyGroup, parts[4])
+		if err != nil {
+			return nil, fmt.Errorf("backup: invalid value of shard %q: %w", shard, err)
+		}
+		shares = append(shares, &share.PriShare{I: i - 1, V: v})
+	}
+	if len(shares) == 0 {
+		return nil, errors.New("backup: no shards")
+	}
+	secret, err := share.RecoverSecret(KeyGroup, shares, threshold, len(shares))
+	if err != nil {
+		return nil, fmt.Errorf("backup: %d shards given, %d needed: %w", len(shares), threshold, err)
+	}
+	if shardsID(secret) != id {
+		return nil, errors.New("backup: the shards don't recover the key of the backup")
+	}
+	return secret, nil
+}
+
+// shardsPassphrase returns the passphrase encrypting a split backup
+func shardsPassphrase(secret kyber.Scalar) []byte {
+	return []byte(ScalarToString(secret))
+}
+
+// shardsID identifies the shards of a backup, with the first bytes of the
+// hash of the public commitment to the secret they share.
+func shardsID(secret kyber.Scalar) string {
+	h := hashFunc()
+	_, _ = KeyGroup.Point().Mul(secret, nil).MarshalTo(h)
+	return fmt.Sprintf("%x", h.Sum(nil)[:4])
+}
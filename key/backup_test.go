@@ -0,0 +1,97 @@
+package key
+
+import (
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/kyber/share"
+	"github.com/drand/kyber/util/random"
+)
+
+// newTestBackup returns the backup of the second node of a group whose shares
+// are evaluations of a real distributed polynomial.
+func newTestBackup() *Backup {
+	pairs, group := BatchIdentities(4)
+	group.Threshold = 3
+	poly := share.NewPriPoly(KeyGroup, group.Threshold, nil, random.New())
+	_, commits := poly.Commit(KeyGroup.Point().Base()).Info()
+	group.PublicKey = &DistPublic{Coefficients: commits}
+	return &Backup{
+		Pair:      pairs[1],
+		Archived:  []*Pair{NewTLSKeyPair(testAddr)},
+		Group:     group,
+		Share:     &Share{Commits: commits, Share: poly.Shares(4)[1]},
+		ChainHash: "chain hash",
+	}
+}
+
+func TestBackupValidate(t *testing.T) {
+	b := newTestBackup()
+	require.NoError(t, b.Validate())
+	require.NoError(t, (&Backup{Pair: b.Pair}).Validate())
+	require.Error(t, (&Backup{Pair: b.Pair, Group: b.Group}).Validate())
+
+	// a share of another node
+	other := newTestBackup()
+	other.Share.Share = &share.PriShare{I: 2, V: b.Share.Share.V}
+	require.ErrorContains(t, other.Validate(), "doesn't match the commitment")
+	other.Share = newTestBackup().Share
+	require.ErrorContains(t, other.Validate(), "another distributed key")
+
+	wrongNode := newTestBackup()
+	wrongNode.Pair = wrongNode.Archived[0]
+	require.ErrorContains(t, wrongNode.Validate(), "not part of the group")
+	// the node is found with its archived key after a rotation
+	wrongNode.Archived = append(wrongNode.Archived, b.Pair)
+	wrongNode.Group, wrongNode.Share = b.Group, b.Share
+	require.NoError(t, wrongNode.Validate())
+}
+
+func TestBackupPassphrase(t *testing.T) {
+	b := newTestBackup()
+	file := path.Join(t.TempDir(), "backup.toml")
+	require.Error(t, SaveBackup(file, b, nil))
+	require.NoError(t, SaveBackup(file, b, []byte("passphrase")))
+
+	_, err := LoadBackup(file, []byte("wrong"))
+	require.ErrorIs(t, err, ErrWrongPassphrase)
+	restored, err := LoadBackup(file, []byte("passphrase"))
+	require.NoError(t, err)
+	require.Equal(t, b.Pair.Key.String(), restored.Pair.Key.String())
+	require.True(t, b.Pair.Public.Equal(restored.Pair.Public))
+	require.Len(t, restored.Archived, 1)
+	require.True(t, b.Archived[0].Public.Equal(restored.Archived[0].Public))
+	require.True(t, b.Group.Equal(restored.Group))
+	require.Equal(t, b.Share.Share.V.String(), restored.Share.Share.V.String())
+	require.Equal(t, b.ChainHash, restored.ChainHash)
+}
+
+func TestBackupSplit(t *testing.T) {
+	b := newTestBackup()
+	file := path.Join(t.TempDir(), "backup.toml")
+	_, err := SaveSplitBackup(file, b, 4, 3)
+	require.Error(t, err)
+	shards, err := SaveSplitBackup(file, b, 3, 5)
+	require.NoError(t, err)
+	require.Len(t, shards, 5)
+
+	for _, subset := range [][]string{shards[:3], shards[2:], {shards[4], shards[0], shards[2]}, shards} {
+		restored, err := LoadSplitBackup(file, subset)
+		require.NoError(t, err)
+		require.Equal(t, b.Share.Share.V.String(), restored.Share.Share.V.String())
+	}
+
+	_, err = LoadSplitBackup(file, shards[:2])
+	require.ErrorContains(t, err, "2 shards given, 3 needed")
+	_, err = LoadSplitBackup(file, []string{shards[0], shards[0], shards[1]})
+	require.Error(t, err)
+	_, err = LoadSplitBackup(file, []string{shards[0], shards[1], "drand-shard:00:3:3:00"})
+	require.Error(t, err)
+
+	otherShards, err := SaveSplitBackup(path.Join(t.TempDir(), "other.toml"), b, 3, 5)
+	require.NoError(t, err)
+	_, err = LoadSplitBackup(file, []string{shards[0], shards[1], otherShards[2]})
+	require.ErrorContains(t, err, "different backups")
+}
@@ -0,0 +1,213 @@
+package key
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+
+	"github.com/BurntSushi/toml"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/drand/drand/fs"
+)
+
+// encryptionScheme names the key derivation function and the cipher used to
+// encrypt the private files
+const encryptionScheme = "scrypt-chacha20poly1305"
+
+// scrypt parameters of newly encrypted files, as recommended for interactive
+// logins. The parameters are stored along each file, so they can be raised
+// without breaking existing files.
+const (
+	scryptN    = 1 << 15
+	scryptR    = 8
+	scryptP    = 1
+	saltLength = 32
+)
+
+// ErrEncryptedKey is returned when loading an encrypted file without a
+// passphrase
+var ErrEncryptedKey = errors.New("key: file is encrypted, a passphrase is needed to load it")
+
+// ErrWrongPassphrase is returned when an encrypted file can't be decrypted
+// with the passphrase given
+var ErrWrongPassphrase = errors.New("key: wrong passphrase or corrupted file")
+
+// NewEncryptedFileStore returns a file store which encrypts the private key
+// and the share with a key derived from the passphrase. It still loads files
+// saved in plain text, so that existing stores can be migrated by loading and
+// saving them again.
+func NewEncryptedFileStore(baseFolder, beaconID string, passphrase []byte) Store {
+	return newFileStore(baseFolder, beaconID, passphrase)
+}
+
+// NewEncryptedFileStores is like NewFileStores with stores encrypting their
+// private files with the passphrase.
+func NewEncryptedFileStores(baseFolder string, passphrase []byte) (map[string]Store, error) {
+	return newFileStores(baseFolder, passphrase)
+}
+
+// EncryptedTOML is the TOML representation of an encrypted file
+type EncryptedTOML struct {
+	// Encryption is the scheme used to encrypt the file
+	Encryption string
+	Salt       string
+	N          int
+	R          int
+	P          int
+	Nonce      string
+	Ciphertext string
+}
+
+// SaveEncrypted saves the given Tomler interface to the given path, encrypted
+// with a key derived from the passphrase. The file has a 0700 security.
+func SaveEncrypted(filePath string, t Tomler, passphrase []byte) error {
+	var plain bytes.Buffer
+	if err := toml.NewEncoder(&plain).Encode(t.TOML()); err != nil {
+		return err
+	}
+	etoml, err := Encrypt(plain.Bytes(), passphrase)
+	if err != nil {
+		return err
+	}
+	fd, err := fs.CreateSecureFile(filePath)
+	if err != nil {
+		return fmt.Errorf("config: can't save %s to %s: %w", reflect.TypeOf(t).String(), filePath, err)
+	}
+	defer fd.Close()
+	return toml.NewEncoder(fd).Encode(etoml)
+}
+
+// LoadEncrypted loads the given Tomler from the given file path, decrypting
+// it with the passphrase. A file in plain text is loaded as with Load. If the
+// file is encrypted and the passphrase is empty, it returns ErrEncryptedKey.
+func LoadEncrypted(filePath string, t Tomler, passphrase []byte) error {
+	etoml, err := loadEncryptedTOML(filePath)
+	if err != nil {
+		return err
+	}
+	if etoml.Encryption == "" {
+		return Load(filePath, t)
+	}
+	if len(passphrase) == 0 {
+		return fmt.Errorf("%w: %s", ErrEncryptedKey, filePath)
+	}
+	plain, err := etoml.decrypt(passphrase)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrWrongPassphrase, filePath, err)
+	}
+	tomlValue := t.TOMLValue()
+	if _, err := toml.Decode(string(plain), tomlValue); err != nil {
+		return err
+	}
+	return t.FromTOML(tomlValue)
+}
+
+// Encrypt encrypts plain with a key derived from the passphrase.
+func Encrypt(plain, passphrase []byte) (*EncryptedTOML, error) {
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(passphrase, salt, scryptN, scryptR, scryptP)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return &EncryptedTOML{
+		Encryption: encryptionScheme,
+		Salt:       hex.EncodeToString(salt),
+		N:          scryptN,
+		R:          scryptR,
+		P:          scryptP,
+		Nonce:      hex.EncodeToString(nonce),
+		Ciphertext: hex.EncodeToString(aead.Seal(nil, nonce, plain, nil)),
+	}, nil
+}
+
+// Decrypt returns the plain text encrypted by Encrypt. It returns
+// ErrEncryptedKey if the passphrase is empty, and ErrWrongPassphrase if the
+// content can't be decrypted with it.
+func (e *EncryptedTOML) Decrypt(passphrase []byte) ([]byte, error) {
+	if e.Encryption != encryptionScheme {
+		return nil, fmt.Errorf("key: unknown encryption %q", e.Encryption)
+	}
+	if len(passphrase) == 0 {
+		return nil, ErrEncryptedKey
+	}
+	plain, err := e.decrypt(passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrWrongPassphrase, err)
+	}
+	return plain, nil
+}
+
+// IsEncrypted returns true if the file at the given path is encrypted.
+func IsEncrypted(filePath string) (bool, error) {
+	etoml, err := loadEncryptedTOML(filePath)
+	if err != nil {
+		return false, err
+	}
+	return etoml.Encryption != "", nil
+}
+
+// loadEncryptedTOML returns an EncryptedTOML without encryption scheme if the
+// file is in plain text.
+func loadEncryptedTOML(filePath string) (*EncryptedTOML, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	etoml := new(EncryptedTOML)
+	if _, err := toml.Decode(string(content), etoml); err != nil {
+		return nil, err
+	}
+	if etoml.Encryption != "" && etoml.Encryption != encryptionScheme {
+		return nil, fmt.Errorf("key: unknown encryption %q of %s", etoml.Encryption, filePath)
+	}
+	return etoml, nil
+}
+
+func (e *EncryptedTOML) decrypt(passphrase []byte) ([]byte, error) {
+	salt, err := hex.DecodeString(e.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("decoding salt: %w", err)
+	}
+	nonce, err := hex.DecodeString(e.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("decoding nonce: %w", err)
+	}
+	ciphertext, err := hex.DecodeString(e.Ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("decoding ciphertext: %w", err)
+	}
+	aead, err := newAEAD(passphrase, salt, e.N, e.R, e.P)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, errors.New("invalid nonce")
+	}
+	plain, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, errors.New("authentication failed")
+	}
+	return plain, nil
+}
+
+func newAEAD(passphrase, salt []byte, n, r, p int) (cipher.AEAD, error) {
+	key, err := scrypt.Key(passphrase, salt, n, r, p, chacha20poly1305.KeySize)
+	if err != nil {
+		return nil, fmt.Errorf("deriving key: %w", err)
+	}
+	return chacha20poly1305.NewX(key)
+}
@@ -68,6 +68,21 @@ func (g *Group) Find(pub *Identity) *Node {
 	return nil
 }
 
+// CheckShare verifies the share is consistent with the distributed key of the
+// group, and that it is the share of the given node of the group.
+func (g *Group) CheckShare(s *Share, node *Node) error {
+	if g.PublicKey == nil {
+		return errors.New("key: the group has no distributed public key")
+	}
+	if err := s.Check(g.PublicKey); err != nil {
+		return err
+	}
+	if int(node.Index) != s.Share.I {
+		return fmt.Errorf("key: share %d doesn't belong to node %d of the group", s.Share.I, node.Index)
+	}
+	return nil
+}
+
 // Node returns the node at the given index if it exists in the group. If it does
 // not, Node() returns nil.
 func (g *Group) Node(i Index) *Node {
@@ -379,7 +394,7 @@ func GroupFromProto(g *proto.GroupPacket) (*Group, error) {
 		return nil, fmt.Errorf("genesis time zero")
 	}
 
-	period := time.Duration(g.GetPeriod()) * time.Second
+	period := commonutils.PeriodFromProto(g.GetPeriod(), g.GetPeriodMs())
 	if period == time.Duration(0) {
 		return nil, fmt.Errorf("period time is zero")
 	}
@@ -390,7 +405,7 @@ func GroupFromProto(g *proto.GroupPacket) (*Group, error) {
 		return nil, err
 	}
 
-	catchupPeriod := time.Duration(g.GetCatchupPeriod()) * time.Second
+	catchupPeriod := commonutils.PeriodFromProto(g.GetCatchupPeriod(), g.GetCatchupPeriodMs())
 	beaconID := g.GetMetadata().GetBeaconID()
 
 	var dist = new(DistPublic)
@@ -440,14 +455,15 @@ func (g *Group) ToProto(version commonutils.Version) *proto.GroupPacket {
 				Tls:       id.IsTLS(),
 				Key:       key,
 				Signature: id.Signature,
+				Rotation:  id.Rotation,
 			},
 			Index: id.Index,
 		}
 	}
 
 	out.Nodes = ids
-	out.Period = uint32(g.Period.Seconds())
-	out.CatchupPeriod = uint32(g.CatchupPeriod.Seconds())
+	out.Period, out.PeriodMs = commonutils.PeriodToProto(g.Period)
+	out.CatchupPeriod, out.CatchupPeriodMs = commonutils.PeriodToProto(g.CatchupPeriod)
 	out.Threshold = uint32(g.Threshold)
 	out.GenesisTime = uint64(g.GenesisTime)
 	out.TransitionTime = uint64(g.TransitionTime)
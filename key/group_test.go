@@ -76,6 +76,16 @@ func TestGroupProtobuf(t *testing.T) {
 		isErr:  false,
 	})
 
+	// a sub-second period
+	group4 := group2
+	group4.Period = 500 * time.Millisecond
+	group4.CatchupPeriod = 250 * time.Millisecond
+	vectors = append(vectors, testVector{
+		group:  &group4,
+		change: nil,
+		isErr:  false,
+	})
+
 	version := common.GetAppVersion()
 	for i, tv := range vectors {
 		protoGroup := tv.group.ToProto(version)
@@ -94,6 +104,7 @@ func TestGroupProtobuf(t *testing.T) {
 		require.Equal(t, loaded.Threshold, tv.group.Threshold)
 		require.True(t, loaded.PublicKey.Equal(tv.group.PublicKey), "test %d: %v vs %v", i, loaded.PublicKey, group.PublicKey)
 		require.Equal(t, loaded.Period, tv.group.Period)
+		require.Equal(t, loaded.CatchupPeriod, tv.group.CatchupPeriod)
 		require.Equal(t, seed, loaded.GetGenesisSeed())
 		require.Equal(t, genesis, loaded.GenesisTime)
 		require.Equal(t, transition, loaded.TransitionTime)
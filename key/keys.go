@@ -29,6 +29,9 @@ type Identity struct {
 	Addr      string
 	TLS       bool
 	Signature []byte
+	// Rotation is the signature of the previous key of the node over this
+	// identity, set when the node rotated its key
+	Rotation []byte
 }
 
 // Address implements the net.Peer interface
@@ -61,6 +64,25 @@ func (i *Identity) ValidSignature() error {
 	return AuthScheme.Verify(i.Key, msg, i.Signature)
 }
 
+// rotationHash returns the message signed by the previous key of a node to
+// rotate to this identity. It is separated from the self signature, which signs
+// the hash of the key alone.
+func (i *Identity) rotationHash() []byte {
+	h := hashFunc()
+	_, _ = h.Write([]byte("drand-key-rotation"))
+	_, _ = i.Key.MarshalTo(h)
+	return h.Sum(nil)
+}
+
+// ValidRotation returns nil if the rotation signature included in this
+// identity is made by the given previous key of the node
+func (i *Identity) ValidRotation(previous kyber.Point) error {
+	if len(i.Rotation) == 0 {
+		return errors.New("key: identity has no rotation signature")
+	}
+	return AuthScheme.Verify(previous, i.rotationHash(), i.Rotation)
+}
+
 // Equal indicates if two identities are equal
 func (i *Identity) Equal(i2 *Identity) bool {
 	if i.Addr != i2.Addr {
@@ -82,6 +104,13 @@ func (p *Pair) SelfSign() {
 	p.Public.Signature = signature
 }
 
+// SignRotation signs off the rotation of this key pair to the next identity,
+// so that the other nodes accept the swap of identities in a resharing.
+func (p *Pair) SignRotation(next *Identity) {
+	signature, _ := AuthScheme.Sign(p.Key, next.rotationHash())
+	next.Rotation = signature
+}
+
 // NewKeyPair returns a freshly created private / public key pair. The group is
 // decided by the group variable by default.
 func NewKeyPair(address string) *Pair {
@@ -119,6 +148,7 @@ type PublicTOML struct {
 	Key       string
 	TLS       bool
 	Signature string
+	Rotation  string `toml:",omitempty"`
 }
 
 // TOML returns a struct that can be marshaled using a TOML-encoding library
@@ -160,6 +190,12 @@ func (i *Identity) FromTOML(t interface{}) error {
 	i.TLS = ptoml.TLS
 	if ptoml.Signature != "" {
 		i.Signature, err = hex.DecodeString(ptoml.Signature)
+		if err != nil {
+			return err
+		}
+	}
+	if ptoml.Rotation != "" {
+		i.Rotation, err = hex.DecodeString(ptoml.Rotation)
 	}
 	return err
 }
@@ -172,6 +208,7 @@ func (i *Identity) TOML() interface{} {
 		Key:       hexKey,
 		TLS:       i.TLS,
 		Signature: hex.EncodeToString(i.Signature),
+		Rotation:  hex.EncodeToString(i.Rotation),
 	}
 }
 
@@ -214,6 +251,7 @@ func IdentityFromProto(n *proto.Identity) (*Identity, error) {
 		TLS:       n.Tls,
 		Key:       public,
 		Signature: n.GetSignature(),
+		Rotation:  n.GetRotation(),
 	}
 	return id, nil
 }
@@ -226,6 +264,7 @@ func (i *Identity) ToProto() *proto.Identity {
 		Key:       buff,
 		Tls:       i.TLS,
 		Signature: i.Signature,
+		Rotation:  i.Rotation,
 	}
 }
 
@@ -244,6 +283,20 @@ func (s *Share) PrivateShare() *share.PriShare {
 	return s.Share
 }
 
+// Check verifies the private share is the evaluation, at its index, of the
+// polynomial the share commits to, and that this polynomial is the given
+// distributed key.
+func (s *Share) Check(public *DistPublic) error {
+	expected := s.PubPoly().Eval(s.Share.I).V
+	if !expected.Equal(KeyGroup.Point().Mul(s.Share.V, nil)) {
+		return fmt.Errorf("key: private share %d doesn't match the commitment of its public polynomial", s.Share.I)
+	}
+	if public == nil || !public.Equal(s.Public()) {
+		return errors.New("key: the share commits to another distributed key than the group")
+	}
+	return nil
+}
+
 // Public returns the distributed public key associated with the distributed key
 // share
 func (s *Share) Public() *DistPublic {
@@ -65,6 +65,31 @@ func TestKeySignature(t *testing.T) {
 	require.Error(t, decodedID.ValidSignature())
 }
 
+func TestKeyRotation(t *testing.T) {
+	previous := NewTLSKeyPair(testAddr)
+	next := NewTLSKeyPair(testAddr)
+	require.Error(t, next.Public.ValidRotation(previous.Public.Key))
+
+	previous.SignRotation(next.Public)
+	require.NoError(t, next.Public.ValidRotation(previous.Public.Key))
+	require.Error(t, next.Public.ValidRotation(next.Public.Key))
+	// the rotation signature doesn't replace the self signature
+	require.NoError(t, next.Public.ValidSignature())
+
+	id := new(Identity)
+	require.NoError(t, id.FromTOML(next.Public.TOML()))
+	require.NoError(t, id.ValidRotation(previous.Public.Key))
+
+	id, err := IdentityFromProto(next.Public.ToProto())
+	require.NoError(t, err)
+	require.NoError(t, id.ValidRotation(previous.Public.Key))
+
+	// identities without rotation keep the same TOML
+	var buff bytes.Buffer
+	require.NoError(t, toml.NewEncoder(&buff).Encode(previous.Public.TOML()))
+	require.NotContains(t, buff.String(), "Rotation")
+}
+
 func TestKeyDistributedPublic(t *testing.T) {
 	n := 4
 	publics := make([]kyber.Point, n)
@@ -131,6 +156,30 @@ func TestShare(t *testing.T) {
 	}
 }
 
+func TestShareCheck(t *testing.T) {
+	n := 5
+	_, group := BatchIdentities(n)
+	poly := share.NewPriPoly(KeyGroup, group.Threshold, nil, random.New())
+	_, commits := poly.Commit(KeyGroup.Point().Base()).Info()
+	group.PublicKey = &DistPublic{commits}
+	s := &Share{Commits: commits, Share: poly.Eval(2)}
+	require.NoError(t, s.Check(group.PublicKey))
+	require.NoError(t, group.CheckShare(s, group.Nodes[2]))
+
+	// the share of another node
+	require.ErrorContains(t, group.CheckShare(s, group.Nodes[1]), "doesn't belong to node 1")
+
+	// a corrupted private share
+	corrupted := &Share{Commits: commits, Share: &share.PriShare{I: 2, V: KeyGroup.Scalar().Pick(random.New())}}
+	require.ErrorContains(t, group.CheckShare(corrupted, group.Nodes[2]), "doesn't match the commitment")
+
+	// a group with another distributed key
+	_, other := BatchIdentities(n)
+	require.ErrorContains(t, other.CheckShare(s, other.Nodes[2]), "another distributed key")
+	other.PublicKey = nil
+	require.ErrorContains(t, other.CheckShare(s, other.Nodes[2]), "no distributed public key")
+}
+
 func BatchIdentities(n int) ([]*Pair, *Group) {
 	startPort := 8000
 	startAddr := "127.0.0.1:"
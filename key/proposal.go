@@ -0,0 +1,305 @@
+package key
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	commonutils "github.com/drand/drand/common"
+	"github.com/drand/drand/common/scheme"
+	proto "github.com/drand/drand/protobuf/drand"
+)
+
+// Proposal is the setup of a DKG agreed on beforehand by all its participants:
+// the group to create, the time at which all participants start the DKG and
+// the duration of its phases. When the group has a transition time, the
+// proposal is a resharing towards that group. Each participant signs the
+// proposal with its long-term key, so that the DKG can run without a leader
+// once every participant signed it. For a resharing, these are the nodes of
+// the new group.
+type Proposal struct {
+	// Group is the group to create, without distributed key
+	Group *Group
+	// Start is the unix time at which all participants start the DKG
+	Start int64
+	// Timeout is the duration of each phase of the DKG
+	Timeout time.Duration
+	// Signatures of the participants over the proposal, by index
+	Signatures map[Index][]byte
+}
+
+// NewProposal returns an unsigned proposal for a DKG between the given
+// identities, starting at the given time. A resharing proposal is created
+// from it by setting the transition time of its group, and the genesis time
+// and seed of the group reshared from.
+func NewProposal(list []*Identity, threshold int, genesis, start int64, period, catchupPeriod, timeout time.Duration,
+	sch scheme.Scheme, beaconID string) *Proposal {
+	return &Proposal{
+		Group:      NewGroup(list, threshold, genesis, period, catchupPeriod, sch, beaconID),
+		Start:      start,
+		Timeout:    timeout,
+		Signatures: make(map[Index][]byte),
+	}
+}
+
+// Reshare returns true if the proposal is a resharing to its group, rather
+// than a fresh DKG.
+func (p *Proposal) Reshare() bool {
+	return p.Group.TransitionTime != 0
+}
+
+// Hash returns the hash of the proposal, which participants sign. Contrary to
+// the hash of the group, it covers the addresses of the nodes and all the
+// parameters of the chain.
+func (p *Proposal) Hash() []byte {
+	h := hashFunc()
+	_, _ = h.Write(p.Group.Hash())
+	for _, n := range p.Group.Nodes {
+		_, _ = h.Write([]byte(n.Address()))
+		if n.IsTLS() {
+			_, _ = h.Write([]byte{1})
+		} else {
+			_, _ = h.Write([]byte{0})
+		}
+	}
+	// durations are hashed as they are sent over the wire: in seconds, and in
+	// milliseconds for the periods that aren't a whole number of seconds
+	period, periodMs := commonutils.PeriodToProto(p.Group.Period)
+	catchup, catchupMs := commonutils.PeriodToProto(p.Group.CatchupPeriod)
+	_ = binary.Write(h, binary.LittleEndian, period)
+	_ = binary.Write(h, binary.LittleEndian, catchup)
+	if periodMs != 0 || catchupMs != 0 {
+		_ = binary.Write(h, binary.LittleEndian, periodMs)
+		_ = binary.Write(h, binary.LittleEndian, catchupMs)
+	}
+	_, _ = h.Write([]byte(p.Group.Scheme.ID))
+	_, _ = h.Write([]byte(commonutils.GetCanonicalBeaconID(p.Group.ID)))
+	_, _ = h.Write(p.Group.GetGenesisSeed())
+	_ = binary.Write(h, binary.LittleEndian, p.Start)
+	_ = binary.Write(h, binary.LittleEndian, uint32(p.Timeout.Seconds()))
+	return h.Sum(nil)
+}
+
+// Sign adds the signature of the given key pair to the proposal. The public
+// key of the pair must be one of the nodes of the group.
+func (p *Proposal) Sign(pair *Pair) error {
+	var node *Node
+	for _, n := range p.Group.Nodes {
+		if n.Key.Equal(pair.Public.Key) {
+			node = n
+			break
+		}
+	}
+	if node == nil {
+		return errors.New("proposal: key is not part of the group")
+	}
+	sig, err := AuthScheme.Sign(pair.Key, p.Hash())
+	if err != nil {
+		return fmt.Errorf("proposal: signing: %w", err)
+	}
+	if p.Signatures == nil {
+		p.Signatures = make(map[Index][]byte)
+	}
+	p.Signatures[node.Index] = sig
+	return nil
+}
+
+// Unsigned returns the nodes whose signature over the proposal is missing or
+// invalid.
+func (p *Proposal) Unsigned() []*Node {
+	msg := p.Hash()
+	var unsigned []*Node
+	for _, n := range p.Group.Nodes {
+		sig, ok := p.Signatures[n.Index]
+		if !ok || AuthScheme.Verify(n.Key, msg, sig) != nil {
+			unsigned = append(unsigned, n)
+		}
+	}
+	return unsigned
+}
+
+// VerifyParameters checks the consistency of the proposal, regardless of its
+// signatures.
+func (p *Proposal) VerifyParameters() error {
+	g := p.Group
+	if g == nil || g.Len() == 0 {
+		return errors.New("proposal: empty group")
+	}
+	if g.Threshold < MinimumT(g.Len()) || g.Threshold > g.Len() {
+		return fmt.Errorf("proposal: invalid threshold %d for %d nodes", g.Threshold, g.Len())
+	}
+	if g.PublicKey != nil {
+		return errors.New("proposal: group already has a distributed key")
+	}
+	indexes := make(map[Index]bool, g.Len())
+	for _, n := range g.Nodes {
+		if indexes[n.Index] {
+			return fmt.Errorf("proposal: duplicate index %d", n.Index)
+		}
+		indexes[n.Index] = true
+		if err := n.ValidSignature(); err != nil {
+			return fmt.Errorf("proposal: invalid self-signature of %s", n.Address())
+		}
+	}
+	for name, d := range map[string]time.Duration{
+		"period":         g.Period,
+		"catchup period": g.CatchupPeriod,
+	} {
+		if d%time.Millisecond != 0 {
+			return fmt.Errorf("proposal: %s %s is not a whole number of milliseconds", name, d)
+		}
+	}
+	if p.Timeout%time.Second != 0 {
+		return fmt.Errorf("proposal: timeout %s is not a whole number of seconds", p.Timeout)
+	}
+	if g.Period <= 0 {
+		return errors.New("proposal: period is zero")
+	}
+	if p.Timeout <= 0 {
+		return errors.New("proposal: timeout is zero")
+	}
+	if p.Start <= 0 {
+		return errors.New("proposal: start time is zero")
+	}
+	end := p.Start + int64(3*p.Timeout/time.Second)
+	if p.Reshare() {
+		if g.TransitionTime <= end {
+			return fmt.Errorf("proposal: transition time %d is before the end of the dkg at %d", g.TransitionTime, end)
+		}
+		if g.GenesisTime >= g.TransitionTime {
+			return errors.New("proposal: genesis time is after the transition time")
+		}
+		// the new group takes over the chain at the time of a round
+		if (g.TransitionTime-g.GenesisTime)*int64(time.Second/time.Millisecond)%g.Period.Milliseconds() != 0 {
+			return fmt.Errorf("proposal: transition time %d is not the time of a round", g.TransitionTime)
+		}
+	} else if g.GenesisTime <= end {
+		return fmt.Errorf("proposal: genesis time %d is before the end of the dkg at %d", g.GenesisTime, end)
+	}
+	return nil
+}
+
+// Verify checks the consistency of the proposal and that all the nodes of the
+// group signed it.
+func (p *Proposal) Verify() error {
+	if err := p.VerifyParameters(); err != nil {
+		return err
+	}
+	if unsigned := p.Unsigned(); len(unsigned) > 0 {
+		addrs := make([]string, len(unsigned))
+		for i, n := range unsigned {
+			addrs[i] = n.Address()
+		}
+		return fmt.Errorf("proposal: missing or invalid signatures from %s", strings.Join(addrs, ", "))
+	}
+	return nil
+}
+
+// ProposalTOML is the TOML representation of a proposal
+type ProposalTOML struct {
+	Start      int64
+	Timeout    string
+	Group      *GroupTOML
+	Signatures []*ProposalSignatureTOML `toml:",omitempty"`
+}
+
+// ProposalSignatureTOML is the TOML representation of the signature of a node
+// over a proposal
+type ProposalSignatureTOML struct {
+	Index     Index
+	Signature string
+}
+
+// TOML returns a TOML-encodable version of the proposal
+func (p *Proposal) TOML() interface{} {
+	ptoml := &ProposalTOML{
+		Start:   p.Start,
+		Timeout: p.Timeout.String(),
+		Group:   p.Group.TOML().(*GroupTOML),
+	}
+	for _, n := range p.Group.Nodes {
+		if sig, ok := p.Signatures[n.Index]; ok {
+			ptoml.Signatures = append(ptoml.Signatures, &ProposalSignatureTOML{
+				Index:     n.Index,
+				Signature: hex.EncodeToString(sig),
+			})
+		}
+	}
+	return ptoml
+}
+
+// FromTOML decodes the proposal from its TOML representation
+func (p *Proposal) FromTOML(i interface{}) (err error) {
+	ptoml, ok := i.(*ProposalTOML)
+	if !ok {
+		return errors.New("proposal: can't decode from non ProposalTOML struct")
+	}
+	if ptoml.Group == nil {
+		return errors.New("proposal: no group")
+	}
+	p.Group = new(Group)
+	if err := p.Group.FromTOML(ptoml.Group); err != nil {
+		return err
+	}
+	p.Start = ptoml.Start
+	if p.Timeout, err = time.ParseDuration(ptoml.Timeout); err != nil {
+		return fmt.Errorf("proposal: decoding timeout: %w", err)
+	}
+	p.Signatures = make(map[Index][]byte, len(ptoml.Signatures))
+	for _, s := range ptoml.Signatures {
+		if p.Signatures[s.Index], err = hex.DecodeString(s.Signature); err != nil {
+			return fmt.Errorf("proposal: decoding signature of node %d: %w", s.Index, err)
+		}
+	}
+	return nil
+}
+
+// TOMLValue returns an empty TOML-compatible value of the proposal
+func (p *Proposal) TOMLValue() interface{} {
+	return &ProposalTOML{}
+}
+
+// ToProto encodes the proposal into its wire format
+func (p *Proposal) ToProto(version commonutils.Version) *proto.DKGProposal {
+	out := &proto.DKGProposal{
+		Group:      p.Group.ToProto(version),
+		Start:      p.Start,
+		Timeout:    uint32(p.Timeout.Seconds()),
+		Signatures: make([][]byte, len(p.Group.Nodes)),
+	}
+	// signatures are in the order of the nodes of the group packet
+	for i, n := range p.Group.Nodes {
+		out.Signatures[i] = p.Signatures[n.Index]
+	}
+	return out
+}
+
+// ProposalFromProto decodes a proposal from its wire format. It does not
+// verify it.
+func ProposalFromProto(pp *proto.DKGProposal) (*Proposal, error) {
+	if pp.GetGroup() == nil {
+		return nil, errors.New("proposal: no group")
+	}
+	group, err := GroupFromProto(pp.GetGroup())
+	if err != nil {
+		return nil, err
+	}
+	if len(pp.GetSignatures()) > len(group.Nodes) {
+		return nil, errors.New("proposal: more signatures than nodes")
+	}
+	p := &Proposal{
+		Group:      group,
+		Start:      pp.GetStart(),
+		Timeout:    time.Duration(pp.GetTimeout()) * time.Second,
+		Signatures: make(map[Index][]byte, len(group.Nodes)),
+	}
+	for i, sig := range pp.GetSignatures() {
+		if len(sig) > 0 {
+			p.Signatures[group.Nodes[i].Index] = sig
+		}
+	}
+	return p, nil
+}
@@ -0,0 +1,147 @@
+package key
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/common"
+	"github.com/drand/drand/common/scheme"
+)
+
+func newProposal(t *testing.T, n int) (*Proposal, []*Pair) {
+	t.Helper()
+	pairs := make([]*Pair, n)
+	ids := make([]*Identity, n)
+	for i := range pairs {
+		pairs[i] = NewKeyPair("127.0.0.1:3000")
+		ids[i] = pairs[i].Public
+	}
+	start := time.Now().Unix()
+	p := NewProposal(ids, MinimumT(n), start+60, start, 30*time.Second, 15*time.Second, 10*time.Second,
+		scheme.GetSchemeFromEnv(), "test_beacon")
+	return p, pairs
+}
+
+func TestProposalSign(t *testing.T) {
+	p, pairs := newProposal(t, 4)
+	require.NoError(t, p.VerifyParameters())
+	require.Error(t, p.Verify())
+	require.Len(t, p.Unsigned(), 4)
+
+	for _, pair := range pairs[:3] {
+		require.NoError(t, p.Sign(pair))
+	}
+	require.Len(t, p.Unsigned(), 1)
+	require.ErrorContains(t, p.Verify(), "missing or invalid signatures")
+
+	require.NoError(t, p.Sign(pairs[3]))
+	require.NoError(t, p.Verify())
+
+	// a key out of the group can't sign
+	require.Error(t, p.Sign(NewKeyPair("127.0.0.1:4000")))
+
+	// any change of the parameters invalidates the signatures
+	p.Group.Period = time.Minute
+	require.Len(t, p.Unsigned(), 4)
+	p.Group.Period = 30 * time.Second
+	p.Group.Nodes[0].Addr = "127.0.0.1:5000"
+	require.Len(t, p.Unsigned(), 4)
+}
+
+func TestProposalVerifyParameters(t *testing.T) {
+	vectors := []struct {
+		change func(*Proposal)
+		err    string
+	}{
+		{func(p *Proposal) { p.Group.Threshold = 1 }, "invalid threshold"},
+		{func(p *Proposal) { p.Group.Threshold = 5 }, "invalid threshold"},
+		{func(p *Proposal) { p.Group.Nodes[1].Index = p.Group.Nodes[0].Index }, "duplicate index"},
+		{func(p *Proposal) { p.Group.Nodes[0].Signature = nil }, "invalid self-signature"},
+		{func(p *Proposal) { p.Timeout = 1500 * time.Millisecond }, "whole number of seconds"},
+		{func(p *Proposal) { p.Group.Period = 1500 * time.Microsecond }, "whole number of milliseconds"},
+		{func(p *Proposal) { p.Timeout = 0 }, "timeout is zero"},
+		{func(p *Proposal) { p.Start = 0 }, "start time is zero"},
+		{func(p *Proposal) { p.Group.GenesisTime = p.Start + 30 }, "before the end of the dkg"},
+		{func(p *Proposal) { p.Group.TransitionTime = p.Start + 30 }, "transition time"},
+		{func(p *Proposal) { p.Group.TransitionTime = p.Start + 40 }, "genesis time is after the transition time"},
+		{func(p *Proposal) { p.Group.TransitionTime = p.Group.GenesisTime + 31 }, "not the time of a round"},
+		{func(p *Proposal) {
+			p.Group.Period = 400 * time.Millisecond
+			p.Group.TransitionTime = p.Group.GenesisTime + 1
+		}, "not the time of a round"},
+	}
+	for i, v := range vectors {
+		p, _ := newProposal(t, 4)
+		v.change(p)
+		require.ErrorContains(t, p.VerifyParameters(), v.err, "vector %d", i)
+	}
+}
+
+func TestProposalReshare(t *testing.T) {
+	p, pairs := newProposal(t, 4)
+	require.False(t, p.Reshare())
+	oldSeed := p.Group.GetGenesisSeed()
+
+	// the chain of the new group started before the resharing
+	p.Group.GenesisTime = p.Start - 3600
+	p.Group.GenesisSeed = oldSeed
+	p.Group.TransitionTime = p.Group.GenesisTime + 3660
+	require.True(t, p.Reshare())
+	require.NoError(t, p.VerifyParameters())
+	for _, pair := range pairs {
+		require.NoError(t, p.Sign(pair))
+	}
+
+	file := path.Join(t.TempDir(), "proposal.toml")
+	require.NoError(t, Save(file, p, false))
+	loaded := new(Proposal)
+	require.NoError(t, Load(file, loaded))
+	require.True(t, loaded.Reshare())
+	require.Equal(t, oldSeed, loaded.Group.GetGenesisSeed())
+	require.NoError(t, loaded.Verify())
+}
+
+func TestProposalEncoding(t *testing.T) {
+	p, pairs := newProposal(t, 5)
+	for _, pair := range pairs[1:] {
+		require.NoError(t, p.Sign(pair))
+	}
+
+	file := path.Join(t.TempDir(), "proposal.toml")
+	require.NoError(t, Save(file, p, false))
+	loaded := new(Proposal)
+	require.NoError(t, Load(file, loaded))
+	require.Equal(t, p.Hash(), loaded.Hash())
+	require.Equal(t, p.Signatures, loaded.Signatures)
+
+	received, err := ProposalFromProto(loaded.ToProto(common.GetAppVersion()))
+	require.NoError(t, err)
+	require.Equal(t, p.Hash(), received.Hash())
+	require.Equal(t, p.Signatures, received.Signatures)
+
+	require.NoError(t, received.Sign(pairs[0]))
+	require.NoError(t, received.Verify())
+}
+
+func TestProposalSubSecondPeriod(t *testing.T) {
+	p, pairs := newProposal(t, 4)
+	p.Group.Period = 500 * time.Millisecond
+	p.Group.CatchupPeriod = 250 * time.Millisecond
+	require.NoError(t, p.VerifyParameters())
+	for _, pair := range pairs {
+		require.NoError(t, p.Sign(pair))
+	}
+
+	received, err := ProposalFromProto(p.ToProto(common.GetAppVersion()))
+	require.NoError(t, err)
+	require.Equal(t, 500*time.Millisecond, received.Group.Period)
+	require.Equal(t, 250*time.Millisecond, received.Group.CatchupPeriod)
+	require.NoError(t, received.Verify())
+
+	// the milliseconds are covered by the signatures
+	received.Group.Period = 700 * time.Millisecond
+	require.Len(t, received.Unsigned(), 4)
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"path"
 	"reflect"
+	"strings"
 
 	"github.com/BurntSushi/toml"
 
@@ -22,6 +23,11 @@ type Store interface {
 	// LoadKeyPair loads the private/public key pair associated with the drand
 	// operator
 	LoadKeyPair() (*Pair, error)
+	// ArchiveKeyPair keeps a previous key pair of the operator after a key
+	// rotation, to recognize the node in the groups it was part of with it
+	ArchiveKeyPair(p *Pair) error
+	// LoadArchivedKeyPairs loads the key pairs archived
+	LoadArchivedKeyPairs() ([]*Pair, error)
 	SaveShare(share *Share) error
 	LoadShare() (*Share, error)
 	SaveGroup(*Group) error
@@ -35,6 +41,7 @@ const KeyFolderName = "key"
 // GroupFolderName is the name of the folder where drand keeps its group files
 const GroupFolderName = "groups"
 const keyFileName = "drand_id"
+const archiveFolderName = "archive"
 const privateExtension = ".private"
 const publicExtension = ".public"
 const groupFileName = "drand_group.toml"
@@ -56,9 +63,12 @@ type fileStore struct {
 	beaconID       string
 	privateKeyFile string
 	publicKeyFile  string
+	archiveFolder  string
 	shareFile      string
 	distKeyFile    string
 	groupFile      string
+	// passphrase encrypts the private files when set
+	passphrase []byte
 }
 
 // GetFirstStore will return the first store from the stores map
@@ -72,6 +82,10 @@ func GetFirstStore(stores map[string]Store) (string, Store) {
 // NewFileStores will list all folder on base path and load every file store it can find. It will
 // return a map with a beacon id as key and a file store as value.
 func NewFileStores(baseFolder string) (map[string]Store, error) {
+	return newFileStores(baseFolder, nil)
+}
+
+func newFileStores(baseFolder string, passphrase []byte) (map[string]Store, error) {
 	fileStores := make(map[string]Store)
 	fi, err := os.ReadDir(path.Join(baseFolder))
 	if err != nil {
@@ -80,12 +94,12 @@ func NewFileStores(baseFolder string) (map[string]Store, error) {
 
 	for _, f := range fi {
 		if f.IsDir() {
-			fileStores[f.Name()] = NewFileStore(baseFolder, f.Name())
+			fileStores[f.Name()] = newFileStore(baseFolder, f.Name(), passphrase)
 		}
 	}
 
 	if len(fileStores) == 0 {
-		fileStores[common.DefaultBeaconID] = NewFileStore(baseFolder, common.DefaultBeaconID)
+		fileStores[common.DefaultBeaconID] = newFileStore(baseFolder, common.DefaultBeaconID, passphrase)
 	}
 
 	return fileStores, nil
@@ -94,15 +108,23 @@ func NewFileStores(baseFolder string) (map[string]Store, error) {
 // NewFileStore is used to create the config folder and all the subfolders.
 // If a folder already exists, we simply check the rights
 func NewFileStore(baseFolder, beaconID string) Store {
+	return newFileStore(baseFolder, beaconID, nil)
+}
+
+func newFileStore(baseFolder, beaconID string, passphrase []byte) *fileStore {
 	beaconID = common.GetCanonicalBeaconID(beaconID)
 
 	store := &fileStore{baseFolder: baseFolder, beaconID: beaconID}
+	if len(passphrase) > 0 {
+		store.passphrase = passphrase
+	}
 
 	keyFolder := fs.CreateSecureFolder(path.Join(baseFolder, beaconID, KeyFolderName))
 	groupFolder := fs.CreateSecureFolder(path.Join(baseFolder, beaconID, GroupFolderName))
 
 	store.privateKeyFile = path.Join(keyFolder, keyFileName) + privateExtension
 	store.publicKeyFile = path.Join(keyFolder, keyFileName) + publicExtension
+	store.archiveFolder = path.Join(keyFolder, archiveFolderName)
 	store.groupFile = path.Join(groupFolder, groupFileName)
 	store.shareFile = path.Join(groupFolder, shareFileName)
 	store.distKeyFile = path.Join(groupFolder, distKeyFileName)
@@ -113,7 +135,7 @@ func NewFileStore(baseFolder, beaconID string) Store {
 // SaveKeyPair first saves the private key in a file with tight permissions and then
 // saves the public part in another file.
 func (f *fileStore) SaveKeyPair(p *Pair) error {
-	if err := Save(f.privateKeyFile, p, true); err != nil {
+	if err := f.savePrivate(f.privateKeyFile, p); err != nil {
 		return err
 	}
 	fmt.Printf("Saved the key : %s at %s\n", p.Public.Addr, f.publicKeyFile) //nolint
@@ -123,12 +145,49 @@ func (f *fileStore) SaveKeyPair(p *Pair) error {
 // LoadKeyPair decode private key first then public
 func (f *fileStore) LoadKeyPair() (*Pair, error) {
 	p := new(Pair)
-	if err := Load(f.privateKeyFile, p); err != nil {
+	if err := LoadEncrypted(f.privateKeyFile, p, f.passphrase); err != nil {
 		return nil, err
 	}
 	return p, Load(f.publicKeyFile, p.Public)
 }
 
+// ArchiveKeyPair saves the key pair in the archive folder, under a name
+// derived from its public key so that archiving it again overwrites it.
+func (f *fileStore) ArchiveKeyPair(p *Pair) error {
+	fs.CreateSecureFolder(f.archiveFolder)
+	name := path.Join(f.archiveFolder, fmt.Sprintf("%s_%x", keyFileName, p.Public.Hash()[:8]))
+	if err := f.savePrivate(name+privateExtension, p); err != nil {
+		return err
+	}
+	return Save(name+publicExtension, p.Public, false)
+}
+
+// LoadArchivedKeyPairs loads all the key pairs of the archive folder.
+func (f *fileStore) LoadArchivedKeyPairs() ([]*Pair, error) {
+	entries, err := os.ReadDir(f.archiveFolder)
+	if os.IsNotExist(err) {
+		return []*Pair{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	pairs := make([]*Pair, 0, len(entries)/2)
+	for _, e := range entries {
+		if e.IsDir() || path.Ext(e.Name()) != privateExtension {
+			continue
+		}
+		name := path.Join(f.archiveFolder, strings.TrimSuffix(e.Name(), privateExtension))
+		p := new(Pair)
+		if err := LoadEncrypted(name+privateExtension, p, f.passphrase); err != nil {
+			return nil, err
+		}
+		if err := Load(name+publicExtension, p.Public); err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, p)
+	}
+	return pairs, nil
+}
+
 func (f *fileStore) LoadGroup() (*Group, error) {
 	g := new(Group)
 	return g, Load(f.groupFile, g)
@@ -140,12 +199,20 @@ func (f *fileStore) SaveGroup(g *Group) error {
 
 func (f *fileStore) SaveShare(share *Share) error {
 	fmt.Printf("crypto store: saving private share in %s\n", f.shareFile) //nolint
-	return Save(f.shareFile, share, true)
+	return f.savePrivate(f.shareFile, share)
 }
 
 func (f *fileStore) LoadShare() (*Share, error) {
 	s := new(Share)
-	return s, Load(f.shareFile, s)
+	return s, LoadEncrypted(f.shareFile, s, f.passphrase)
+}
+
+// savePrivate saves a private file, encrypted if the store has a passphrase.
+func (f *fileStore) savePrivate(filePath string, t Tomler) error {
+	if f.passphrase != nil {
+		return SaveEncrypted(filePath, t, f.passphrase)
+	}
+	return Save(filePath, t, true)
 }
 
 func (f *fileStore) Reset(...ResetOption) error {
@@ -75,3 +75,92 @@ func TestKeysSaveLoad(t *testing.T) {
 	require.Equal(t, testShare.Share.V, loadedShare.Share.V)
 	require.Equal(t, testShare.Share.I, loadedShare.Share.I)
 }
+
+func TestEncryptedStore(t *testing.T) {
+	ps, _ := BatchIdentities(2)
+	beaconID := commonutils.GetCanonicalBeaconID(os.Getenv("BEACON_ID"))
+	tmp := t.TempDir()
+	passphrase := []byte("correct horse battery staple")
+
+	// keys saved in plain text are loaded by the encrypted store
+	plain := NewFileStore(tmp, beaconID).(*fileStore)
+	require.NoError(t, plain.SaveKeyPair(ps[0]))
+	store := NewEncryptedFileStore(tmp, beaconID, passphrase).(*fileStore)
+	pair, err := store.LoadKeyPair()
+	require.NoError(t, err)
+	require.Equal(t, ps[0].Key.String(), pair.Key.String())
+
+	testShare := &Share{
+		Commits: []kyber.Point{ps[0].Public.Key, ps[1].Public.Key},
+		Share:   &share.PriShare{V: ps[0].Key, I: 0},
+	}
+	require.NoError(t, store.SaveKeyPair(pair))
+	require.NoError(t, store.SaveShare(testShare))
+	for _, file := range []string{store.privateKeyFile, store.shareFile} {
+		encrypted, err := IsEncrypted(file)
+		require.NoError(t, err)
+		require.True(t, encrypted, file)
+		content, err := os.ReadFile(file)
+		require.NoError(t, err)
+		require.NotContains(t, string(content), ps[0].Key.String())
+	}
+	// the public key stays in plain text
+	encrypted, err := IsEncrypted(store.publicKeyFile)
+	require.NoError(t, err)
+	require.False(t, encrypted)
+
+	pair, err = store.LoadKeyPair()
+	require.NoError(t, err)
+	require.Equal(t, ps[0].Key.String(), pair.Key.String())
+	require.Equal(t, ps[0].Public.Key.String(), pair.Public.Key.String())
+	loadedShare, err := store.LoadShare()
+	require.NoError(t, err)
+	require.Equal(t, testShare.Share.V, loadedShare.Share.V)
+
+	_, err = plain.LoadKeyPair()
+	require.ErrorIs(t, err, ErrEncryptedKey)
+	_, err = plain.LoadShare()
+	require.ErrorIs(t, err, ErrEncryptedKey)
+
+	wrong := NewEncryptedFileStore(tmp, beaconID, []byte("wrong"))
+	_, err = wrong.LoadKeyPair()
+	require.ErrorIs(t, err, ErrWrongPassphrase)
+}
+
+func TestArchiveKeyPair(t *testing.T) {
+	ps, _ := BatchIdentities(3)
+	beaconID := commonutils.GetCanonicalBeaconID(os.Getenv("BEACON_ID"))
+	tmp := t.TempDir()
+	passphrase := []byte("correct horse battery staple")
+
+	store := NewEncryptedFileStore(tmp, beaconID, passphrase).(*fileStore)
+	archived, err := store.LoadArchivedKeyPairs()
+	require.NoError(t, err)
+	require.Empty(t, archived)
+
+	require.NoError(t, store.SaveKeyPair(ps[2]))
+	require.NoError(t, store.ArchiveKeyPair(ps[0]))
+	require.NoError(t, store.ArchiveKeyPair(ps[1]))
+	// archiving a key pair again overwrites it
+	require.NoError(t, store.ArchiveKeyPair(ps[1]))
+
+	archived, err = store.LoadArchivedKeyPairs()
+	require.NoError(t, err)
+	require.Len(t, archived, 2)
+	for _, p := range ps[:2] {
+		var found bool
+		for _, a := range archived {
+			if a.Public.Equal(p.Public) {
+				require.Equal(t, p.Key.String(), a.Key.String())
+				found = true
+			}
+		}
+		require.True(t, found, p.Public.Address())
+	}
+	pair, err := store.LoadKeyPair()
+	require.NoError(t, err)
+	require.True(t, pair.Public.Equal(ps[2].Public))
+
+	_, err = NewFileStore(tmp, beaconID).LoadArchivedKeyPairs()
+	require.ErrorIs(t, err, ErrEncryptedKey)
+}
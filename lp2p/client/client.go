@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/host"
 	"golang.org/x/xerrors"
 	"google.golang.org/protobuf/proto"
 
@@ -19,9 +20,26 @@ import (
 // Client is a concrete pubsub client implementation
 type Client struct {
 	cancel func()
-	latest uint64
 	cache  client.Cache
 	log    log.Logger
+	info   *chain.Info
+	// verifier verifies the rounds received on both topics and fetched,
+	// deduplicating and batching the verifications. It is set up with the
+	// topic validators.
+	verifier *beaconVerifier
+	// h is the host used to fetch the missed rounds, if set.
+	h host.Host
+	// withoutLegacy disables the subscription to the legacy topic.
+	withoutLegacy bool
+
+	// deliverLk serializes the delivery of the rounds, in order.
+	deliverLk sync.Mutex
+	latest    uint64
+	// backfilling is set while the missed rounds are fetched in the
+	// background. The rounds received meanwhile are queued, and delivered
+	// after the missed ones.
+	backfilling bool
+	queued      []*drand.PublicRandResponse
 
 	subs struct {
 		sync.Mutex
@@ -34,11 +52,14 @@ func (c *Client) SetLog(l log.Logger) {
 	c.log = l
 }
 
+// Option configures a gossip randomness client.
+type Option func(*Client)
+
 // WithPubsub provides an option for integrating pubsub notification
 // into a drand client.
-func WithPubsub(ps *pubsub.PubSub) client.Option {
+func WithPubsub(ps *pubsub.PubSub, opts ...Option) client.Option {
 	return client.WithWatcher(func(info *chain.Info, cache client.Cache) (client.Watcher, error) {
-		c, err := NewWithPubsub(ps, info, cache)
+		c, err := NewWithPubsub(ps, info, cache, opts...)
 		if err != nil {
 			return nil, err
 		}
@@ -46,8 +67,18 @@ func WithPubsub(ps *pubsub.PubSub) client.Option {
 	})
 }
 
-// NewWithPubsub creates a gossip randomness client.
-func NewWithPubsub(ps *pubsub.PubSub, info *chain.Info, cache client.Cache) (*Client, error) {
+// WithoutLegacyTopic makes the client only subscribe to the versioned topic of
+// the chain, on which the randomness is wrapped in envelopes, and not to the
+// legacy topic carrying it bare.
+func WithoutLegacyTopic() Option {
+	return func(c *Client) {
+		c.withoutLegacy = true
+	}
+}
+
+// NewWithPubsub creates a gossip randomness client, subscribed to both the
+// versioned and the legacy topics of the chain by default.
+func NewWithPubsub(ps *pubsub.PubSub, info *chain.Info, cache client.Cache, opts ...Option) (*Client, error) {
 	if info == nil {
 		return nil, xerrors.Errorf("No chain supplied for joining")
 	}
@@ -57,72 +88,174 @@ func NewWithPubsub(ps *pubsub.PubSub, info *chain.Info, cache client.Cache) (*Cl
 		cancel: cancel,
 		cache:  cache,
 		log:    log.DefaultLogger(),
+		info:   info,
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.subs.M = make(map[*int]chan drand.PublicRandResponse)
 
 	chainHash := hex.EncodeToString(info.Hash())
-	topic := lp2p.PubSubTopic(chainHash)
-	if err := ps.RegisterTopicValidator(topic, randomnessValidator(info, cache, c)); err != nil {
+	et, err := c.subscribe(ctx, ps, lp2p.PubSubEnvelopeTopic(chainHash), envelopeValidator(info, cache, c), decodeEnvelope)
+	if err != nil {
 		cancel()
+		return nil, err
+	}
+	if !c.withoutLegacy {
+		if _, err := c.subscribe(ctx, ps, lp2p.PubSubTopic(chainHash), randomnessValidator(info, cache, c), decodeRandomness); err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+
+	if c.h != nil {
+		go c.catchUp(ctx, et)
+	}
+
+	return c, nil
+}
+
+// decodeFunc decodes the randomness carried by the messages of a topic.
+type decodeFunc func(data []byte) (*drand.PublicRandResponse, error)
+
+func decodeRandomness(data []byte) (*drand.PublicRandResponse, error) {
+	var rand drand.PublicRandResponse
+	if err := proto.Unmarshal(data, &rand); err != nil {
+		return nil, err
+	}
+	return &rand, nil
+}
+
+func decodeEnvelope(data []byte) (*drand.PublicRandResponse, error) {
+	var env drand.GossipEnvelope
+	if err := proto.Unmarshal(data, &env); err != nil {
+		return nil, err
+	}
+	return env.GetBeacon(), nil
+}
+
+// subscribe joins a topic and delivers the randomness of its messages,
+// decoded with decode, until ctx is canceled.
+func (c *Client) subscribe(ctx context.Context, ps *pubsub.PubSub, topic string, v pubsub.ValidatorEx,
+	decode decodeFunc) (*pubsub.Topic, error) {
+	if err := ps.RegisterTopicValidator(topic, v); err != nil {
 		return nil, xerrors.Errorf("creating topic: %w", err)
 	}
 	t, err := ps.Join(topic)
 	if err != nil {
-		cancel()
 		return nil, xerrors.Errorf("joining pubsub: %w", err)
 	}
+	if err := t.SetScoreParams(lp2p.TopicScoreParams()); err != nil {
+		c.log.Warnw("", "gossip client", "could not set topic score params", "err", err)
+	}
 	s, err := t.Subscribe()
 	if err != nil {
-		cancel()
+		_ = t.Close()
 		return nil, xerrors.Errorf("subscribe: %w", err)
 	}
 
-	c.subs.M = make(map[*int]chan drand.PublicRandResponse)
-
 	go func() {
 		for {
 			msg, err := s.Next(ctx)
 			if ctx.Err() != nil {
-				c.subs.Lock()
-				for _, ch := range c.subs.M {
-					close(ch)
-				}
-				c.subs.M = make(map[*int]chan drand.PublicRandResponse)
-				c.subs.Unlock()
-				t.Close()
+				c.closeSubs()
 				s.Cancel()
+				t.Close()
 				return
 			}
 			if err != nil {
 				c.log.Warnw("", "gossip client", "topic.Next error", "err", err)
 				continue
 			}
-			var rand drand.PublicRandResponse
-			err = proto.Unmarshal(msg.Data, &rand)
+			rand, err := decode(msg.Data)
 			if err != nil {
 				c.log.Warnw("", "gossip client", "unmarshal random error", "err", err)
 				continue
 			}
 
-			// TODO: verification, need to pass drand network public key in
+			c.deliver(ctx, t, rand)
+		}
+	}()
+	return t, nil
+}
 
-			if c.latest >= rand.Round {
-				continue
-			}
-			c.latest = rand.Round
+// closeSubs closes the channels of the subscribers.
+func (c *Client) closeSubs() {
+	c.subs.Lock()
+	defer c.subs.Unlock()
+	for _, ch := range c.subs.M {
+		close(ch)
+	}
+	c.subs.M = make(map[*int]chan drand.PublicRandResponse)
+}
 
-			c.subs.Lock()
-			for _, ch := range c.subs.M {
-				select {
-				case ch <- rand:
-				default:
-					c.log.Warnw("", "gossip client", "randomness notification dropped due to a full channel")
-				}
-			}
-			c.subs.Unlock()
+// deliver notifies the subscribers of a round more recent than the latest one
+// delivered, after the rounds missed in between if they can be fetched.
+func (c *Client) deliver(ctx context.Context, t *pubsub.Topic, rand *drand.PublicRandResponse) {
+	c.deliverLk.Lock()
+	defer c.deliverLk.Unlock()
+
+	if c.backfilling {
+		c.queued = append(c.queued, rand)
+		return
+	}
+	c.deliverLocked(ctx, t, rand)
+}
+
+// deliverLocked delivers the round, or starts fetching the rounds missed before
+// it. It requires the deliver lock.
+func (c *Client) deliverLocked(ctx context.Context, t *pubsub.Topic, rand *drand.PublicRandResponse) {
+	if c.latest >= rand.Round {
+		return
+	}
+	if c.h != nil && c.latest > 0 && rand.Round > c.latest+1 {
+		c.backfilling = true
+		c.queued = append(c.queued, rand)
+		go c.fill(ctx, t, c.latest+1, rand.Round-1)
+		return
+	}
+	c.latest = rand.Round
+	c.notify(rand)
+}
+
+// fill fetches the missed rounds from..to without holding the deliver lock,
+// and delivers them followed by the rounds queued meanwhile.
+func (c *Client) fill(ctx context.Context, t *pubsub.Topic, from, to uint64) {
+	missed := c.backfill(ctx, t, from, to)
+
+	c.deliverLk.Lock()
+	defer c.deliverLk.Unlock()
+	for _, rand := range append(missed, c.queued[0]) {
+		if rand.Round > c.latest {
+			c.latest = rand.Round
+			c.notify(rand)
 		}
-	}()
+	}
+	// the round which revealed the gap is delivered even if the rounds
+	// before it couldn't all be fetched, the next ones may reveal a new gap
+	queued := c.queued[1:]
+	c.queued = nil
+	c.backfilling = false
+	for i, rand := range queued {
+		c.deliverLocked(ctx, t, rand)
+		if c.backfilling {
+			c.queued = append(c.queued, queued[i+1:]...)
+			return
+		}
+	}
+}
 
-	return c, nil
+func (c *Client) notify(rand *drand.PublicRandResponse) {
+	c.subs.Lock()
+	defer c.subs.Unlock()
+	for _, ch := range c.subs.M {
+		select {
+		case ch <- *rand:
+		default:
+			c.log.Warnw("", "gossip client", "randomness notification dropped due to a full channel")
+		}
+	}
 }
 
 // UnsubFunc is a cancel function for pubsub subscription
@@ -143,16 +276,21 @@ func (c *Client) Sub(ch chan drand.PublicRandResponse) UnsubFunc {
 	c.subs.Unlock()
 	return func() {
 		c.subs.Lock()
-		delete(c.subs.M, id)
-		close(ch)
-		c.subs.Unlock()
+		defer c.subs.Unlock()
+		// the channel is already closed if the client was closed
+		if _, ok := c.subs.M[id]; ok {
+			delete(c.subs.M, id)
+			close(ch)
+		}
 	}
 }
 
 // Watch implements the client.Watcher interface
 func (c *Client) Watch(ctx context.Context) <-chan client.Result {
-	innerCh := make(chan drand.PublicRandResponse)
-	outerCh := make(chan client.Result)
+	// the channels are buffered to hold the rounds fetched at once when the
+	// client backfills the rounds it missed
+	innerCh := make(chan drand.PublicRandResponse, lp2p.MaxHistoryRange)
+	outerCh := make(chan client.Result, lp2p.MaxHistoryRange)
 	end := c.Sub(innerCh)
 
 	go func() {
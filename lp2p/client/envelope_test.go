@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	dssync "github.com/ipfs/go-datastore/sync"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/log"
+	"github.com/drand/drand/lp2p"
+)
+
+func TestClientEnvelopesOnly(t *testing.T) {
+	td, err := os.MkdirTemp(os.TempDir(), "test-gossip-envelope")
+	require.NoError(t, err)
+	defer os.RemoveAll(td)
+
+	sc := newSignedChain(3*time.Second, 100)
+	chainHash := hex.EncodeToString(sc.info.Hash())
+	g, err := lp2p.NewGossipRelayNode(log.DefaultLogger(), &lp2p.GossipRelayConfig{
+		Addr:               "/ip4/127.0.0.1/tcp/0",
+		DataDir:            path.Join(td, "datastore"),
+		IdentityPath:       path.Join(td, "identity.key"),
+		DisableLegacyTopic: true,
+		SignEnvelopes:      true,
+	})
+	require.NoError(t, err)
+	defer g.Shutdown()
+	require.NoError(t, g.AddChain(chainHash, sc))
+
+	priv, _, err := crypto.GenerateEd25519Key(nil)
+	require.NoError(t, err)
+	h, ps, err := lp2p.ConstructHost(dssync.MutexWrap(datastore.NewMapDatastore()), priv, "/ip4/127.0.0.1/tcp/0",
+		g.Multiaddrs(), log.DefaultLogger())
+	require.NoError(t, err)
+	defer h.Close()
+
+	c, err := NewWithPubsub(ps, sc.info, nil, WithoutLegacyTopic())
+	require.NoError(t, err)
+	defer c.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := c.Watch(ctx)
+
+	require.Eventually(t, func() bool {
+		return len(g.PubSub().ListPeers(lp2p.PubSubEnvelopeTopic(chainHash))) > 0
+	}, 10*time.Second, 50*time.Millisecond)
+	require.Equal(t, []string{lp2p.PubSubEnvelopeTopic(chainHash)}, ps.GetTopics())
+
+	current := chain.CurrentRound(time.Now().Unix(), sc.info.Period, sc.info.GenesisTime)
+	sc.watch <- sc.round(t, current-1)
+	expectRound(t, ch, current-1)
+}
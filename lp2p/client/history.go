@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/lp2p"
+	"github.com/drand/drand/protobuf/drand"
+)
+
+// catchUpPoll is the interval at which the client checks for peers to fetch
+// the current round from on startup.
+const catchUpPoll = 500 * time.Millisecond
+
+// WithHistory makes the client fetch the rounds it missed from its peers over
+// the history protocol of host h, which must be the host of the pubsub
+// instance: the current round on startup, and the rounds missing when it
+// receives a round more recent than the next one, e.g. after a partition.
+func WithHistory(h host.Host) Option {
+	return func(c *Client) {
+		c.h = h
+	}
+}
+
+// historyPeers returns the peers to fetch rounds from: the peers subscribed to
+// the topic first, and then the other peers connected, since relays publishing
+// on the topic aren't subscribed to it.
+func (c *Client) historyPeers(t *pubsub.Topic) []peer.ID {
+	peers := t.ListPeers()
+	seen := make(map[peer.ID]bool, len(peers))
+	for _, p := range peers {
+		seen[p] = true
+	}
+	for _, p := range c.h.Network().Peers() {
+		if !seen[p] {
+			peers = append(peers, p)
+		}
+	}
+	return peers
+}
+
+// catchUp fetches the current round from the peers, so that
+// it is delivered without waiting for the next round to be published.
+func (c *Client) catchUp(ctx context.Context, t *pubsub.Topic) {
+	deadline := time.NewTimer(c.info.Period)
+	defer deadline.Stop()
+	ticker := time.NewTicker(catchUpPoll)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-deadline.C:
+			return
+		case <-ctx.Done():
+			return
+		}
+
+		if len(c.historyPeers(t)) == 0 {
+			continue
+		}
+		round := chain.CurrentRoundAt(time.Now(), c.info.Period, c.info.GenesisTime)
+		if rounds := c.backfill(ctx, t, round, round); len(rounds) > 0 {
+			c.deliver(ctx, t, rounds[0])
+			return
+		}
+	}
+}
+
+// backfill fetches the rounds from..to from the peers of the topic, or the
+// last MaxHistoryRange of them, and returns the ones it could fetch and
+// verify, in order, up to the first missing one.
+func (c *Client) backfill(ctx context.Context, t *pubsub.Topic, from, to uint64) []*drand.PublicRandResponse {
+	if to-from >= lp2p.MaxHistoryRange {
+		from = to - lp2p.MaxHistoryRange + 1
+	}
+
+	var rounds []*drand.PublicRandResponse
+	next := from
+	for _, p := range c.historyPeers(t) {
+		fetched, err := lp2p.FetchHistory(ctx, c.h, p, c.info.Hash(), next, to)
+		if err != nil {
+			c.log.Debugw("", "gossip client", "could not fetch history", "peer", p, "err", err)
+		}
+		bs := make([]chain.Beacon, len(fetched))
+		for i, rand := range fetched {
+			bs[i] = chain.Beacon{
+				Round:       rand.GetRound(),
+				Signature:   rand.GetSignature(),
+				PreviousSig: rand.GetPreviousSignature(),
+			}
+		}
+		for i, err := range c.verifier.VerifyBatch(bs) {
+			if err != nil {
+				c.log.Warnw("", "gossip client", "invalid round in history", "peer", p, "round", bs[i].Round, "err", err)
+				break
+			}
+			rounds = append(rounds, fetched[i])
+			next++
+		}
+		if next > to {
+			break
+		}
+	}
+
+	if next <= to {
+		c.log.Warnw("", "gossip client", "could not fetch all the missed rounds", "from", next, "to", to)
+	}
+	return rounds
+}
@@ -0,0 +1,172 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	dssync "github.com/ipfs/go-datastore/sync"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/client"
+	"github.com/drand/drand/common/scheme"
+	"github.com/drand/drand/key"
+	"github.com/drand/drand/log"
+	"github.com/drand/drand/lp2p"
+	"github.com/drand/kyber"
+	"github.com/drand/kyber/share"
+	"github.com/drand/kyber/sign/tbls"
+	"github.com/drand/kyber/util/random"
+)
+
+// signedChain signs the rounds of a fake chain on demand.
+type signedChain struct {
+	info   *chain.Info
+	secret kyber.Scalar
+	// available is the last round served.
+	available uint64
+	watch     chan client.Result
+}
+
+func newSignedChain(period time.Duration, rounds int64) *signedChain {
+	secret := key.KeyGroup.Scalar().Pick(random.New())
+	return &signedChain{
+		info: &chain.Info{
+			PublicKey:   key.KeyGroup.Point().Mul(secret, nil),
+			Period:      period,
+			GenesisTime: time.Now().Unix() - rounds*int64(period.Seconds()),
+			Scheme:      scheme.GetSchemeFromEnv(),
+		},
+		secret: secret,
+		watch:  make(chan client.Result, 10),
+	}
+}
+
+func (s *signedChain) round(t *testing.T, round uint64) *client.RandomData {
+	t.Helper()
+	prev := []byte(hex.EncodeToString(chain.RoundToBytes(round - 1)))
+	msg := chain.NewVerifier(s.info.Scheme).DigestMessage(round, prev)
+	sig, err := key.Scheme.Sign(&share.PriShare{I: 0, V: s.secret}, msg)
+	require.NoError(t, err)
+	sigShare := tbls.SigShare(sig)
+	value := sigShare.Value()
+	return &client.RandomData{
+		Rnd:               round,
+		Sig:               value,
+		PreviousSignature: prev,
+		Random:            chain.RandomnessFromSignature(value),
+	}
+}
+
+func (s *signedChain) Watch(context.Context) <-chan client.Result {
+	return s.watch
+}
+
+func (s *signedChain) Info(context.Context) (*chain.Info, error) {
+	return s.info, nil
+}
+
+type testingHistory struct {
+	*signedChain
+	t *testing.T
+}
+
+func (h *testingHistory) Get(_ context.Context, round uint64) (client.Result, error) {
+	if round > h.available {
+		return nil, errors.New("not available")
+	}
+	return h.round(h.t, round), nil
+}
+
+// newHistoryTest starts a relay publishing the given chain, and a gossip client
+// fetching the rounds it misses from it, whose rounds are delivered on the
+// returned channel.
+func newHistoryTest(t *testing.T, sc *signedChain) <-chan client.Result {
+	t.Helper()
+	td, err := os.MkdirTemp(os.TempDir(), "test-gossip-history")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(td) })
+	chainHash := hex.EncodeToString(sc.info.Hash())
+
+	g, err := lp2p.NewGossipRelayNode(log.DefaultLogger(), &lp2p.GossipRelayConfig{
+		Addr:         "/ip4/127.0.0.1/tcp/0",
+		DataDir:      path.Join(td, "datastore"),
+		IdentityPath: path.Join(td, "identity.key"),
+	})
+	require.NoError(t, err)
+	t.Cleanup(g.Shutdown)
+	require.NoError(t, g.AddChain(chainHash, &testingHistory{signedChain: sc, t: t}))
+
+	priv, _, err := crypto.GenerateEd25519Key(nil)
+	require.NoError(t, err)
+	h, ps, err := lp2p.ConstructHost(dssync.MutexWrap(datastore.NewMapDatastore()), priv, "/ip4/127.0.0.1/tcp/0",
+		g.Multiaddrs(), log.DefaultLogger())
+	require.NoError(t, err)
+	t.Cleanup(func() { h.Close() })
+
+	c, err := NewWithPubsub(ps, sc.info, nil, WithHistory(h))
+	require.NoError(t, err)
+	t.Cleanup(func() { c.Close() })
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	ch := c.Watch(ctx)
+
+	topic := lp2p.PubSubTopic(chainHash)
+	require.Eventually(t, func() bool {
+		return len(g.PubSub().ListPeers(topic)) > 0
+	}, 10*time.Second, 50*time.Millisecond)
+	return ch
+}
+
+func expectRound(t *testing.T, ch <-chan client.Result, expected uint64) {
+	t.Helper()
+	select {
+	case r := <-ch:
+		require.Equal(t, expected, r.Round())
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting for round", expected)
+	}
+}
+
+func TestClientBackfillsMissedRounds(t *testing.T) {
+	sc := newSignedChain(3*time.Second, 100)
+	current := chain.CurrentRound(time.Now().Unix(), sc.info.Period, sc.info.GenesisTime)
+	// the current round isn't available, so that it isn't fetched on startup
+	sc.available = current - 5
+	ch := newHistoryTest(t, sc)
+
+	// the relay publishes a round and then a later one: the rounds in between
+	// are fetched from the relay
+	sc.watch <- sc.round(t, current-8)
+	expectRound(t, ch, current-8)
+	sc.watch <- sc.round(t, current-5)
+	// a round received while the missed ones are fetched is delivered after
+	// them
+	sc.watch <- sc.round(t, current-4)
+	for _, expected := range []uint64{current - 7, current - 6, current - 5, current - 4} {
+		expectRound(t, ch, expected)
+	}
+}
+
+func TestClientCatchesUpOnStartup(t *testing.T) {
+	sc := newSignedChain(3*time.Second, 100)
+	current := chain.CurrentRound(time.Now().Unix(), sc.info.Period, sc.info.GenesisTime)
+	sc.available = current + 1
+	ch := newHistoryTest(t, sc)
+
+	// the current round is fetched without being published
+	select {
+	case r := <-ch:
+		// the next round might have started in the meantime
+		require.Contains(t, []uint64{current, current + 1}, r.Round())
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting for the current round")
+	}
+}
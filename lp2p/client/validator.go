@@ -11,64 +11,108 @@ import (
 
 	"github.com/drand/drand/chain"
 	"github.com/drand/drand/client"
+	"github.com/drand/drand/lp2p"
+	"github.com/drand/drand/metrics"
 	"github.com/drand/drand/protobuf/drand"
 )
 
+// reject rejects a message, which penalizes the peer that delivered it.
+func reject(reason string) pubsub.ValidationResult {
+	metrics.GossipRejectedMessages.WithLabelValues(reason).Inc()
+	return pubsub.ValidationReject
+}
+
 func randomnessValidator(info *chain.Info, cache client.Cache, c *Client) pubsub.ValidatorEx {
+	c.setupVerifier(info)
 	return func(ctx context.Context, p peer.ID, m *pubsub.Message) pubsub.ValidationResult {
 		var rand drand.PublicRandResponse
 		err := proto.Unmarshal(m.Data, &rand)
 		if err != nil {
-			return pubsub.ValidationReject
+			return reject("malformed")
 		}
+		return validateRandomness(ctx, info, cache, c, &rand)
+	}
+}
 
-		if info == nil {
-			c.log.Warnw("", "gossip validator", "Not validating received randomness due to lack of trust root.")
-			return pubsub.ValidationAccept
+// envelopeValidator validates the envelopes of the versioned topic: they must
+// be of the chain of the client, signed by the relay if they carry a relay
+// signature, and carry valid randomness.
+func envelopeValidator(info *chain.Info, cache client.Cache, c *Client) pubsub.ValidatorEx {
+	c.setupVerifier(info)
+	return func(ctx context.Context, p peer.ID, m *pubsub.Message) pubsub.ValidationResult {
+		var env drand.GossipEnvelope
+		if err := proto.Unmarshal(m.Data, &env); err != nil {
+			return reject("malformed")
 		}
-
-		b := chain.Beacon{
-			Round:       rand.GetRound(),
-			Signature:   rand.GetSignature(),
-			PreviousSig: rand.GetPreviousSignature(),
+		if info != nil {
+			if err := lp2p.VerifyEnvelope(&env, info.Hash(), info.Scheme.ID); err != nil {
+				c.log.Debugw("", "gossip validator", "invalid envelope", "peer", p, "err", err)
+				return reject("invalid_envelope")
+			}
 		}
+		return validateRandomness(ctx, info, cache, c, env.GetBeacon())
+	}
+}
 
-		// Unwilling to relay beacons in the future.
-		if time.Unix(chain.TimeOfRound(info.Period, info.GenesisTime, b.Round), 0).After(time.Now()) {
-			return pubsub.ValidationReject
-		}
+// setupVerifier sets up the verifier shared by the validators of the topics.
+func (c *Client) setupVerifier(info *chain.Info) {
+	if c.verifier == nil && info != nil {
+		c.verifier = newBeaconVerifier(info)
+	}
+}
 
-		if cache != nil {
-			if current := cache.TryGet(rand.GetRound()); current != nil {
-				currentFull, ok := current.(*client.RandomData)
-				if !ok {
-					// Note: this shouldn't happen in practice, but if we have a
-					// degraded cache entry we can't validate the full byte
-					// sequence.
-					if bytes.Equal(b.Signature, current.Signature()) {
-						return pubsub.ValidationIgnore
-					}
-					return pubsub.ValidationReject
-				}
-				curB := chain.Beacon{
-					Round:       current.Round(),
-					Signature:   current.Signature(),
-					PreviousSig: currentFull.PreviousSignature,
-				}
-				if b.Equal(&curB) {
+// validateRandomness validates the randomness carried by the messages of both
+// topics.
+func validateRandomness(ctx context.Context, info *chain.Info, cache client.Cache, c *Client,
+	rand *drand.PublicRandResponse) pubsub.ValidationResult {
+	if info == nil {
+		c.log.Warnw("", "gossip validator", "Not validating received randomness due to lack of trust root.")
+		return pubsub.ValidationAccept
+	}
+	if rand == nil {
+		return reject("malformed")
+	}
+
+	b := chain.Beacon{
+		Round:       rand.GetRound(),
+		Signature:   rand.GetSignature(),
+		PreviousSig: rand.GetPreviousSignature(),
+	}
+
+	// Unwilling to relay beacons in the future.
+	if chain.RoundTime(info.Period, info.GenesisTime, b.Round).After(time.Now()) {
+		return reject("future_round")
+	}
+
+	if cache != nil {
+		if current := cache.TryGet(rand.GetRound()); current != nil {
+			currentFull, ok := current.(*client.RandomData)
+			if !ok {
+				// Note: this shouldn't happen in practice, but if we have a
+				// degraded cache entry we can't validate the full byte
+				// sequence.
+				if bytes.Equal(b.Signature, current.Signature()) {
 					return pubsub.ValidationIgnore
 				}
-				return pubsub.ValidationReject
+				return reject("conflicting")
 			}
+			curB := chain.Beacon{
+				Round:       current.Round(),
+				Signature:   current.Signature(),
+				PreviousSig: currentFull.PreviousSignature,
+			}
+			if b.Equal(&curB) {
+				return pubsub.ValidationIgnore
+			}
+			return reject("conflicting")
 		}
+	}
 
-		verifier := chain.NewVerifier(info.Scheme)
-
-		err = verifier.VerifyBeacon(b, info.PublicKey)
-
-		if err != nil {
-			return pubsub.ValidationReject
+	if err := c.verifier.Verify(ctx, &b); err != nil {
+		if ctx.Err() != nil {
+			return pubsub.ValidationIgnore
 		}
-		return pubsub.ValidationAccept
+		return reject("invalid_signature")
 	}
+	return pubsub.ValidationAccept
 }
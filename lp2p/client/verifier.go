@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/drand/drand/chain"
+)
+
+// verifiedCacheSize is the number of verification results kept, so that the
+// copies of a round delivered by different peers are only verified once.
+const verifiedCacheSize = 1024
+
+// verifyKey identifies the content of a beacon, as verified.
+type verifyKey struct {
+	round uint64
+	sig   string
+	prev  string
+}
+
+func keyOf(b *chain.Beacon) verifyKey {
+	return verifyKey{round: b.Round, sig: string(b.Signature), prev: string(b.PreviousSig)}
+}
+
+// pendingVerification is a beacon being verified, whose result is available
+// once done is closed.
+type pendingVerification struct {
+	b    chain.Beacon
+	err  error
+	done chan struct{}
+}
+
+// beaconVerifier verifies the beacons received by a gossip client. The
+// concurrent verifications of the same beacon, as delivered by different
+// peers, are deduplicated and their results cached, and the beacons queued
+// while a batch is being verified are verified together in the next one,
+// e.g. when many rounds arrive at once after a partition heals.
+type beaconVerifier struct {
+	info     *chain.Info
+	verifier *chain.Verifier
+	results  *lru.Cache
+
+	lk       sync.Mutex
+	inflight map[verifyKey]*pendingVerification
+	queue    []*pendingVerification
+	running  bool
+}
+
+func newBeaconVerifier(info *chain.Info) *beaconVerifier {
+	// lru.New only fails with a non-positive size
+	results, _ := lru.New(verifiedCacheSize)
+	return &beaconVerifier{
+		info:     info,
+		verifier: chain.NewVerifier(info.Scheme),
+		results:  results,
+		inflight: make(map[verifyKey]*pendingVerification),
+	}
+}
+
+// Verify returns an error if the beacon does not verify, or if ctx is done
+// before it is verified.
+func (v *beaconVerifier) Verify(ctx context.Context, b *chain.Beacon) error {
+	k := keyOf(b)
+
+	v.lk.Lock()
+	if res, ok := v.results.Get(k); ok {
+		v.lk.Unlock()
+		if res == nil {
+			return nil
+		}
+		return res.(error)
+	}
+	p, ok := v.inflight[k]
+	if !ok {
+		p = &pendingVerification{b: *b, done: make(chan struct{})}
+		v.inflight[k] = p
+		v.queue = append(v.queue, p)
+		if !v.running {
+			v.running = true
+			go v.run()
+		}
+	}
+	v.lk.Unlock()
+
+	select {
+	case <-p.done:
+		return p.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run verifies the queued beacons in batches, until the queue is empty.
+func (v *beaconVerifier) run() {
+	for {
+		v.lk.Lock()
+		batch := v.queue
+		v.queue = nil
+		if len(batch) == 0 {
+			v.running = false
+			v.lk.Unlock()
+			return
+		}
+		v.lk.Unlock()
+
+		bs := make([]chain.Beacon, len(batch))
+		for i, p := range batch {
+			bs[i] = p.b
+		}
+		errs := v.VerifyBatch(bs)
+
+		v.lk.Lock()
+		for i, p := range batch {
+			p.err = errs[i]
+			delete(v.inflight, keyOf(&p.b))
+			close(p.done)
+		}
+		v.lk.Unlock()
+	}
+}
+
+// VerifyBatch verifies the beacons at once, and one by one only if the batch
+// doesn't verify, to tell the invalid ones. It returns the error of each
+// beacon, and caches the results.
+func (v *beaconVerifier) VerifyBatch(bs []chain.Beacon) []error {
+	errs := make([]error, len(bs))
+	if len(bs) == 1 || v.verifier.VerifyBeacons(bs, v.info.PublicKey) != nil {
+		for i := range bs {
+			errs[i] = v.verifier.VerifyBeacon(bs[i], v.info.PublicKey)
+		}
+	}
+	for i := range bs {
+		v.results.Add(keyOf(&bs[i]), errs[i])
+	}
+	return errs
+}
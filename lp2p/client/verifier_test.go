@@ -0,0 +1,69 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/chain"
+)
+
+func TestBeaconVerifier(t *testing.T) {
+	sc := newSignedChain(3*time.Second, 100)
+	v := newBeaconVerifier(sc.info)
+	ctx := context.Background()
+
+	var valid, invalid []chain.Beacon
+	for round := uint64(1); round <= 10; round++ {
+		rd := sc.round(t, round)
+		b := chain.Beacon{Round: round, Signature: rd.Sig, PreviousSig: rd.PreviousSignature}
+		valid = append(valid, b)
+		b.Round += 100
+		invalid = append(invalid, b)
+	}
+
+	// each beacon is verified concurrently as delivered by several peers
+	var wg sync.WaitGroup
+	for peer := 0; peer < 3; peer++ {
+		for i := range valid {
+			wg.Add(2)
+			go func(b chain.Beacon) {
+				defer wg.Done()
+				require.NoError(t, v.Verify(ctx, &b))
+			}(valid[i])
+			go func(b chain.Beacon) {
+				defer wg.Done()
+				require.Error(t, v.Verify(ctx, &b))
+			}(invalid[i])
+		}
+	}
+	wg.Wait()
+
+	// the results are cached, and nothing is left in flight
+	require.Equal(t, len(valid)+len(invalid), v.results.Len())
+	v.lk.Lock()
+	require.Empty(t, v.inflight)
+	require.Empty(t, v.queue)
+	v.lk.Unlock()
+
+	// a batch with an invalid beacon tells which one it is
+	batch := append([]chain.Beacon{}, valid...)
+	batch[4] = invalid[4]
+	for i, err := range v.VerifyBatch(batch) {
+		if i == 4 {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+		}
+	}
+
+	// verifications are abandoned with the context
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	b := valid[0]
+	b.Round = 1000
+	require.ErrorIs(t, v.Verify(cctx, &b), context.Canceled)
+}
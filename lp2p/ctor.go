@@ -47,10 +47,31 @@ func PubSubTopic(h string) string {
 	return fmt.Sprintf("/drand/pubsub/v0.0.0/%s", h)
 }
 
+// HostOption configures the host built by ConstructHost.
+type HostOption func(*hostConfig)
+
+type hostConfig struct {
+	trusted []peer.ID
+}
+
+// WithTrustedPeers makes the host trust the given peers, in addition to the
+// bootstrap peers: they are never penalized by the gossip peer scoring.
+func WithTrustedPeers(ids ...peer.ID) HostOption {
+	return func(cfg *hostConfig) {
+		cfg.trusted = append(cfg.trusted, ids...)
+	}
+}
+
 // ConstructHost build a libp2p host configured for relaying drand randomness over pubsub.
+// Gossip peers are scored, and the ones delivering messages rejected by the
+// topic validators get graylisted, unless they are trusted.
 func ConstructHost(ds datastore.Datastore, priv crypto.PrivKey, listenAddr string,
-	bootstrap []ma.Multiaddr, log dlog.Logger) (host.Host, *pubsub.PubSub, error) {
+	bootstrap []ma.Multiaddr, log dlog.Logger, opts ...HostOption) (host.Host, *pubsub.PubSub, error) {
 	ctx := context.Background()
+	cfg := &hostConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
 
 	pstoreDs := namespace.Wrap(ds, datastore.NewKey("/peerstore"))
 	pstore, err := pstoreds.NewPeerstore(ctx, pstoreDs, pstoreds.DefaultOpts())
@@ -71,12 +92,20 @@ func ConstructHost(ds datastore.Datastore, priv crypto.PrivKey, listenAddr strin
 		return nil, nil, fmt.Errorf("parsing addrInfos: %w", err)
 	}
 
+	trusted := make(map[peer.ID]bool)
+	for _, ai := range addrInfos {
+		trusted[ai.ID] = true
+	}
+	for _, id := range cfg.trusted {
+		trusted[id] = true
+	}
+
 	cmgr, err := connmgr.NewConnManager(lowWater, highWater, connmgr.WithGracePeriod(gracePeriod))
 	if err != nil {
 		return nil, nil, fmt.Errorf("constructing connmanager: %w", err)
 	}
 
-	opts := []libp2p.Option{
+	hostOpts := []libp2p.Option{
 		libp2p.Identity(priv),
 		libp2p.ChainOptions(
 			libp2p.Security(libp2ptls.ID, libp2ptls.New),
@@ -88,12 +117,12 @@ func ConstructHost(ds datastore.Datastore, priv crypto.PrivKey, listenAddr strin
 	}
 
 	if listenAddr != "" {
-		opts = append(opts, libp2p.ListenAddrStrings(listenAddr))
+		hostOpts = append(hostOpts, libp2p.ListenAddrStrings(listenAddr))
 	} else {
-		opts = append(opts, libp2p.NoListenAddrs)
+		hostOpts = append(hostOpts, libp2p.NoListenAddrs)
 	}
 
-	h, err := libp2p.New(opts...)
+	h, err := libp2p.New(hostOpts...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("constructing host: %w", err)
 	}
@@ -107,6 +136,8 @@ func ConstructHost(ds datastore.Datastore, priv crypto.PrivKey, listenAddr strin
 		pubsub.WithDirectPeers(addrInfos),
 		pubsub.WithFloodPublish(true),
 		pubsub.WithDirectConnectTicks(directConnectTicks),
+		pubsub.WithPeerScore(peerScoreParams(trusted), peerScoreThresholds()),
+		pubsub.WithPeerScoreInspect(graylistInspector(log), scoreInspectPeriod),
 	)
 	if err != nil {
 		return nil, nil, fmt.Errorf("constructing pubsub: %w", err)
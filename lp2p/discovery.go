@@ -0,0 +1,276 @@
+package lp2p
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/namespace"
+	"github.com/ipfs/go-datastore/query"
+	dht "github.com/libp2p/go-libp2p-kad-dht"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/discovery/mdns"
+	drouting "github.com/libp2p/go-libp2p/p2p/discovery/routing"
+	ma "github.com/multiformats/go-multiaddr"
+
+	dlog "github.com/drand/drand/log"
+)
+
+const (
+	// dhtProtocolPrefix keeps the drand DHT apart from the public IPFS one.
+	dhtProtocolPrefix = "/drand"
+	// mdnsServiceName is the mDNS service advertised by drand gossip hosts.
+	mdnsServiceName = "_drand-gossip._udp"
+	// peersNamespace is the datastore namespace where discovered peers are kept.
+	peersNamespace = "/discovery/peers"
+	// DefaultDiscoveryInterval is the default interval between two lookups of
+	// the peers of a topic on the DHT.
+	DefaultDiscoveryInterval = time.Minute
+	// advertiseRetry is the delay before advertising again after a failure,
+	// e.g. while the routing table is still empty.
+	advertiseRetry = 10 * time.Second
+)
+
+// DiscoveryConfig configures the discovery of the peers of gossip topics.
+type DiscoveryConfig struct {
+	// DHT enables the rendezvous of the peers of a topic on a Kademlia DHT,
+	// bootstrapped from the direct peers and the peers found previously.
+	DHT bool
+	// DHTServer runs the DHT in server mode, answering the queries of other
+	// peers and advertising the topics joined. It should only be set by
+	// publicly reachable hosts, such as relays.
+	DHTServer bool
+	// MDNS enables the discovery of the peers on the local network.
+	MDNS bool
+	// Interval is the interval between two lookups of the peers of a topic.
+	// It defaults to DefaultDiscoveryInterval.
+	Interval time.Duration
+}
+
+// Discovery finds the peers of gossip topics through a DHT and/or mDNS,
+// connects to them and persists them, so that they're used to bootstrap the
+// host on restart.
+type Discovery struct {
+	l        dlog.Logger
+	h        host.Host
+	peers    datastore.Datastore
+	dht      *dht.IpfsDHT
+	rd       *drouting.RoutingDiscovery
+	mdns     mdns.Service
+	server   bool
+	interval time.Duration
+	ctx      context.Context
+	cancel   context.CancelFunc
+
+	topicsLk sync.Mutex
+	topics   map[string]context.CancelFunc
+}
+
+// NewDiscovery starts the discovery of peers for the host h, persisting them
+// in ds. The bootstrap peers are used to join the DHT, along with the peers
+// persisted by a previous run, which the host connects to right away.
+func NewDiscovery(ds datastore.Batching, h host.Host, bootstrap []ma.Multiaddr,
+	cfg DiscoveryConfig, l dlog.Logger) (*Discovery, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	d := &Discovery{
+		l:        l,
+		h:        h,
+		peers:    namespace.Wrap(ds, datastore.NewKey(peersNamespace)),
+		server:   cfg.DHT && cfg.DHTServer,
+		interval: cfg.Interval,
+		ctx:      ctx,
+		cancel:   cancel,
+		topics:   make(map[string]context.CancelFunc),
+	}
+	if d.interval <= 0 {
+		d.interval = DefaultDiscoveryInterval
+	}
+
+	addrInfos, err := resolveAddresses(ctx, bootstrap, nil)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("parsing addrInfos: %w", err)
+	}
+	known, err := d.loadPeers()
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("loading known peers: %w", err)
+	}
+
+	if cfg.DHT {
+		mode := dht.ModeClient
+		if cfg.DHTServer {
+			mode = dht.ModeServer
+		}
+		kad, err := dht.New(ctx, h,
+			dht.Mode(mode),
+			dht.ProtocolPrefix(dhtProtocolPrefix),
+			dht.Datastore(namespace.Wrap(ds, datastore.NewKey("/dht"))),
+			dht.DisableValues(),
+			dht.BootstrapPeers(append(addrInfos, known...)...),
+		)
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("constructing dht: %w", err)
+		}
+		d.dht = kad
+		d.rd = drouting.NewRoutingDiscovery(kad)
+	}
+
+	if cfg.MDNS {
+		d.mdns = mdns.NewMdnsService(h, mdnsServiceName, d)
+		if err := d.mdns.Start(); err != nil {
+			d.Close()
+			return nil, fmt.Errorf("starting mdns: %w", err)
+		}
+	}
+
+	go func() {
+		for _, ai := range known {
+			d.connect(ai)
+		}
+		if d.dht != nil {
+			if err := d.dht.Bootstrap(ctx); err != nil {
+				l.Warnw("", "discovery", "could not bootstrap dht", "err", err)
+			}
+		}
+	}()
+
+	return d, nil
+}
+
+// Join starts looking for the peers of the given topic on the DHT, and
+// advertising the host as one of them if the DHT runs in server mode. It is a
+// no-op if the DHT isn't enabled or the topic is already joined.
+func (d *Discovery) Join(topic string) {
+	if d.rd == nil {
+		return
+	}
+
+	d.topicsLk.Lock()
+	defer d.topicsLk.Unlock()
+	if _, joined := d.topics[topic]; joined {
+		return
+	}
+	ctx, cancel := context.WithCancel(d.ctx)
+	d.topics[topic] = cancel
+	go d.discover(ctx, topic)
+}
+
+// Leave stops looking for the peers of the given topic.
+func (d *Discovery) Leave(topic string) {
+	d.topicsLk.Lock()
+	defer d.topicsLk.Unlock()
+	if cancel, joined := d.topics[topic]; joined {
+		cancel()
+		delete(d.topics, topic)
+	}
+}
+
+// Close stops the discovery.
+func (d *Discovery) Close() error {
+	d.cancel()
+	if d.mdns != nil {
+		if err := d.mdns.Close(); err != nil {
+			return err
+		}
+	}
+	if d.dht != nil {
+		return d.dht.Close()
+	}
+	return nil
+}
+
+// HandlePeerFound connects to the peers found over mDNS.
+func (d *Discovery) HandlePeerFound(ai peer.AddrInfo) {
+	go d.connect(ai)
+}
+
+func (d *Discovery) discover(ctx context.Context, topic string) {
+	var readvertise time.Time
+	for {
+		if d.server && time.Now().After(readvertise) {
+			ttl, err := d.rd.Advertise(ctx, topic)
+			if err != nil {
+				d.l.Debugw("", "discovery", "could not advertise", "topic", topic, "err", err)
+				readvertise = time.Now().Add(advertiseRetry)
+			} else {
+				readvertise = time.Now().Add(7 * ttl / 8)
+			}
+		}
+
+		peers, err := d.rd.FindPeers(ctx, topic)
+		if err != nil {
+			d.l.Debugw("", "discovery", "could not find peers", "topic", topic, "err", err)
+		} else {
+			for ai := range peers {
+				d.connect(ai)
+			}
+		}
+
+		wait := d.interval
+		if d.server && time.Until(readvertise) < wait {
+			wait = time.Until(readvertise)
+		}
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// connect connects to a peer that was discovered, if needed, and persists it.
+func (d *Discovery) connect(ai peer.AddrInfo) {
+	if ai.ID == d.h.ID() || len(ai.Addrs) == 0 {
+		return
+	}
+	if d.h.Network().Connectedness(ai.ID) != network.Connected {
+		ctx, cancel := context.WithTimeout(d.ctx, bootstrapTimeout)
+		err := d.h.Connect(ctx, ai)
+		cancel()
+		if err != nil {
+			d.l.Debugw("", "discovery", "could not connect to peer", "peer", ai.ID, "err", err)
+			// forget about peers we can't reach anymore, they'll be found again if they come back
+			_ = d.peers.Delete(d.ctx, datastore.NewKey(ai.ID.String()))
+			return
+		}
+		d.l.Infow("", "discovery", "connected to peer", "peer", ai.ID)
+	}
+
+	b, err := json.Marshal(ai)
+	if err != nil {
+		return
+	}
+	if err := d.peers.Put(d.ctx, datastore.NewKey(ai.ID.String()), b); err != nil {
+		d.l.Warnw("", "discovery", "could not persist peer", "peer", ai.ID, "err", err)
+	}
+}
+
+// loadPeers returns the peers persisted in the datastore.
+func (d *Discovery) loadPeers() ([]peer.AddrInfo, error) {
+	res, err := d.peers.Query(d.ctx, query.Query{})
+	if err != nil {
+		return nil, err
+	}
+	entries, err := res.Rest()
+	if err != nil {
+		return nil, err
+	}
+
+	peers := make([]peer.AddrInfo, 0, len(entries))
+	for _, e := range entries {
+		var ai peer.AddrInfo
+		if err := json.Unmarshal(e.Value, &ai); err != nil {
+			d.l.Warnw("", "discovery", "skipping invalid persisted peer", "key", e.Key, "err", err)
+			continue
+		}
+		peers = append(peers, ai)
+	}
+	return peers, nil
+}
@@ -0,0 +1,82 @@
+package lp2p
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	dssync "github.com/ipfs/go-datastore/sync"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/log"
+)
+
+func newDiscoveryHost(t *testing.T, bootstrap ...host.Host) (host.Host, datastore.Batching, []ma.Multiaddr) {
+	t.Helper()
+	priv, _, err := crypto.GenerateEd25519Key(nil)
+	require.NoError(t, err)
+	ds := dssync.MutexWrap(datastore.NewMapDatastore())
+
+	var addrs []ma.Multiaddr
+	for _, b := range bootstrap {
+		m, err := ma.NewMultiaddr(fmt.Sprintf("%s/p2p/%s", b.Addrs()[0], b.ID()))
+		require.NoError(t, err)
+		addrs = append(addrs, m)
+	}
+
+	h, _, err := ConstructHost(ds, priv, "/ip4/127.0.0.1/tcp/0", addrs, log.DefaultLogger())
+	require.NoError(t, err)
+	t.Cleanup(func() { h.Close() })
+	return h, ds, addrs
+}
+
+func TestDiscoveryDHT(t *testing.T) {
+	cfg := DiscoveryConfig{DHT: true, DHTServer: true, Interval: 100 * time.Millisecond}
+	topic := PubSubTopic("abcd")
+
+	boot, bootDs, _ := newDiscoveryHost(t)
+	bootDisc, err := NewDiscovery(bootDs, boot, nil, cfg, log.DefaultLogger())
+	require.NoError(t, err)
+	defer bootDisc.Close()
+
+	var hosts []host.Host
+	var stores []datastore.Batching
+	for i := 0; i < 2; i++ {
+		h, ds, addrs := newDiscoveryHost(t, boot)
+		disc, err := NewDiscovery(ds, h, addrs, cfg, log.DefaultLogger())
+		require.NoError(t, err)
+		defer disc.Close()
+		disc.Join(topic)
+		hosts = append(hosts, h)
+		stores = append(stores, ds)
+	}
+
+	// the two hosts only know about the bootstrap host, they find each other
+	// through the DHT
+	require.Eventually(t, func() bool {
+		return hosts[0].Network().Connectedness(hosts[1].ID()) == network.Connected
+	}, 20*time.Second, 100*time.Millisecond)
+
+	// the peers found through the rendezvous on the topic are persisted, to be
+	// connected to on restart
+	restarted, err := NewDiscovery(stores[1], hosts[1], nil, DiscoveryConfig{}, log.DefaultLogger())
+	require.NoError(t, err)
+	defer restarted.Close()
+	require.Eventually(t, func() bool {
+		known, err := restarted.loadPeers()
+		if err != nil {
+			return false
+		}
+		for _, ai := range known {
+			if ai.ID == hosts[0].ID() {
+				return true
+			}
+		}
+		return false
+	}, 10*time.Second, 100*time.Millisecond)
+}
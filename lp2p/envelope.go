@@ -0,0 +1,106 @@
+package lp2p
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/drand/drand/protobuf/drand"
+)
+
+// EnvelopeVersion is the version of the gossip envelopes published.
+const EnvelopeVersion = 1
+
+// PubSubEnvelopeTopic generates the versioned drand pubsub topic of a chain,
+// on which the randomness is wrapped in a drand.GossipEnvelope. The legacy
+// topic given by PubSubTopic carries bare drand.PublicRandResponse messages.
+func PubSubEnvelopeTopic(h string) string {
+	return fmt.Sprintf("/drand/pubsub/v1.0.0/%s", h)
+}
+
+// NewEnvelope wraps a round of a chain in an envelope.
+func NewEnvelope(chainHash []byte, schemeID string, beacon *drand.PublicRandResponse) *drand.GossipEnvelope {
+	return &drand.GossipEnvelope{
+		Version:   EnvelopeVersion,
+		ChainHash: chainHash,
+		SchemeId:  schemeID,
+		Beacon:    beacon,
+	}
+}
+
+// SignEnvelope signs an envelope with the libp2p key of a relay.
+func SignEnvelope(e *drand.GossipEnvelope, priv crypto.PrivKey) error {
+	id, err := peer.IDFromPrivateKey(priv)
+	if err != nil {
+		return err
+	}
+	e.RelayId = id.String()
+	e.RelaySignature, err = priv.Sign(envelopeDigest(e))
+	return err
+}
+
+// VerifyEnvelope checks that an envelope is well-formed and belongs to the
+// given chain and scheme, and verifies its relay signature if it is signed. It
+// doesn't verify the beacon.
+func VerifyEnvelope(e *drand.GossipEnvelope, chainHash []byte, schemeID string) error {
+	if e.GetVersion() != EnvelopeVersion {
+		return fmt.Errorf("unsupported envelope version %d", e.GetVersion())
+	}
+	if !bytes.Equal(e.GetChainHash(), chainHash) {
+		return errors.New("envelope of another chain")
+	}
+	if e.GetSchemeId() != schemeID {
+		return fmt.Errorf("envelope of scheme %q instead of %q", e.GetSchemeId(), schemeID)
+	}
+	if e.GetBeacon() == nil {
+		return errors.New("envelope without beacon")
+	}
+
+	if e.GetRelayId() == "" && len(e.GetRelaySignature()) == 0 {
+		return nil
+	}
+	id, err := peer.Decode(e.GetRelayId())
+	if err != nil {
+		return fmt.Errorf("invalid relay id: %w", err)
+	}
+	pub, err := id.ExtractPublicKey()
+	if err != nil {
+		return fmt.Errorf("extracting relay key: %w", err)
+	}
+	ok, err := pub.Verify(envelopeDigest(e), e.GetRelaySignature())
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("invalid relay signature")
+	}
+	return nil
+}
+
+// envelopeDigest returns the digest signed by the relays, over the fields of
+// the envelope other than the signature, each prefixed by its length.
+func envelopeDigest(e *drand.GossipEnvelope) []byte {
+	h := sha256.New()
+	write := func(b []byte) {
+		var l [8]byte
+		binary.BigEndian.PutUint64(l[:], uint64(len(b)))
+		_, _ = h.Write(l[:])
+		_, _ = h.Write(b)
+	}
+	var n [8]byte
+	binary.BigEndian.PutUint64(n[:], uint64(e.GetVersion()))
+	write(n[:])
+	write(e.GetChainHash())
+	write([]byte(e.GetSchemeId()))
+	binary.BigEndian.PutUint64(n[:], e.GetBeacon().GetRound())
+	write(n[:])
+	write(e.GetBeacon().GetSignature())
+	write(e.GetBeacon().GetPreviousSignature())
+	write([]byte(e.GetRelayId()))
+	return h.Sum(nil)
+}
@@ -0,0 +1,49 @@
+package lp2p
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/protobuf/drand"
+)
+
+func TestEnvelope(t *testing.T) {
+	hash := []byte{0xab, 0xcd}
+	scheme := "pedersen-bls-chained"
+	beacon := &drand.PublicRandResponse{Round: 3, Signature: []byte("sig3"), PreviousSignature: []byte("sig2")}
+
+	// unsigned envelopes are accepted
+	env := NewEnvelope(hash, scheme, beacon)
+	require.NoError(t, VerifyEnvelope(env, hash, scheme))
+	require.Error(t, VerifyEnvelope(env, []byte{0xff}, scheme))
+	require.Error(t, VerifyEnvelope(env, hash, "pedersen-bls-unchained"))
+
+	env.Version = EnvelopeVersion + 1
+	require.Error(t, VerifyEnvelope(env, hash, scheme))
+	require.Error(t, VerifyEnvelope(NewEnvelope(hash, scheme, nil), hash, scheme))
+
+	// signed envelopes are verified
+	priv, _, err := crypto.GenerateEd25519Key(nil)
+	require.NoError(t, err)
+	env = NewEnvelope(hash, scheme, beacon)
+	require.NoError(t, SignEnvelope(env, priv))
+	require.NotEmpty(t, env.RelayId)
+	require.NoError(t, VerifyEnvelope(env, hash, scheme))
+
+	env.Beacon = &drand.PublicRandResponse{Round: 4, Signature: []byte("sig3"), PreviousSignature: []byte("sig2")}
+	require.Error(t, VerifyEnvelope(env, hash, scheme))
+
+	other, _, err := crypto.GenerateEd25519Key(nil)
+	require.NoError(t, err)
+	env = NewEnvelope(hash, scheme, beacon)
+	require.NoError(t, SignEnvelope(env, priv))
+	forged := NewEnvelope(hash, scheme, beacon)
+	require.NoError(t, SignEnvelope(forged, other))
+	env.RelaySignature = forged.RelaySignature
+	require.Error(t, VerifyEnvelope(env, hash, scheme))
+
+	env.RelayId = "not a peer id"
+	require.Error(t, VerifyEnvelope(env, hash, scheme))
+}
@@ -0,0 +1,196 @@
+package lp2p
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/libp2p/go-msgio"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/client"
+	"github.com/drand/drand/protobuf/common"
+	"github.com/drand/drand/protobuf/drand"
+)
+
+const (
+	// HistoryProtocol is the libp2p protocol used by gossip clients to fetch
+	// the rounds they missed from their peers.
+	HistoryProtocol protocol.ID = "/drand/history/v0.0.0"
+	// MaxHistoryRange is the maximum number of rounds served for a request.
+	MaxHistoryRange = 100
+	// historyTimeout bounds the time taken to serve or fetch a request.
+	historyTimeout = 10 * time.Second
+	// maxHistoryMessageSize bounds the size of the messages of the protocol.
+	maxHistoryMessageSize = 4096
+)
+
+// HistorySource provides the past rounds of a chain, to serve them over the
+// history protocol. A client.Client is a HistorySource.
+type HistorySource interface {
+	Get(ctx context.Context, round uint64) (client.Result, error)
+}
+
+// NewStoreHistory returns a HistorySource backed by a beacon database.
+func NewStoreHistory(s chain.Store) HistorySource {
+	return &storeHistory{s: s}
+}
+
+type storeHistory struct {
+	s chain.Store
+}
+
+func (s *storeHistory) Get(_ context.Context, round uint64) (client.Result, error) {
+	b, err := s.s.Get(round)
+	if err != nil {
+		return nil, err
+	}
+	return &client.RandomData{
+		Rnd:               b.Round,
+		Random:            b.Randomness(),
+		Sig:               b.Signature,
+		PreviousSignature: b.PreviousSig,
+	}, nil
+}
+
+// historyLookup returns the source of the past rounds of the chain with the
+// given hash, or nil if the chain isn't known.
+type historyLookup func(chainHash string) HistorySource
+
+// serveHistory returns a stream handler serving the history requests with the
+// rounds found by lookup. The rounds are streamed in order, up to the first
+// missing one.
+func serveHistory(lookup historyLookup) network.StreamHandler {
+	return func(s network.Stream) {
+		defer s.Close()
+		_ = s.SetDeadline(time.Now().Add(historyTimeout))
+
+		var req drand.HistoryRequest
+		if err := readMessage(s, &req); err != nil {
+			_ = s.Reset()
+			return
+		}
+		src := lookup(hex.EncodeToString(req.GetMetadata().GetChainHash()))
+		if src == nil {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), historyTimeout)
+		defer cancel()
+
+		w := msgio.NewVarintWriter(s)
+		from, to := historyRange(req.GetFromRound(), req.GetToRound())
+		for round := from; round <= to; round++ {
+			res, err := src.Get(ctx, round)
+			if err != nil || res == nil || res.Round() != round {
+				return
+			}
+			resp := &drand.PublicRandResponse{
+				Round:      res.Round(),
+				Signature:  res.Signature(),
+				Randomness: res.Randomness(),
+			}
+			if rd, ok := res.(*client.RandomData); ok {
+				resp.PreviousSignature = rd.PreviousSignature
+			}
+			b, err := proto.Marshal(resp)
+			if err != nil {
+				return
+			}
+			if err := w.WriteMsg(b); err != nil {
+				_ = s.Reset()
+				return
+			}
+		}
+	}
+}
+
+// historyRange clamps a requested range to MaxHistoryRange rounds.
+func historyRange(from, to uint64) (uint64, uint64) {
+	if to < from {
+		to = from
+	}
+	if to-from >= MaxHistoryRange {
+		to = from + MaxHistoryRange - 1
+	}
+	return from, to
+}
+
+// FetchHistory fetches the rounds from..to of the chain with the given hash
+// from peer p, over the history protocol. At most MaxHistoryRange rounds are
+// returned, in order, and fewer if the peer doesn't have them all. The rounds
+// are not verified.
+func FetchHistory(ctx context.Context, h host.Host, p peer.ID, chainHash []byte,
+	from, to uint64) ([]*drand.PublicRandResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, historyTimeout)
+	defer cancel()
+
+	s, err := h.NewStream(ctx, p, HistoryProtocol)
+	if err != nil {
+		return nil, fmt.Errorf("opening history stream: %w", err)
+	}
+	defer s.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = s.SetDeadline(deadline)
+	}
+
+	req := &drand.HistoryRequest{
+		FromRound: from,
+		ToRound:   to,
+		Metadata:  &common.Metadata{ChainHash: chainHash},
+	}
+	b, err := proto.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := msgio.NewVarintWriter(s).WriteMsg(b); err != nil {
+		_ = s.Reset()
+		return nil, fmt.Errorf("sending history request: %w", err)
+	}
+	if err := s.CloseWrite(); err != nil {
+		return nil, err
+	}
+
+	from, to = historyRange(from, to)
+	var rounds []*drand.PublicRandResponse
+	r := msgio.NewVarintReaderSize(s, maxHistoryMessageSize)
+	for {
+		var resp drand.PublicRandResponse
+		b, err := r.ReadMsg()
+		if errors.Is(err, io.EOF) {
+			return rounds, nil
+		}
+		if err != nil {
+			return rounds, fmt.Errorf("reading history response: %w", err)
+		}
+		err = proto.Unmarshal(b, &resp)
+		r.ReleaseMsg(b)
+		if err != nil {
+			return rounds, fmt.Errorf("decoding history response: %w", err)
+		}
+		expected := from + uint64(len(rounds))
+		if resp.GetRound() != expected || expected > to {
+			return rounds, fmt.Errorf("unexpected round %d in history response", resp.GetRound())
+		}
+		rounds = append(rounds, &resp)
+	}
+}
+
+// readMessage reads a single varint-delimited protobuf message.
+func readMessage(r io.Reader, msg proto.Message) error {
+	mr := msgio.NewVarintReaderSize(r, maxHistoryMessageSize)
+	b, err := mr.ReadMsg()
+	if err != nil {
+		return err
+	}
+	defer mr.ReleaseMsg(b)
+	return proto.Unmarshal(b, msg)
+}
@@ -0,0 +1,97 @@
+package lp2p
+
+import (
+	"context"
+	"encoding/hex"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	dssync "github.com/ipfs/go-datastore/sync"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/chain/boltdb"
+	"github.com/drand/drand/client"
+	"github.com/drand/drand/log"
+)
+
+func TestHistoryProtocol(t *testing.T) {
+	td := tmpDir(t)
+	defer func() {
+		_ = os.RemoveAll(td)
+	}()
+	gr, err := NewGossipRelayNode(log.DefaultLogger(), &GossipRelayConfig{
+		Addr:         "/ip4/127.0.0.1/tcp/0",
+		DataDir:      path.Join(td, "datastore"),
+		IdentityPath: path.Join(td, "identity.key"),
+	})
+	require.NoError(t, err)
+	defer gr.Shutdown()
+
+	// the relay publishes round 200, and has rounds 1 to 150 in its database
+	published := make(chan struct{})
+	chainHash := "abcd"
+	require.NoError(t, gr.AddChain(chainHash, &mockClient{watchF: func(ctx context.Context) <-chan client.Result {
+		ch := make(chan client.Result, 1)
+		ch <- &client.RandomData{Rnd: 200, Sig: []byte("sig200"), PreviousSignature: []byte("sig199")}
+		go func() {
+			<-ctx.Done()
+			close(ch)
+		}()
+		close(published)
+		return ch
+	}}))
+	<-published
+
+	store, err := boltdb.NewBoltStore(td, nil)
+	require.NoError(t, err)
+	defer store.Close()
+	for i := uint64(1); i <= 150; i++ {
+		require.NoError(t, store.Put(&chain.Beacon{Round: i, Signature: []byte{byte(i)}, PreviousSig: []byte{byte(i - 1)}}))
+	}
+	require.NoError(t, gr.SetHistorySource(chainHash, NewStoreHistory(store)))
+	require.Error(t, gr.SetHistorySource("ffff", NewStoreHistory(store)))
+
+	priv, _, err := crypto.GenerateEd25519Key(nil)
+	require.NoError(t, err)
+	h, _, err := ConstructHost(dssync.MutexWrap(datastore.NewMapDatastore()), priv, "/ip4/127.0.0.1/tcp/0",
+		gr.Multiaddrs(), log.DefaultLogger())
+	require.NoError(t, err)
+	defer h.Close()
+	ctx := context.Background()
+	require.NoError(t, h.Connect(ctx, peer.AddrInfo{ID: gr.Host().ID(), Addrs: gr.Host().Addrs()}))
+
+	hash, _ := hex.DecodeString(chainHash)
+	fetch := func(from, to uint64) []uint64 {
+		rounds, err := FetchHistory(ctx, h, gr.Host().ID(), hash, from, to)
+		require.NoError(t, err)
+		var res []uint64
+		for _, r := range rounds {
+			res = append(res, r.GetRound())
+		}
+		return res
+	}
+
+	// a single round, from the database
+	require.Equal(t, []uint64{10}, fetch(10, 0))
+	// the ranges are clamped
+	rounds := fetch(1, 1000)
+	require.Len(t, rounds, MaxHistoryRange)
+	require.Equal(t, uint64(MaxHistoryRange), rounds[MaxHistoryRange-1])
+	// the rounds are streamed up to the first missing one
+	require.Equal(t, []uint64{149, 150}, fetch(149, 160))
+	// the published rounds are served from the cache
+	require.Eventually(t, func() bool {
+		return len(fetch(200, 200)) == 1
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// unknown chain
+	unknown, err := FetchHistory(ctx, h, gr.Host().ID(), []byte{0xff}, 1, 10)
+	require.NoError(t, err)
+	require.Empty(t, unknown)
+}
@@ -2,16 +2,21 @@ package lp2p
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"sync"
 	"time"
 
+	lru "github.com/hashicorp/golang-lru"
 	bds "github.com/ipfs/go-ds-badger2"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
 	ma "github.com/multiformats/go-multiaddr"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/drand/drand/chain"
 	"github.com/drand/drand/client"
 	"github.com/drand/drand/log"
 	"github.com/drand/drand/protobuf/drand"
@@ -19,7 +24,8 @@ import (
 
 // GossipRelayConfig configures a gossip relay node.
 type GossipRelayConfig struct {
-	// ChainHash is a hash that uniquely identifies the drand chain.
+	// ChainHash is a hash that uniquely identifies the drand chain relayed
+	// from Client. It is ignored if Client is nil.
 	ChainHash    string
 	PeerWith     []string
 	Addr         string
@@ -27,10 +33,28 @@ type GossipRelayConfig struct {
 	IdentityPath string
 	CertPath     string
 	Insecure     bool
-	Client       client.Client
+	// TrustedPeers are the IDs of the peers never penalized by the gossip
+	// peer scoring, in addition to the PeerWith peers.
+	TrustedPeers []string
+	// Discovery configures the discovery of the peers of the relayed chains,
+	// in addition to the PeerWith peers. The DHT runs in server mode if it is
+	// enabled.
+	Discovery DiscoveryConfig
+	// DisableLegacyTopic stops publishing the bare randomness on the legacy
+	// topic of the chains, so that it is only published in envelopes on the
+	// versioned topic. Both are published by default during the transition.
+	DisableLegacyTopic bool
+	// SignEnvelopes makes the relay sign the envelopes it publishes with its
+	// libp2p key.
+	SignEnvelopes bool
+	// Client supplies the randomness of the chain identified by ChainHash. It
+	// can be nil, in which case chains are only relayed once added with
+	// AddChain.
+	Client client.Client
 }
 
-// GossipRelayNode is a gossip relay runtime.
+// GossipRelayNode is a gossip relay runtime, which can relay several chains,
+// each on its own topic.
 type GossipRelayNode struct {
 	l         log.Logger
 	bootstrap []ma.Multiaddr
@@ -38,9 +62,46 @@ type GossipRelayNode struct {
 	priv      crypto.PrivKey
 	h         host.Host
 	ps        *pubsub.PubSub
-	t         *pubsub.Topic
 	addrs     []ma.Multiaddr
-	done      chan struct{}
+	disc      *Discovery
+	legacy    bool
+	sign      bool
+	ctx       context.Context
+	cancel    context.CancelFunc
+
+	chainsLk sync.Mutex
+	chains   map[string]*relayedChain
+}
+
+type relayedChain struct {
+	hash []byte
+	// t is the legacy topic of the chain, nil if it is disabled.
+	t *pubsub.Topic
+	// et is the topic of the chain carrying envelopes.
+	et     *pubsub.Topic
+	cancel context.CancelFunc
+	done   chan struct{}
+	// cache holds the last rounds published, served over the history protocol.
+	cache *lru.ARCCache
+	// history is the source of the rounds served that aren't in the cache. It
+	// can be nil.
+	history HistorySource
+}
+
+// historyCacheSize is the number of rounds kept in memory for each chain, to
+// serve them over the history protocol.
+const historyCacheSize = 1024
+
+// Get returns a round of the relayed chain, from the cache or else from the
+// history source of the chain.
+func (rc *relayedChain) Get(ctx context.Context, round uint64) (client.Result, error) {
+	if res, ok := rc.cache.Get(round); ok {
+		return res.(client.Result), nil
+	}
+	if rc.history == nil {
+		return nil, fmt.Errorf("round %d not found", round)
+	}
+	return rc.history.Get(ctx, round)
 }
 
 // NewGossipRelayNode starts a new gossip relay node.
@@ -60,7 +121,15 @@ func NewGossipRelayNode(l log.Logger, cfg *GossipRelayConfig) (*GossipRelayNode,
 		return nil, fmt.Errorf("loading p2p key: %w", err)
 	}
 
-	h, ps, err := ConstructHost(ds, priv, cfg.Addr, bootstrap, l)
+	trusted := make([]peer.ID, len(cfg.TrustedPeers))
+	for i, id := range cfg.TrustedPeers {
+		trusted[i], err = peer.Decode(id)
+		if err != nil {
+			return nil, fmt.Errorf("parsing trusted peer %q: %w", id, err)
+		}
+	}
+
+	h, ps, err := ConstructHost(ds, priv, cfg.Addr, bootstrap, l, WithTrustedPeers(trusted...))
 	if err != nil {
 		return nil, fmt.Errorf("constructing host: %w", err)
 	}
@@ -74,11 +143,17 @@ func NewGossipRelayNode(l log.Logger, cfg *GossipRelayConfig) (*GossipRelayNode,
 		l.Infow("", "relay_node", "has addr", "addr", fmt.Sprintf("%s/p2p/%s", a, h.ID()))
 	}
 
-	t, err := ps.Join(PubSubTopic(cfg.ChainHash))
-	if err != nil {
-		return nil, fmt.Errorf("joining topic: %w", err)
+	var disc *Discovery
+	if cfg.Discovery.DHT || cfg.Discovery.MDNS {
+		dcfg := cfg.Discovery
+		dcfg.DHTServer = true
+		disc, err = NewDiscovery(ds, h, bootstrap, dcfg, l)
+		if err != nil {
+			return nil, fmt.Errorf("starting discovery: %w", err)
+		}
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
 	g := &GossipRelayNode{
 		l:         l,
 		bootstrap: bootstrap,
@@ -86,19 +161,167 @@ func NewGossipRelayNode(l log.Logger, cfg *GossipRelayConfig) (*GossipRelayNode,
 		priv:      priv,
 		h:         h,
 		ps:        ps,
-		t:         t,
 		addrs:     addrs,
-		done:      make(chan struct{}),
+		disc:      disc,
+		legacy:    !cfg.DisableLegacyTopic,
+		sign:      cfg.SignEnvelopes,
+		ctx:       ctx,
+		cancel:    cancel,
+		chains:    make(map[string]*relayedChain),
 	}
+	h.SetStreamHandler(HistoryProtocol, serveHistory(g.lookupHistory))
 
-	if cfg.Client == nil {
-		return nil, fmt.Errorf("no client supplying randomness supplied")
+	if cfg.Client != nil {
+		if err := g.AddChain(cfg.ChainHash, cfg.Client); err != nil {
+			cancel()
+			return nil, err
+		}
 	}
-	go g.background(cfg.Client)
 
 	return g, nil
 }
 
+// AddChain starts publishing the randomness of the chain with the given hash,
+// as supplied by w, on the topics of the chain: in envelopes on the versioned
+// topic, provided w also gives the chain info, e.g. a client.Client, and bare
+// on the legacy topic unless it is disabled. The last rounds published are
+// served over the history protocol, as well as the older ones if w is also a
+// HistorySource, e.g. a client.Client.
+func (g *GossipRelayNode) AddChain(chainHash string, w client.Watcher) error {
+	g.chainsLk.Lock()
+	defer g.chainsLk.Unlock()
+
+	if _, exists := g.chains[chainHash]; exists {
+		return fmt.Errorf("chain %s is already relayed", chainHash)
+	}
+
+	hash, err := hex.DecodeString(chainHash)
+	if err != nil {
+		return fmt.Errorf("invalid chain hash %s: %w", chainHash, err)
+	}
+	cache, err := lru.NewARC(historyCacheSize)
+	if err != nil {
+		return err
+	}
+
+	rc := &relayedChain{hash: hash, done: make(chan struct{}), cache: cache}
+	rc.et, err = g.join(PubSubEnvelopeTopic(chainHash))
+	if err != nil {
+		return err
+	}
+	if g.legacy {
+		rc.t, err = g.join(PubSubTopic(chainHash))
+		if err != nil {
+			_ = rc.et.Close()
+			return err
+		}
+	}
+	ctx, cancel := context.WithCancel(g.ctx)
+	rc.cancel = cancel
+	if src, ok := w.(HistorySource); ok {
+		rc.history = src
+	}
+	g.chains[chainHash] = rc
+	g.Discover(chainHash)
+
+	go func() {
+		defer close(rc.done)
+		g.background(ctx, rc, w)
+	}()
+
+	g.l.Infow("", "relay_node", "relaying chain", "chain_hash", chainHash)
+	return nil
+}
+
+// RemoveChain stops publishing the randomness of the chain with the given hash.
+func (g *GossipRelayNode) RemoveChain(chainHash string) error {
+	g.chainsLk.Lock()
+	rc, exists := g.chains[chainHash]
+	delete(g.chains, chainHash)
+	g.chainsLk.Unlock()
+
+	if !exists {
+		return fmt.Errorf("chain %s is not relayed", chainHash)
+	}
+
+	if g.disc != nil {
+		g.disc.Leave(PubSubTopic(chainHash))
+	}
+	rc.cancel()
+	<-rc.done
+	return rc.close()
+}
+
+// join joins a topic to publish on it.
+func (g *GossipRelayNode) join(topic string) (*pubsub.Topic, error) {
+	t, err := g.ps.Join(topic)
+	if err != nil {
+		return nil, fmt.Errorf("joining topic %s: %w", topic, err)
+	}
+	if err := t.SetScoreParams(TopicScoreParams()); err != nil {
+		g.l.Warnw("", "relay_node", "could not set topic score params", "topic", topic, "err", err)
+	}
+	return t, nil
+}
+
+func (rc *relayedChain) close() error {
+	err := rc.et.Close()
+	if rc.t != nil {
+		if lerr := rc.t.Close(); err == nil {
+			err = lerr
+		}
+	}
+	return err
+}
+
+// SetHistorySource sets the source of the past rounds of a relayed chain
+// served over the history protocol, e.g. NewStoreHistory with the database of
+// a drand node.
+func (g *GossipRelayNode) SetHistorySource(chainHash string, src HistorySource) error {
+	g.chainsLk.Lock()
+	defer g.chainsLk.Unlock()
+
+	rc, exists := g.chains[chainHash]
+	if !exists {
+		return fmt.Errorf("chain %s is not relayed", chainHash)
+	}
+	rc.history = src
+	return nil
+}
+
+func (g *GossipRelayNode) lookupHistory(chainHash string) HistorySource {
+	g.chainsLk.Lock()
+	defer g.chainsLk.Unlock()
+
+	rc, exists := g.chains[chainHash]
+	if !exists {
+		return nil
+	}
+	// copy the chain, since its history source can be changed concurrently
+	return &relayedChain{cache: rc.cache, history: rc.history}
+}
+
+// Discover looks for the peers of the topic of the chain with the given hash,
+// and advertises the node as one of them, if discovery is enabled. AddChain
+// calls it for the chains published by the node, it is also needed for the
+// chains only subscribed to through PubSub.
+func (g *GossipRelayNode) Discover(chainHash string) {
+	if g.disc != nil {
+		g.disc.Join(PubSubTopic(chainHash))
+	}
+}
+
+// Host returns the libp2p host of the relay node.
+func (g *GossipRelayNode) Host() host.Host {
+	return g.h
+}
+
+// PubSub returns the pubsub instance of the relay node, e.g. to subscribe to
+// the topics of chains that are not relayed by this node.
+func (g *GossipRelayNode) PubSub() *pubsub.PubSub {
+	return g.ps
+}
+
 // Multiaddrs returns the gossipsub multiaddresses of this relay node.
 func (g *GossipRelayNode) Multiaddrs() []ma.Multiaddr {
 	base := g.h.Addrs()
@@ -115,7 +338,12 @@ func (g *GossipRelayNode) Multiaddrs() []ma.Multiaddr {
 
 // Shutdown stops the relay node.
 func (g *GossipRelayNode) Shutdown() {
-	close(g.done)
+	g.cancel()
+	if g.disc != nil {
+		if err := g.disc.Close(); err != nil {
+			g.l.Warnw("", "relay_node", "error stopping discovery", "err", err)
+		}
+	}
 }
 
 // ParseMultiaddrSlice parses a list of addresses into multiaddrs
@@ -131,9 +359,28 @@ func ParseMultiaddrSlice(peers []string) ([]ma.Multiaddr, error) {
 	return out, nil
 }
 
-func (g *GossipRelayNode) background(w client.Watcher) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+// chainScheme returns the ID of the scheme of the chain supplied by w, or an
+// empty string if w doesn't give the chain info.
+func (g *GossipRelayNode) chainScheme(ctx context.Context, w client.Watcher) string {
+	ic, ok := w.(interface {
+		Info(context.Context) (*chain.Info, error)
+	})
+	if !ok {
+		return ""
+	}
+	info, err := ic.Info(ctx)
+	if err != nil || info == nil {
+		g.l.Warnw("", "relay_node", "could not get chain info", "err", err)
+		return ""
+	}
+	return info.Scheme.ID
+}
+
+func (g *GossipRelayNode) background(ctx context.Context, rc *relayedChain, w client.Watcher) {
+	// the scheme of the chain is resolved with the first round it is known for,
+	// the envelopes aren't published until then
+	var schemeID string
+
 	for {
 		results := w.Watch(ctx)
 	LOOP:
@@ -151,28 +398,55 @@ func (g *GossipRelayNode) background(w client.Watcher) {
 					continue
 				}
 
-				randB, err := proto.Marshal(&drand.PublicRandResponse{
+				rand := &drand.PublicRandResponse{
 					Round:             res.Round(),
 					Signature:         res.Signature(),
 					PreviousSignature: rd.PreviousSignature,
 					Randomness:        res.Randomness(),
-				})
-				if err != nil {
-					g.l.Errorw("", "relay_node", "err marshaling", "err", err)
-					continue
 				}
+				rc.cache.Add(res.Round(), res)
 
-				err = g.t.Publish(ctx, randB)
-				if err != nil {
-					g.l.Errorw("", "relay_node", "err publishing on pubsub", "err", err)
-					continue
+				if rc.t != nil {
+					g.publish(ctx, rc.t, rand.Round, rand)
 				}
-
-				g.l.Infow("", "relay_node", "Published randomness on pubsub", "round", res.Round())
-			case <-g.done:
+				if schemeID == "" {
+					if schemeID = g.chainScheme(ctx, w); schemeID == "" {
+						g.l.Warnw("", "relay_node", "unknown chain scheme, not publishing the envelope",
+							"chain_hash", hex.EncodeToString(rc.hash), "round", rand.Round)
+					}
+				}
+				if schemeID != "" {
+					env := NewEnvelope(rc.hash, schemeID, rand)
+					if g.sign {
+						if err := SignEnvelope(env, g.priv); err != nil {
+							g.l.Errorw("", "relay_node", "err signing envelope", "err", err)
+							continue
+						}
+					}
+					g.publish(ctx, rc.et, rand.Round, env)
+				}
+			case <-ctx.Done():
 				return
 			}
 		}
-		time.Sleep(time.Second)
+
+		select {
+		case <-time.After(time.Second):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (g *GossipRelayNode) publish(ctx context.Context, t *pubsub.Topic, round uint64, msg proto.Message) {
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		g.l.Errorw("", "relay_node", "err marshaling", "err", err)
+		return
+	}
+	if err := t.Publish(ctx, b); err != nil {
+		g.l.Errorw("", "relay_node", "err publishing on pubsub", "err", err, "topic", t.String())
+		return
 	}
+	g.l.Infow("", "relay_node", "Published randomness on pubsub", "round", round, "topic", t.String())
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/drand/drand/chain"
 	"github.com/drand/drand/client"
 	"github.com/drand/drand/client/test/result/mock"
+	"github.com/drand/drand/common/scheme"
 	"github.com/drand/drand/log"
 	"github.com/drand/drand/test"
 )
@@ -122,3 +123,133 @@ func TestWatchRetryOnClose(t *testing.T) {
 		t.Fatal("random data items waiting to be consumed", len(results))
 	}
 }
+
+// flakyInfoClient fails to give the chain info the first time
+type flakyInfoClient struct {
+	*mockClient
+	lk    sync.Mutex
+	calls int
+}
+
+func (c *flakyInfoClient) Info(ctx context.Context) (*chain.Info, error) {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	c.calls++
+	if c.calls == 1 {
+		return nil, errors.New("chain info unavailable")
+	}
+	return c.chainInfo, nil
+}
+
+func (c *flakyInfoClient) infoCalls() int {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	return c.calls
+}
+
+func TestRelaySchemeResolvedLazily(t *testing.T) {
+	chainInfo := &chain.Info{
+		Period:      time.Second,
+		GenesisTime: time.Now().Unix(),
+		PublicKey:   test.GenerateIDs(1)[0].Public.Key,
+		Scheme:      scheme.GetSchemeFromEnv(),
+	}
+	results := toRandomDataChain(
+		mock.NewMockResult(0),
+		mock.NewMockResult(1),
+		mock.NewMockResult(2),
+		mock.NewMockResult(3),
+	)
+	ch := make(chan client.Result, len(results))
+	for i := range results {
+		ch <- &results[i]
+	}
+	c := &flakyInfoClient{mockClient: &mockClient{chainInfo, func(context.Context) <-chan client.Result { return ch }}}
+
+	td := tmpDir(t)
+	defer func() {
+		_ = os.RemoveAll(td)
+	}()
+	gr, err := NewGossipRelayNode(log.DefaultLogger(), &GossipRelayConfig{
+		ChainHash:    hex.EncodeToString(chainInfo.Hash()),
+		Addr:         "/ip4/0.0.0.0/tcp/0",
+		DataDir:      td,
+		IdentityPath: path.Join(td, "identity.key"),
+		Client:       c,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gr.Shutdown()
+
+	// the scheme is asked for again after the failure, and not once known
+	deadline := time.Now().Add(5 * time.Second)
+	for len(ch) > 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if calls := c.infoCalls(); calls != 2 {
+		t.Fatal("expected the chain info to be asked for twice, got", calls)
+	}
+}
+
+func TestRelayMultipleChains(t *testing.T) {
+	td := tmpDir(t)
+	defer func() {
+		_ = os.RemoveAll(td)
+	}()
+	gr, err := NewGossipRelayNode(log.DefaultLogger(), &GossipRelayConfig{
+		Addr:         "/ip4/0.0.0.0/tcp/0",
+		DataDir:      td,
+		IdentityPath: path.Join(td, "identity.key"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gr.Shutdown()
+
+	watching := make(chan string, 2)
+	stopped := make(chan string, 2)
+	newClient := func(name string) *mockClient {
+		return &mockClient{watchF: func(ctx context.Context) <-chan client.Result {
+			ch := make(chan client.Result)
+			watching <- name
+			go func() {
+				<-ctx.Done()
+				stopped <- name
+				close(ch)
+			}()
+			return ch
+		}}
+	}
+
+	if err := gr.AddChain("aa", newClient("aa")); err != nil {
+		t.Fatal(err)
+	}
+	if err := gr.AddChain("bb", newClient("bb")); err != nil {
+		t.Fatal(err)
+	}
+	if err := gr.AddChain("aa", newClient("aa")); err == nil {
+		t.Fatal("expected error adding a chain twice")
+	}
+
+	seen := map[string]bool{<-watching: true, <-watching: true}
+	if !seen["aa"] || !seen["bb"] {
+		t.Fatal("expected both chains to be watched", seen)
+	}
+
+	if err := gr.RemoveChain("aa"); err != nil {
+		t.Fatal(err)
+	}
+	if name := <-stopped; name != "aa" {
+		t.Fatal("expected chain aa to be stopped, got", name)
+	}
+	if err := gr.RemoveChain("aa"); err == nil {
+		t.Fatal("expected error removing an unknown chain")
+	}
+
+	// the topic of a removed chain can be joined again
+	if err := gr.AddChain("aa", newClient("aa")); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,106 @@
+package lp2p
+
+import (
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	dlog "github.com/drand/drand/log"
+	"github.com/drand/drand/metrics"
+)
+
+const (
+	// trustedPeerScore is the application specific score of the trusted
+	// peers, high enough that they can't be graylisted.
+	trustedPeerScore = 10000
+	// invalidMessageWeight penalizes the peers delivering messages rejected by
+	// the topic validators, e.g. beacons in the future or with an invalid
+	// signature. The penalty is the square of the number of messages times the
+	// weight, so that a handful of invalid messages gets a peer graylisted.
+	invalidMessageWeight = -100
+	// scoreInspectPeriod is the period at which peer scores are checked to
+	// report the graylisted peers.
+	scoreInspectPeriod = 5 * time.Second
+	// scoreRetention keeps the score of disconnected peers, so that they can't
+	// clear their penalties by reconnecting.
+	scoreRetention = 10 * time.Minute
+
+	gossipThreshold   = -500
+	publishThreshold  = -1000
+	graylistThreshold = -2500
+)
+
+// TopicScoreParams returns the peer score parameters of a drand randomness
+// topic. They have to be set on the topic once it is joined.
+func TopicScoreParams() *pubsub.TopicScoreParams {
+	return &pubsub.TopicScoreParams{
+		TopicWeight: 1,
+		// time in mesh isn't rewarded, since beacons are infrequent
+		TimeInMeshQuantum: time.Second,
+		// reward the peers delivering beacons first
+		FirstMessageDeliveriesWeight: 1,
+		FirstMessageDeliveriesDecay:  pubsub.ScoreParameterDecay(10 * time.Minute),
+		FirstMessageDeliveriesCap:    10,
+		// penalize the peers delivering invalid beacons
+		InvalidMessageDeliveriesWeight: invalidMessageWeight,
+		InvalidMessageDeliveriesDecay:  pubsub.ScoreParameterDecay(time.Hour),
+	}
+}
+
+// peerScoreParams returns the peer score parameters of the host, trusting the
+// given peers.
+func peerScoreParams(trusted map[peer.ID]bool) *pubsub.PeerScoreParams {
+	return &pubsub.PeerScoreParams{
+		Topics: make(map[string]*pubsub.TopicScoreParams),
+		AppSpecificScore: func(p peer.ID) float64 {
+			if trusted[p] {
+				return trustedPeerScore
+			}
+			return 0
+		},
+		AppSpecificWeight: 1,
+		// penalize the peers misbehaving at the protocol level, e.g. not
+		// respecting the GRAFT backoff or making broken gossip promises
+		BehaviourPenaltyWeight:    -10,
+		BehaviourPenaltyThreshold: 1,
+		BehaviourPenaltyDecay:     pubsub.ScoreParameterDecay(10 * time.Minute),
+		DecayInterval:             pubsub.DefaultDecayInterval,
+		DecayToZero:               pubsub.DefaultDecayToZero,
+		RetainScore:               scoreRetention,
+	}
+}
+
+// peerScoreThresholds returns the score thresholds of the host.
+func peerScoreThresholds() *pubsub.PeerScoreThresholds {
+	return &pubsub.PeerScoreThresholds{
+		GossipThreshold:             gossipThreshold,
+		PublishThreshold:            publishThreshold,
+		GraylistThreshold:           graylistThreshold,
+		AcceptPXThreshold:           0,
+		OpportunisticGraftThreshold: 2,
+	}
+}
+
+// graylistInspector returns a peer score inspection function logging the
+// peers getting in and out of the graylist, and reporting their number. The
+// metric is updated incrementally, since several hosts can run in a process.
+func graylistInspector(log dlog.Logger) pubsub.PeerScoreInspectFn {
+	graylisted := make(map[peer.ID]bool)
+	return func(scores map[peer.ID]float64) {
+		for p := range graylisted {
+			if score, ok := scores[p]; !ok || score >= graylistThreshold {
+				log.Infow("", "gossip_score", "peer no longer graylisted", "peer", p)
+				delete(graylisted, p)
+				metrics.GossipGraylistedPeers.Dec()
+			}
+		}
+		for p, score := range scores {
+			if score < graylistThreshold && !graylisted[p] {
+				log.Warnw("", "gossip_score", "peer graylisted", "peer", p, "score", score)
+				graylisted[p] = true
+				metrics.GossipGraylistedPeers.Inc()
+			}
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package lp2p
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	dssync "github.com/ipfs/go-datastore/sync"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/log"
+	"github.com/drand/drand/metrics"
+)
+
+func newScoredHost(t *testing.T, bootstrap []ma.Multiaddr, opts ...HostOption) (host.Host, *pubsub.PubSub) {
+	t.Helper()
+	priv, _, err := crypto.GenerateEd25519Key(nil)
+	require.NoError(t, err)
+	ds := dssync.MutexWrap(datastore.NewMapDatastore())
+	h, ps, err := ConstructHost(ds, priv, "/ip4/127.0.0.1/tcp/0", bootstrap, log.DefaultLogger(), opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { h.Close() })
+	return h, ps
+}
+
+func joinScored(t *testing.T, ps *pubsub.PubSub, topic string) *pubsub.Topic {
+	t.Helper()
+	tp, err := ps.Join(topic)
+	require.NoError(t, err)
+	require.NoError(t, tp.SetScoreParams(TopicScoreParams()))
+	_, err = tp.Subscribe()
+	require.NoError(t, err)
+	return tp
+}
+
+func TestPeerScoreGraylistsInvalidPublishers(t *testing.T) {
+	topic := PubSubTopic("abcd")
+
+	trustedHost, trustedPs := newScoredHost(t, nil)
+	badHost, badPs := newScoredHost(t, nil)
+
+	// the node rejects every message, and only trusts one of the publishers
+	node, nodePs := newScoredHost(t, nil, WithTrustedPeers(trustedHost.ID()))
+	require.NoError(t, nodePs.RegisterTopicValidator(topic,
+		func(context.Context, peer.ID, *pubsub.Message) pubsub.ValidationResult {
+			return pubsub.ValidationReject
+		}))
+	joinScored(t, nodePs, topic)
+
+	ctx := context.Background()
+	publishers := []*pubsub.Topic{joinScored(t, trustedPs, topic), joinScored(t, badPs, topic)}
+	for _, h := range []host.Host{trustedHost, badHost} {
+		require.NoError(t, h.Connect(ctx, peer.AddrInfo{ID: node.ID(), Addrs: node.Addrs()}))
+	}
+	require.Eventually(t, func() bool {
+		return len(nodePs.ListPeers(topic)) == 2
+	}, 10*time.Second, 50*time.Millisecond)
+
+	for i := 0; i < 10; i++ {
+		for j, tp := range publishers {
+			require.NoError(t, tp.Publish(ctx, []byte(fmt.Sprintf("invalid %d from %d", i, j))))
+		}
+	}
+
+	// only the untrusted publisher gets graylisted
+	require.Eventually(t, func() bool {
+		return testutil.ToFloat64(metrics.GossipGraylistedPeers) == 1
+	}, 3*scoreInspectPeriod, 100*time.Millisecond)
+	require.Never(t, func() bool {
+		return testutil.ToFloat64(metrics.GossipGraylistedPeers) > 1
+	}, scoreInspectPeriod+time.Second, 500*time.Millisecond)
+}
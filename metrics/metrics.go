@@ -91,6 +91,35 @@ var (
 		Help: "Last locally stored beacon",
 	}, []string{"beacon_id"})
 
+	// SigningConflicts (Group) counts the partial beacons the node was about to
+	// sign over a message conflicting with a partial beacon signed before
+	SigningConflicts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "signing_conflicts",
+		Help: "Number of partial beacons conflicting with a partial beacon signed before",
+	}, []string{"beacon_id"})
+
+	// PartialParticipation (Group) counts the valid partial beacons received
+	// from each node of the group
+	PartialParticipation = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "partial_participation",
+		Help: "Number of valid partial beacons received, per node index",
+	}, []string{"beacon_id", "index"})
+
+	// InvalidPartials (Group) counts the partial beacons received that didn't
+	// verify. The index they claim is not authenticated, so they are not
+	// counted per node.
+	InvalidPartials = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "invalid_partials",
+		Help: "Number of invalid partial beacons received",
+	}, []string{"beacon_id"})
+
+	// PartialLatency (Group) millisecond duration between the time of a round
+	// and the arrival of the last partial beacon of each node of the group
+	PartialLatency = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "partial_latency",
+		Help: "Delay between the time of a round and the arrival of a partial beacon, per node index",
+	}, []string{"beacon_id", "index"})
+
 	// HTTPCallCounter (HTTP) how many http requests
 	HTTPCallCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
 		Name: "http_call_counter",
@@ -182,6 +211,20 @@ var (
 		[]string{"url"},
 	)
 
+	// GossipGraylistedPeers (client) tracks the number of gossip peers
+	// graylisted because of their peer score
+	GossipGraylistedPeers = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "gossip_graylisted_peers",
+		Help: "Number of gossip peers graylisted because of their peer score",
+	})
+
+	// GossipRejectedMessages (client) counts the gossip messages rejected by the
+	// randomness validator, by reason
+	GossipRejectedMessages = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "gossip_rejected_messages",
+		Help: "Number of gossip messages rejected by the randomness validator",
+	}, []string{"reason"})
+
 	// dkgState (Group) tracks DKG status changes
 	dkgState = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "dkg_state",
@@ -272,6 +315,10 @@ func bindMetrics() {
 		GroupThreshold,
 		BeaconDiscrepancyLatency,
 		LastBeaconRound,
+		SigningConflicts,
+		PartialParticipation,
+		InvalidPartials,
+		PartialLatency,
 		drandBuildTime,
 		dkgState,
 		dkgStateTimestamp,
@@ -335,6 +382,8 @@ func RegisterClientMetrics(r prometheus.Registerer) error {
 		ClientHTTPHeartbeatSuccess,
 		ClientHTTPHeartbeatFailure,
 		ClientHTTPHeartbeatLatency,
+		GossipGraylistedPeers,
+		GossipRejectedMessages,
 	}
 	for _, c := range client {
 		if err := r.Register(c); err != nil {
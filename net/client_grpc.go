@@ -207,17 +207,44 @@ func (g *grpcClient) BroadcastDKG(ctx context.Context, p Peer, in *drand.DKGPack
 	return err
 }
 
-func (g *grpcClient) PartialBeacon(ctx context.Context, p Peer, in *drand.PartialBeaconPacket, opts ...CallOption) error {
+// PartialBeacon sends the partial beacon to the peer, and returns its reply
+func (g *grpcClient) PartialBeacon(ctx context.Context, p Peer, in *drand.PartialBeaconPacket, opts ...CallOption) (
+	*drand.Empty, error) {
+	c, err := g.conn(p)
+	if err != nil {
+		return nil, err
+	}
+	client := drand.NewProtocolClient(c)
+	ctx, cancel := g.getTimeoutContext(ctx)
+	defer cancel()
+	return client.PartialBeacon(ctx, in, opts...)
+}
+
+// Halt sends a vote to end the chain to the peer
+func (g *grpcClient) Halt(ctx context.Context, p Peer, in *drand.HaltPacket, opts ...CallOption) error {
 	c, err := g.conn(p)
 	if err != nil {
 		return err
 	}
 	client := drand.NewProtocolClient(c)
-	ctx, _ = g.getTimeoutContext(ctx)
-	_, err = client.PartialBeacon(ctx, in, opts...)
+	ctx, cancel := g.getTimeoutContext(ctx)
+	defer cancel()
+	_, err = client.Halt(ctx, in, opts...)
 	return err
 }
 
+// PartialBeaconStream opens a stream to send partial beacons to the peer, which
+// lasts until the context is canceled.
+func (g *grpcClient) PartialBeaconStream(ctx context.Context, p Peer, opts ...CallOption) (
+	drand.Protocol_PartialBeaconStreamClient, error) {
+	c, err := g.conn(p)
+	if err != nil {
+		return nil, err
+	}
+	client := drand.NewProtocolClient(c)
+	return client.PartialBeaconStream(ctx, opts...)
+}
+
 // MaxSyncBuffer is the maximum number of queued rounds when syncing
 const MaxSyncBuffer = 500
 
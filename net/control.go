@@ -3,7 +3,9 @@ package net
 import (
 	ctx "context"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
 	"net"
 	"strings"
 	"time"
@@ -98,6 +100,23 @@ func (c *ControlClient) RemoteStatus(ct ctx.Context,
 	return resp.GetStatuses(), err
 }
 
+// Participation returns the partial beacons the daemon received for the last
+// rounds, all the rounds it keeps if rounds is 0
+func (c *ControlClient) Participation(beaconID string, rounds uint32) (*control.ParticipationResponse, error) {
+	metadata := protoCommon.NewMetadata(c.version.ToProto())
+	metadata.BeaconID = beaconID
+
+	return c.client.Participation(ctx.Background(), &control.ParticipationRequest{Metadata: metadata, Rounds: rounds})
+}
+
+// ScheduleHalt votes to end the chain at the given round
+func (c *ControlClient) ScheduleHalt(beaconID string, round uint64) (*control.ScheduleHaltResponse, error) {
+	metadata := protoCommon.NewMetadata(c.version.ToProto())
+	metadata.BeaconID = beaconID
+
+	return c.client.ScheduleHalt(ctx.Background(), &control.ScheduleHaltRequest{Metadata: metadata, Round: round})
+}
+
 // Ping the drand daemon to check if it's up and running
 func (c *ControlClient) Ping() error {
 	metadata := protoCommon.NewMetadata(c.version.ToProto())
@@ -145,6 +164,8 @@ func (c *ControlClient) ListSchemes() (*control.ListSchemesResponse, error) {
 }
 
 // InitReshareLeader sets up the node to be ready for a resharing protocol.
+// The nodes of the old group at the evicted addresses are removed from the
+// new group, without taking part in the resharing.
 // NOTE: only group referral via filesystem path is supported at the moment.
 // XXX Might be best to move to core/
 func (c *ControlClient) InitReshareLeader(
@@ -152,7 +173,8 @@ func (c *ControlClient) InitReshareLeader(
 	timeout, catchupPeriod time.Duration,
 	secret, oldPath string,
 	offset int,
-	beaconID string) (*control.GroupPacket, error) {
+	beaconID string,
+	evict ...string) (*control.GroupPacket, error) {
 	metadata := protoCommon.Metadata{NodeVersion: c.version.ToProto(), BeaconID: beaconID}
 
 	request := &control.InitResharePacket{
@@ -171,13 +193,17 @@ func (c *ControlClient) InitReshareLeader(
 		CatchupPeriodChanged: catchupPeriod >= 0,
 		CatchupPeriod:        uint32(catchupPeriod.Seconds()),
 		Metadata:             &metadata,
+		Evict:                evict,
 	}
 
 	return c.client.InitReshare(ctx.Background(), request)
 }
 
-// InitReshare sets up the node to be ready for a resharing protocol.
-func (c *ControlClient) InitReshare(leader Peer, secret, oldPath string, force bool, beaconID string) (*control.GroupPacket, error) {
+// InitReshare sets up the node to be ready for a resharing protocol. If
+// addresses to evict are given, the node only accepts a new group without
+// these nodes of the old group.
+func (c *ControlClient) InitReshare(leader Peer, secret, oldPath string, force bool, beaconID string,
+	evict ...string) (*control.GroupPacket, error) {
 	metadata := protoCommon.Metadata{NodeVersion: c.version.ToProto(), BeaconID: beaconID}
 
 	request := &control.InitResharePacket{
@@ -193,6 +219,7 @@ func (c *ControlClient) InitReshare(leader Peer, secret, oldPath string, force b
 			Metadata:      &metadata,
 		},
 		Metadata: &metadata,
+		Evict:    evict,
 	}
 
 	return c.client.InitReshare(ctx.Background(), request)
@@ -211,6 +238,8 @@ func (c *ControlClient) InitDKGLeader(
 	schemeID string,
 	beaconID string) (*control.GroupPacket, error) {
 	metadata := protoCommon.Metadata{NodeVersion: c.version.ToProto(), BeaconID: beaconID}
+	period, periodMs := common.PeriodToProto(beaconPeriod)
+	catchup, catchupMs := common.PeriodToProto(catchupPeriod)
 
 	request := &control.InitDKGPacket{
 		Info: &control.SetupInfoPacket{
@@ -222,11 +251,13 @@ func (c *ControlClient) InitDKGLeader(
 			BeaconOffset: uint32(offset),
 			Metadata:     &metadata,
 		},
-		Entropy:       entropy,
-		BeaconPeriod:  uint32(beaconPeriod.Seconds()),
-		CatchupPeriod: uint32(catchupPeriod.Seconds()),
-		SchemeID:      schemeID,
-		Metadata:      &metadata,
+		Entropy:         entropy,
+		BeaconPeriod:    period,
+		BeaconPeriodMs:  periodMs,
+		CatchupPeriod:   catchup,
+		CatchupPeriodMs: catchupMs,
+		SchemeID:        schemeID,
+		Metadata:        &metadata,
 	}
 
 	return c.client.InitDKG(ctx.Background(), request)
@@ -251,6 +282,25 @@ func (c *ControlClient) InitDKG(leader Peer, entropy *control.EntropyInfo, secre
 	return c.client.InitDKG(ctx.Background(), request)
 }
 
+// InitDKGLeaderless sets up the node to run the DKG or resharing of the given
+// proposal, signed by all its participants, without a leader. The old group is
+// only needed by new nodes of a resharing.
+func (c *ControlClient) InitDKGLeaderless(proposal *control.DKGProposal, entropy *control.EntropyInfo,
+	oldPath, beaconID string) (*control.GroupPacket, error) {
+	metadata := protoCommon.Metadata{NodeVersion: c.version.ToProto(), BeaconID: beaconID}
+
+	request := &control.InitDKGLeaderlessPacket{
+		Proposal: proposal,
+		Entropy:  entropy,
+		Metadata: &metadata,
+		Old: &control.GroupInfo{
+			Location: &control.GroupInfo_Path{Path: oldPath},
+		},
+	}
+
+	return c.client.InitDKGLeaderless(ctx.Background(), request)
+}
+
 // Share returns the share of the remote node
 func (c *ControlClient) Share(beaconID string) (*control.ShareResponse, error) {
 	metadata := protoCommon.Metadata{NodeVersion: c.version.ToProto(), BeaconID: beaconID}
@@ -424,6 +474,41 @@ func (c *ControlClient) BackupDB(outFile, beaconID string) error {
 	return err
 }
 
+// DKGStatus returns the progress of the DKG of the beacon. If watch is true,
+// the progress is sent again after each change until the DKG is over. The
+// channels are closed once the stream ends, and the error channel receives
+// the error that ended it, if any.
+func (c *ControlClient) DKGStatus(cc ctx.Context, watch bool,
+	beaconID string) (outCh chan *control.DKGProgress, errCh chan error, e error) {
+	metadata := protoCommon.Metadata{NodeVersion: c.version.ToProto(), BeaconID: beaconID}
+	stream, err := c.client.DKGStatus(cc, &control.DKGStatusRequest{Watch: watch, Metadata: &metadata})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	outCh = make(chan *control.DKGProgress, progressSyncQueue)
+	errCh = make(chan error, 1)
+	go func() {
+		defer close(outCh)
+		defer close(errCh)
+		for {
+			resp, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return
+			} else if err != nil {
+				errCh <- err
+				return
+			}
+			select {
+			case outCh <- resp:
+			case <-cc.Done():
+				return
+			}
+		}
+	}()
+	return outCh, errCh, nil
+}
+
 // controlListenAddr parses the control address as specified, into a dialable / listenable address
 func controlListenAddr(listenAddr string) (network, addr string) {
 	if strings.HasPrefix(listenAddr, "unix://") {
@@ -460,6 +545,22 @@ func (s *DefaultControlServer) RemoteStatus(c ctx.Context, in *control.RemoteSta
 	return s.C.RemoteStatus(c, in)
 }
 
+// Participation returns the partial beacons received for the last rounds
+func (s *DefaultControlServer) Participation(c ctx.Context, in *control.ParticipationRequest) (*control.ParticipationResponse, error) {
+	if s.C == nil {
+		return &control.ParticipationResponse{}, nil
+	}
+	return s.C.Participation(c, in)
+}
+
+// ScheduleHalt votes to end the chain at a round
+func (s *DefaultControlServer) ScheduleHalt(c ctx.Context, in *control.ScheduleHaltRequest) (*control.ScheduleHaltResponse, error) {
+	if s.C == nil {
+		return &control.ScheduleHaltResponse{}, nil
+	}
+	return s.C.ScheduleHalt(c, in)
+}
+
 // Share initiates a share request
 func (s *DefaultControlServer) Share(c ctx.Context, in *control.ShareRequest) (*control.ShareResponse, error) {
 	if s.C == nil {
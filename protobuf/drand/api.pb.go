@@ -170,6 +170,168 @@ func (x *PublicRandResponse) GetMetadata() *common.Metadata {
 	return nil
 }
 
+// GossipEnvelope wraps the randomness published on the versioned gossip topics,
+// identifying the chain and the scheme it belongs to.
+type GossipEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// version is the version of the envelope format.
+	Version   uint32              `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	ChainHash []byte              `protobuf:"bytes,2,opt,name=chain_hash,json=chainHash,proto3" json:"chain_hash,omitempty"`
+	SchemeId  string              `protobuf:"bytes,3,opt,name=scheme_id,json=schemeId,proto3" json:"scheme_id,omitempty"`
+	Beacon    *PublicRandResponse `protobuf:"bytes,4,opt,name=beacon,proto3" json:"beacon,omitempty"`
+	// relay_id is the libp2p peer ID of the relay that signed the envelope,
+	// if it is signed.
+	RelayId string `protobuf:"bytes,5,opt,name=relay_id,json=relayId,proto3" json:"relay_id,omitempty"`
+	// relay_signature is the signature of the envelope with the libp2p key of
+	// the relay.
+	RelaySignature []byte `protobuf:"bytes,6,opt,name=relay_signature,json=relaySignature,proto3" json:"relay_signature,omitempty"`
+}
+
+func (x *GossipEnvelope) Reset() {
+	*x = GossipEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drand_api_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GossipEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GossipEnvelope) ProtoMessage() {}
+
+func (x *GossipEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_drand_api_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GossipEnvelope.ProtoReflect.Descriptor instead.
+func (*GossipEnvelope) Descriptor() ([]byte, []int) {
+	return file_drand_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GossipEnvelope) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GossipEnvelope) GetChainHash() []byte {
+	if x != nil {
+		return x.ChainHash
+	}
+	return nil
+}
+
+func (x *GossipEnvelope) GetSchemeId() string {
+	if x != nil {
+		return x.SchemeId
+	}
+	return ""
+}
+
+func (x *GossipEnvelope) GetBeacon() *PublicRandResponse {
+	if x != nil {
+		return x.Beacon
+	}
+	return nil
+}
+
+func (x *GossipEnvelope) GetRelayId() string {
+	if x != nil {
+		return x.RelayId
+	}
+	return ""
+}
+
+func (x *GossipEnvelope) GetRelaySignature() []byte {
+	if x != nil {
+		return x.RelaySignature
+	}
+	return nil
+}
+
+// HistoryRequest is sent over the libp2p history protocol by gossip clients to
+// fetch the rounds they missed from their peers, which answer with a stream of
+// PublicRandResponse.
+type HistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromRound uint64 `protobuf:"varint,1,opt,name=from_round,json=fromRound,proto3" json:"from_round,omitempty"`
+	// to_round is the last round requested. If it is lower than from_round,
+	// only from_round is requested.
+	ToRound  uint64           `protobuf:"varint,2,opt,name=to_round,json=toRound,proto3" json:"to_round,omitempty"`
+	Metadata *common.Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *HistoryRequest) Reset() {
+	*x = HistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drand_api_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryRequest) ProtoMessage() {}
+
+func (x *HistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_drand_api_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryRequest.ProtoReflect.Descriptor instead.
+func (*HistoryRequest) Descriptor() ([]byte, []int) {
+	return file_drand_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HistoryRequest) GetFromRound() uint64 {
+	if x != nil {
+		return x.FromRound
+	}
+	return 0
+}
+
+func (x *HistoryRequest) GetToRound() uint64 {
+	if x != nil {
+		return x.ToRound
+	}
+	return 0
+}
+
+func (x *HistoryRequest) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
 // PrivateRandRequest is the message to send when requesting a private random
 // value.
 type PrivateRandRequest struct {
@@ -187,7 +349,7 @@ type PrivateRandRequest struct {
 func (x *PrivateRandRequest) Reset() {
 	*x = PrivateRandRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_api_proto_msgTypes[2]
+		mi := &file_drand_api_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -200,7 +362,7 @@ func (x *PrivateRandRequest) String() string {
 func (*PrivateRandRequest) ProtoMessage() {}
 
 func (x *PrivateRandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_api_proto_msgTypes[2]
+	mi := &file_drand_api_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -213,7 +375,7 @@ func (x *PrivateRandRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrivateRandRequest.ProtoReflect.Descriptor instead.
 func (*PrivateRandRequest) Descriptor() ([]byte, []int) {
-	return file_drand_api_proto_rawDescGZIP(), []int{2}
+	return file_drand_api_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *PrivateRandRequest) GetRequest() []byte {
@@ -245,7 +407,7 @@ type PrivateRandResponse struct {
 func (x *PrivateRandResponse) Reset() {
 	*x = PrivateRandResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_api_proto_msgTypes[3]
+		mi := &file_drand_api_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -258,7 +420,7 @@ func (x *PrivateRandResponse) String() string {
 func (*PrivateRandResponse) ProtoMessage() {}
 
 func (x *PrivateRandResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_api_proto_msgTypes[3]
+	mi := &file_drand_api_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -271,7 +433,7 @@ func (x *PrivateRandResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrivateRandResponse.ProtoReflect.Descriptor instead.
 func (*PrivateRandResponse) Descriptor() ([]byte, []int) {
-	return file_drand_api_proto_rawDescGZIP(), []int{3}
+	return file_drand_api_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PrivateRandResponse) GetResponse() []byte {
@@ -299,7 +461,7 @@ type HomeRequest struct {
 func (x *HomeRequest) Reset() {
 	*x = HomeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_api_proto_msgTypes[4]
+		mi := &file_drand_api_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -312,7 +474,7 @@ func (x *HomeRequest) String() string {
 func (*HomeRequest) ProtoMessage() {}
 
 func (x *HomeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_api_proto_msgTypes[4]
+	mi := &file_drand_api_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -325,7 +487,7 @@ func (x *HomeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HomeRequest.ProtoReflect.Descriptor instead.
 func (*HomeRequest) Descriptor() ([]byte, []int) {
-	return file_drand_api_proto_rawDescGZIP(), []int{4}
+	return file_drand_api_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *HomeRequest) GetMetadata() *common.Metadata {
@@ -347,7 +509,7 @@ type HomeResponse struct {
 func (x *HomeResponse) Reset() {
 	*x = HomeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_api_proto_msgTypes[5]
+		mi := &file_drand_api_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -360,7 +522,7 @@ func (x *HomeResponse) String() string {
 func (*HomeResponse) ProtoMessage() {}
 
 func (x *HomeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_api_proto_msgTypes[5]
+	mi := &file_drand_api_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -373,7 +535,7 @@ func (x *HomeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HomeResponse.ProtoReflect.Descriptor instead.
 func (*HomeResponse) Descriptor() ([]byte, []int) {
-	return file_drand_api_proto_rawDescGZIP(), []int{5}
+	return file_drand_api_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *HomeResponse) GetStatus() string {
@@ -415,52 +577,73 @@ var file_drand_api_proto_rawDesc = []byte{
 	0x6e, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
 	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
 	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x22, 0x5c, 0x0a, 0x12, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0x5f, 0x0a, 0x13, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0x3b, 0x0a, 0x0b, 0x48, 0x6f, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x74, 0x61, 0x22, 0xdd, 0x01, 0x0a, 0x0e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x45, 0x6e, 0x76,
+	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x72,
+	0x61, 0x6e, 0x64, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x52, 0x61, 0x6e, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x19,
+	0x0a, 0x08, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6c,
+	0x61, 0x79, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x22, 0x78, 0x0a, 0x0e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2c,
+	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x5c, 0x0a, 0x12,
+	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x5f, 0x0a, 0x13, 0x50, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3b, 0x0a, 0x0b, 0x48,
+	0x6f, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x54, 0x0a, 0x0c, 0x48, 0x6f, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x54,
-	0x0a, 0x0c, 0x48, 0x6f, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x32, 0xcb, 0x02, 0x0a, 0x06, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12,
-	0x41, 0x0a, 0x0a, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x52, 0x61, 0x6e, 0x64, 0x12, 0x18, 0x2e,
-	0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x52, 0x61, 0x6e, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x52, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x49, 0x0a, 0x10, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x52, 0x61, 0x6e, 0x64,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x18, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x52, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x19, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x52,
-	0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x44, 0x0a,
-	0x0b, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x64, 0x12, 0x19, 0x2e, 0x64,
-	0x72, 0x61, 0x6e, 0x64, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e,
-	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x17, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x64, 0x72, 0x61, 0x6e,
-	0x64, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x12, 0x2f, 0x0a, 0x04, 0x48, 0x6f, 0x6d, 0x65, 0x12, 0x12, 0x2e, 0x64, 0x72, 0x61, 0x6e,
-	0x64, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
-	0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2f, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x32, 0xcb,
+	0x02, 0x0a, 0x06, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x41, 0x0a, 0x0a, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x52, 0x61, 0x6e, 0x64, 0x12, 0x18, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x52, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x52, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x10,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x52, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x12, 0x18, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x52,
+	0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x64, 0x72, 0x61,
+	0x6e, 0x64, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x52, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x44, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x52, 0x61, 0x6e, 0x64, 0x12, 0x19, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x52, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a,
+	0x09, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x2e, 0x64, 0x72, 0x61,
+	0x6e, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x48,
+	0x6f, 0x6d, 0x65, 0x12, 0x12, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x48, 0x6f, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e,
+	0x48, 0x6f, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x27, 0x5a, 0x25,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x72, 0x61, 0x6e, 0x64,
+	0x2f, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x64, 0x72, 0x61, 0x6e, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -475,40 +658,44 @@ func file_drand_api_proto_rawDescGZIP() []byte {
 	return file_drand_api_proto_rawDescData
 }
 
-var file_drand_api_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_drand_api_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_drand_api_proto_goTypes = []interface{}{
 	(*PublicRandRequest)(nil),   // 0: drand.PublicRandRequest
 	(*PublicRandResponse)(nil),  // 1: drand.PublicRandResponse
-	(*PrivateRandRequest)(nil),  // 2: drand.PrivateRandRequest
-	(*PrivateRandResponse)(nil), // 3: drand.PrivateRandResponse
-	(*HomeRequest)(nil),         // 4: drand.HomeRequest
-	(*HomeResponse)(nil),        // 5: drand.HomeResponse
-	(*common.Metadata)(nil),     // 6: common.Metadata
-	(*ChainInfoRequest)(nil),    // 7: drand.ChainInfoRequest
-	(*ChainInfoPacket)(nil),     // 8: drand.ChainInfoPacket
+	(*GossipEnvelope)(nil),      // 2: drand.GossipEnvelope
+	(*HistoryRequest)(nil),      // 3: drand.HistoryRequest
+	(*PrivateRandRequest)(nil),  // 4: drand.PrivateRandRequest
+	(*PrivateRandResponse)(nil), // 5: drand.PrivateRandResponse
+	(*HomeRequest)(nil),         // 6: drand.HomeRequest
+	(*HomeResponse)(nil),        // 7: drand.HomeResponse
+	(*common.Metadata)(nil),     // 8: common.Metadata
+	(*ChainInfoRequest)(nil),    // 9: drand.ChainInfoRequest
+	(*ChainInfoPacket)(nil),     // 10: drand.ChainInfoPacket
 }
 var file_drand_api_proto_depIdxs = []int32{
-	6,  // 0: drand.PublicRandRequest.metadata:type_name -> common.Metadata
-	6,  // 1: drand.PublicRandResponse.metadata:type_name -> common.Metadata
-	6,  // 2: drand.PrivateRandRequest.metadata:type_name -> common.Metadata
-	6,  // 3: drand.PrivateRandResponse.metadata:type_name -> common.Metadata
-	6,  // 4: drand.HomeRequest.metadata:type_name -> common.Metadata
-	6,  // 5: drand.HomeResponse.metadata:type_name -> common.Metadata
-	0,  // 6: drand.Public.PublicRand:input_type -> drand.PublicRandRequest
-	0,  // 7: drand.Public.PublicRandStream:input_type -> drand.PublicRandRequest
-	2,  // 8: drand.Public.PrivateRand:input_type -> drand.PrivateRandRequest
-	7,  // 9: drand.Public.ChainInfo:input_type -> drand.ChainInfoRequest
-	4,  // 10: drand.Public.Home:input_type -> drand.HomeRequest
-	1,  // 11: drand.Public.PublicRand:output_type -> drand.PublicRandResponse
-	1,  // 12: drand.Public.PublicRandStream:output_type -> drand.PublicRandResponse
-	3,  // 13: drand.Public.PrivateRand:output_type -> drand.PrivateRandResponse
-	8,  // 14: drand.Public.ChainInfo:output_type -> drand.ChainInfoPacket
-	5,  // 15: drand.Public.Home:output_type -> drand.HomeResponse
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	8,  // 0: drand.PublicRandRequest.metadata:type_name -> common.Metadata
+	8,  // 1: drand.PublicRandResponse.metadata:type_name -> common.Metadata
+	1,  // 2: drand.GossipEnvelope.beacon:type_name -> drand.PublicRandResponse
+	8,  // 3: drand.HistoryRequest.metadata:type_name -> common.Metadata
+	8,  // 4: drand.PrivateRandRequest.metadata:type_name -> common.Metadata
+	8,  // 5: drand.PrivateRandResponse.metadata:type_name -> common.Metadata
+	8,  // 6: drand.HomeRequest.metadata:type_name -> common.Metadata
+	8,  // 7: drand.HomeResponse.metadata:type_name -> common.Metadata
+	0,  // 8: drand.Public.PublicRand:input_type -> drand.PublicRandRequest
+	0,  // 9: drand.Public.PublicRandStream:input_type -> drand.PublicRandRequest
+	4,  // 10: drand.Public.PrivateRand:input_type -> drand.PrivateRandRequest
+	9,  // 11: drand.Public.ChainInfo:input_type -> drand.ChainInfoRequest
+	6,  // 12: drand.Public.Home:input_type -> drand.HomeRequest
+	1,  // 13: drand.Public.PublicRand:output_type -> drand.PublicRandResponse
+	1,  // 14: drand.Public.PublicRandStream:output_type -> drand.PublicRandResponse
+	5,  // 15: drand.Public.PrivateRand:output_type -> drand.PrivateRandResponse
+	10, // 16: drand.Public.ChainInfo:output_type -> drand.ChainInfoPacket
+	7,  // 17: drand.Public.Home:output_type -> drand.HomeResponse
+	13, // [13:18] is the sub-list for method output_type
+	8,  // [8:13] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_drand_api_proto_init() }
@@ -543,7 +730,7 @@ func file_drand_api_proto_init() {
 			}
 		}
 		file_drand_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrivateRandRequest); i {
+			switch v := v.(*GossipEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -555,7 +742,7 @@ func file_drand_api_proto_init() {
 			}
 		}
 		file_drand_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrivateRandResponse); i {
+			switch v := v.(*HistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -567,7 +754,7 @@ func file_drand_api_proto_init() {
 			}
 		}
 		file_drand_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HomeRequest); i {
+			switch v := v.(*PrivateRandRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -579,6 +766,30 @@ func file_drand_api_proto_init() {
 			}
 		}
 		file_drand_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrivateRandResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drand_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HomeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drand_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HomeResponse); i {
 			case 0:
 				return &v.state
@@ -597,7 +808,7 @@ func file_drand_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_drand_api_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -194,6 +194,12 @@ type InitDKGPacket struct {
 	// indicates a set of values the process will use to act in specific ways
 	SchemeID string           `protobuf:"bytes,5,opt,name=schemeID,proto3" json:"schemeID,omitempty"`
 	Metadata *common.Metadata `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// the period time of the beacon in milliseconds, set only when the period
+	// isn't a whole number of seconds
+	BeaconPeriodMs uint32 `protobuf:"varint,7,opt,name=beacon_period_ms,json=beaconPeriodMs,proto3" json:"beacon_period_ms,omitempty"`
+	// the minimum beacon period when in catchup, in milliseconds, set under
+	// the same conditions
+	CatchupPeriodMs uint32 `protobuf:"varint,8,opt,name=catchup_period_ms,json=catchupPeriodMs,proto3" json:"catchup_period_ms,omitempty"`
 }
 
 func (x *InitDKGPacket) Reset() {
@@ -270,6 +276,172 @@ func (x *InitDKGPacket) GetMetadata() *common.Metadata {
 	return nil
 }
 
+func (x *InitDKGPacket) GetBeaconPeriodMs() uint32 {
+	if x != nil {
+		return x.BeaconPeriodMs
+	}
+	return 0
+}
+
+func (x *InitDKGPacket) GetCatchupPeriodMs() uint32 {
+	if x != nil {
+		return x.CatchupPeriodMs
+	}
+	return 0
+}
+
+// DKGProposal is the setup of a DKG, or of a resharing when its group has a
+// transition time, agreed on beforehand by all its participants, and signed
+// by each of them, so that they can run it without a leader.
+type DKGProposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the group to create, without distributed key
+	Group *GroupPacket `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	// the unix time at which all participants start the DKG
+	Start int64 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	// timeout of each phase of the dkg. Unit is in seconds.
+	Timeout uint32 `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// the signatures of the participants over the proposal, in the order of
+	// the nodes of the group
+	Signatures [][]byte `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (x *DKGProposal) Reset() {
+	*x = DKGProposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drand_control_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DKGProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DKGProposal) ProtoMessage() {}
+
+func (x *DKGProposal) ProtoReflect() protoreflect.Message {
+	mi := &file_drand_control_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DKGProposal.ProtoReflect.Descriptor instead.
+func (*DKGProposal) Descriptor() ([]byte, []int) {
+	return file_drand_control_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DKGProposal) GetGroup() *GroupPacket {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *DKGProposal) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *DKGProposal) GetTimeout() uint32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *DKGProposal) GetSignatures() [][]byte {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+type InitDKGLeaderlessPacket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Proposal *DKGProposal     `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	Entropy  *EntropyInfo     `protobuf:"bytes,2,opt,name=entropy,proto3" json:"entropy,omitempty"`
+	Metadata *common.Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// the group reshared from, when the proposal is a resharing. Nodes of
+	// that group can use the group they have stored instead.
+	Old *GroupInfo `protobuf:"bytes,4,opt,name=old,proto3" json:"old,omitempty"`
+}
+
+func (x *InitDKGLeaderlessPacket) Reset() {
+	*x = InitDKGLeaderlessPacket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drand_control_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InitDKGLeaderlessPacket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitDKGLeaderlessPacket) ProtoMessage() {}
+
+func (x *InitDKGLeaderlessPacket) ProtoReflect() protoreflect.Message {
+	mi := &file_drand_control_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitDKGLeaderlessPacket.ProtoReflect.Descriptor instead.
+func (*InitDKGLeaderlessPacket) Descriptor() ([]byte, []int) {
+	return file_drand_control_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InitDKGLeaderlessPacket) GetProposal() *DKGProposal {
+	if x != nil {
+		return x.Proposal
+	}
+	return nil
+}
+
+func (x *InitDKGLeaderlessPacket) GetEntropy() *EntropyInfo {
+	if x != nil {
+		return x.Entropy
+	}
+	return nil
+}
+
+func (x *InitDKGLeaderlessPacket) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *InitDKGLeaderlessPacket) GetOld() *GroupInfo {
+	if x != nil {
+		return x.Old
+	}
+	return nil
+}
+
 type InitDKGPacketResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -281,7 +453,7 @@ type InitDKGPacketResponse struct {
 func (x *InitDKGPacketResponse) Reset() {
 	*x = InitDKGPacketResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[2]
+		mi := &file_drand_control_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -294,7 +466,7 @@ func (x *InitDKGPacketResponse) String() string {
 func (*InitDKGPacketResponse) ProtoMessage() {}
 
 func (x *InitDKGPacketResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[2]
+	mi := &file_drand_control_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -307,7 +479,7 @@ func (x *InitDKGPacketResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitDKGPacketResponse.ProtoReflect.Descriptor instead.
 func (*InitDKGPacketResponse) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{2}
+	return file_drand_control_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *InitDKGPacketResponse) GetMetadata() *common.Metadata {
@@ -334,7 +506,7 @@ type EntropyInfo struct {
 func (x *EntropyInfo) Reset() {
 	*x = EntropyInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[3]
+		mi := &file_drand_control_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -347,7 +519,7 @@ func (x *EntropyInfo) String() string {
 func (*EntropyInfo) ProtoMessage() {}
 
 func (x *EntropyInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[3]
+	mi := &file_drand_control_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,7 +532,7 @@ func (x *EntropyInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EntropyInfo.ProtoReflect.Descriptor instead.
 func (*EntropyInfo) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{3}
+	return file_drand_control_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *EntropyInfo) GetScript() string {
@@ -401,12 +573,15 @@ type InitResharePacket struct {
 	CatchupPeriodChanged bool             `protobuf:"varint,3,opt,name=catchup_period_changed,json=catchupPeriodChanged,proto3" json:"catchup_period_changed,omitempty"`
 	CatchupPeriod        uint32           `protobuf:"varint,4,opt,name=catchup_period,json=catchupPeriod,proto3" json:"catchup_period,omitempty"`
 	Metadata             *common.Metadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// addresses of the nodes of the old group removed from it by the
+	// resharing, without their participation
+	Evict []string `protobuf:"bytes,6,rep,name=evict,proto3" json:"evict,omitempty"`
 }
 
 func (x *InitResharePacket) Reset() {
 	*x = InitResharePacket{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[4]
+		mi := &file_drand_control_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -419,7 +594,7 @@ func (x *InitResharePacket) String() string {
 func (*InitResharePacket) ProtoMessage() {}
 
 func (x *InitResharePacket) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[4]
+	mi := &file_drand_control_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -432,7 +607,7 @@ func (x *InitResharePacket) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitResharePacket.ProtoReflect.Descriptor instead.
 func (*InitResharePacket) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{4}
+	return file_drand_control_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *InitResharePacket) GetOld() *GroupInfo {
@@ -470,40 +645,41 @@ func (x *InitResharePacket) GetMetadata() *common.Metadata {
 	return nil
 }
 
-// GroupInfo holds the information to load a group information such as the nodes
-// and the genesis etc. Currently only the loading of a group via filesystem is
-// supported although the basis to support loading a group from a URI is setup.
-// For example, for new nodes that wants to join a network, they could point to
-// the URL that returns a group definition, for example at one of the currently
-// running node.
-type GroupInfo struct {
+func (x *InitResharePacket) GetEvict() []string {
+	if x != nil {
+		return x.Evict
+	}
+	return nil
+}
+
+type DKGStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Location:
-	//	*GroupInfo_Path
-	//	*GroupInfo_Url
-	Location isGroupInfo_Location `protobuf_oneof:"location"`
+	// watch keeps the stream open until the DKG finishes, sending the
+	// progress at each change
+	Watch    bool             `protobuf:"varint,1,opt,name=watch,proto3" json:"watch,omitempty"`
+	Metadata *common.Metadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (x *GroupInfo) Reset() {
-	*x = GroupInfo{}
+func (x *DKGStatusRequest) Reset() {
+	*x = DKGStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[5]
+		mi := &file_drand_control_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupInfo) String() string {
+func (x *DKGStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupInfo) ProtoMessage() {}
+func (*DKGStatusRequest) ProtoMessage() {}
 
-func (x *GroupInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[5]
+func (x *DKGStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_drand_control_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -514,75 +690,718 @@ func (x *GroupInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupInfo.ProtoReflect.Descriptor instead.
-func (*GroupInfo) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use DKGStatusRequest.ProtoReflect.Descriptor instead.
+func (*DKGStatusRequest) Descriptor() ([]byte, []int) {
+	return file_drand_control_proto_rawDescGZIP(), []int{7}
 }
 
-func (m *GroupInfo) GetLocation() isGroupInfo_Location {
-	if m != nil {
-		return m.Location
+func (x *DKGStatusRequest) GetWatch() bool {
+	if x != nil {
+		return x.Watch
+	}
+	return false
+}
+
+func (x *DKGStatusRequest) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
 	}
 	return nil
 }
 
-func (x *GroupInfo) GetPath() string {
-	if x, ok := x.GetLocation().(*GroupInfo_Path); ok {
-		return x.Path
+// DKGProgress is the progress of a DKG or resharing as seen by this node
+type DKGProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reshare bool `protobuf:"varint,1,opt,name=reshare,proto3" json:"reshare,omitempty"`
+	// phase of the protocol: 0 is not started, 1 deal, 2 response,
+	// 3 justification and 4 finished
+	Phase uint32 `protobuf:"varint,2,opt,name=phase,proto3" json:"phase,omitempty"`
+	// unix time at which the current phase ends
+	PhaseDeadline int64 `protobuf:"varint,3,opt,name=phase_deadline,json=phaseDeadline,proto3" json:"phase_deadline,omitempty"`
+	// dealers send deals and justifications: they are the nodes of the old
+	// group during a resharing
+	Dealers []*DKGParticipant `protobuf:"bytes,4,rep,name=dealers,proto3" json:"dealers,omitempty"`
+	// share holders send responses: they are the nodes of the new group
+	// during a resharing
+	ShareHolders []*DKGParticipant `protobuf:"bytes,5,rep,name=share_holders,json=shareHolders,proto3" json:"share_holders,omitempty"`
+	Complaints   []*DKGComplaint   `protobuf:"bytes,6,rep,name=complaints,proto3" json:"complaints,omitempty"`
+	// indexes of the qualified share holders, once the DKG is done
+	Qual []uint32 `protobuf:"varint,7,rep,packed,name=qual,proto3" json:"qual,omitempty"`
+	Done bool     `protobuf:"varint,8,opt,name=done,proto3" json:"done,omitempty"`
+	// error of the DKG, if it failed
+	Error    string           `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	Metadata *common.Metadata `protobuf:"bytes,10,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *DKGProgress) Reset() {
+	*x = DKGProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drand_control_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *GroupInfo) GetUrl() string {
-	if x, ok := x.GetLocation().(*GroupInfo_Url); ok {
-		return x.Url
+func (x *DKGProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DKGProgress) ProtoMessage() {}
+
+func (x *DKGProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_drand_control_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type isGroupInfo_Location interface {
-	isGroupInfo_Location()
+// Deprecated: Use DKGProgress.ProtoReflect.Descriptor instead.
+func (*DKGProgress) Descriptor() ([]byte, []int) {
+	return file_drand_control_proto_rawDescGZIP(), []int{8}
 }
 
-type GroupInfo_Path struct {
-	Path string `protobuf:"bytes,1,opt,name=path,proto3,oneof"`
+func (x *DKGProgress) GetReshare() bool {
+	if x != nil {
+		return x.Reshare
+	}
+	return false
 }
 
-type GroupInfo_Url struct {
-	// XXX not implemented
-	Url string `protobuf:"bytes,2,opt,name=url,proto3,oneof"`
+func (x *DKGProgress) GetPhase() uint32 {
+	if x != nil {
+		return x.Phase
+	}
+	return 0
+}
+
+func (x *DKGProgress) GetPhaseDeadline() int64 {
+	if x != nil {
+		return x.PhaseDeadline
+	}
+	return 0
+}
+
+func (x *DKGProgress) GetDealers() []*DKGParticipant {
+	if x != nil {
+		return x.Dealers
+	}
+	return nil
+}
+
+func (x *DKGProgress) GetShareHolders() []*DKGParticipant {
+	if x != nil {
+		return x.ShareHolders
+	}
+	return nil
+}
+
+func (x *DKGProgress) GetComplaints() []*DKGComplaint {
+	if x != nil {
+		return x.Complaints
+	}
+	return nil
+}
+
+func (x *DKGProgress) GetQual() []uint32 {
+	if x != nil {
+		return x.Qual
+	}
+	return nil
+}
+
+func (x *DKGProgress) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *DKGProgress) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DKGProgress) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// DKGParticipant tells which packets of a node were received
+type DKGParticipant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index         uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Deal          bool   `protobuf:"varint,3,opt,name=deal,proto3" json:"deal,omitempty"`
+	Response      bool   `protobuf:"varint,4,opt,name=response,proto3" json:"response,omitempty"`
+	Justification bool   `protobuf:"varint,5,opt,name=justification,proto3" json:"justification,omitempty"`
+}
+
+func (x *DKGParticipant) Reset() {
+	*x = DKGParticipant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drand_control_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DKGParticipant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DKGParticipant) ProtoMessage() {}
+
+func (x *DKGParticipant) ProtoReflect() protoreflect.Message {
+	mi := &file_drand_control_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DKGParticipant.ProtoReflect.Descriptor instead.
+func (*DKGParticipant) Descriptor() ([]byte, []int) {
+	return file_drand_control_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DKGParticipant) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *DKGParticipant) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *DKGParticipant) GetDeal() bool {
+	if x != nil {
+		return x.Deal
+	}
+	return false
+}
+
+func (x *DKGParticipant) GetResponse() bool {
+	if x != nil {
+		return x.Response
+	}
+	return false
+}
+
+func (x *DKGParticipant) GetJustification() bool {
+	if x != nil {
+		return x.Justification
+	}
+	return false
+}
+
+// DKGComplaint is a share holder complaining about the deal of a dealer
+type DKGComplaint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShareHolder uint32 `protobuf:"varint,1,opt,name=share_holder,json=shareHolder,proto3" json:"share_holder,omitempty"`
+	Dealer      uint32 `protobuf:"varint,2,opt,name=dealer,proto3" json:"dealer,omitempty"`
+}
+
+func (x *DKGComplaint) Reset() {
+	*x = DKGComplaint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drand_control_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DKGComplaint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DKGComplaint) ProtoMessage() {}
+
+func (x *DKGComplaint) ProtoReflect() protoreflect.Message {
+	mi := &file_drand_control_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DKGComplaint.ProtoReflect.Descriptor instead.
+func (*DKGComplaint) Descriptor() ([]byte, []int) {
+	return file_drand_control_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DKGComplaint) GetShareHolder() uint32 {
+	if x != nil {
+		return x.ShareHolder
+	}
+	return 0
+}
+
+func (x *DKGComplaint) GetDealer() uint32 {
+	if x != nil {
+		return x.Dealer
+	}
+	return 0
+}
+
+// GroupInfo holds the information to load a group information such as the nodes
+// and the genesis etc. Currently only the loading of a group via filesystem is
+// supported although the basis to support loading a group from a URI is setup.
+// For example, for new nodes that wants to join a network, they could point to
+// the URL that returns a group definition, for example at one of the currently
+// running node.
+type GroupInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Location:
+	//	*GroupInfo_Path
+	//	*GroupInfo_Url
+	Location isGroupInfo_Location `protobuf_oneof:"location"`
+}
+
+func (x *GroupInfo) Reset() {
+	*x = GroupInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drand_control_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupInfo) ProtoMessage() {}
+
+func (x *GroupInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_drand_control_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupInfo.ProtoReflect.Descriptor instead.
+func (*GroupInfo) Descriptor() ([]byte, []int) {
+	return file_drand_control_proto_rawDescGZIP(), []int{11}
+}
+
+func (m *GroupInfo) GetLocation() isGroupInfo_Location {
+	if m != nil {
+		return m.Location
+	}
+	return nil
+}
+
+func (x *GroupInfo) GetPath() string {
+	if x, ok := x.GetLocation().(*GroupInfo_Path); ok {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GroupInfo) GetUrl() string {
+	if x, ok := x.GetLocation().(*GroupInfo_Url); ok {
+		return x.Url
+	}
+	return ""
+}
+
+type isGroupInfo_Location interface {
+	isGroupInfo_Location()
+}
+
+type GroupInfo_Path struct {
+	Path string `protobuf:"bytes,1,opt,name=path,proto3,oneof"`
+}
+
+type GroupInfo_Url struct {
+	// XXX not implemented
+	Url string `protobuf:"bytes,2,opt,name=url,proto3,oneof"`
+}
+
+func (*GroupInfo_Path) isGroupInfo_Location() {}
+
+func (*GroupInfo_Url) isGroupInfo_Location() {}
+
+// ShareRequest requests the private share of a drand node
+type ShareRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *ShareRequest) Reset() {
+	*x = ShareRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drand_control_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareRequest) ProtoMessage() {}
+
+func (x *ShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_drand_control_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareRequest.ProtoReflect.Descriptor instead.
+func (*ShareRequest) Descriptor() ([]byte, []int) {
+	return file_drand_control_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ShareRequest) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// ShareResponse holds the private share of a drand node
+type ShareResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index    uint32           `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Share    []byte           `protobuf:"bytes,3,opt,name=share,proto3" json:"share,omitempty"`
+	Metadata *common.Metadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *ShareResponse) Reset() {
+	*x = ShareResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drand_control_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareResponse) ProtoMessage() {}
+
+func (x *ShareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_drand_control_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareResponse.ProtoReflect.Descriptor instead.
+func (*ShareResponse) Descriptor() ([]byte, []int) {
+	return file_drand_control_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ShareResponse) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ShareResponse) GetShare() []byte {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+func (x *ShareResponse) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type Ping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *Ping) Reset() {
+	*x = Ping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drand_control_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ping) ProtoMessage() {}
+
+func (x *Ping) ProtoReflect() protoreflect.Message {
+	mi := &file_drand_control_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ping.ProtoReflect.Descriptor instead.
+func (*Ping) Descriptor() ([]byte, []int) {
+	return file_drand_control_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Ping) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type Pong struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *Pong) Reset() {
+	*x = Pong{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drand_control_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pong) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pong) ProtoMessage() {}
+
+func (x *Pong) ProtoReflect() protoreflect.Message {
+	mi := &file_drand_control_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pong.ProtoReflect.Descriptor instead.
+func (*Pong) Descriptor() ([]byte, []int) {
+	return file_drand_control_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Pong) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// RemoteStatusRequest contains the list of addresses that the local drand node
+// process should ask the status to.
+type RemoteStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata  *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Addresses []*Address       `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (x *RemoteStatusRequest) Reset() {
+	*x = RemoteStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drand_control_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoteStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteStatusRequest) ProtoMessage() {}
+
+func (x *RemoteStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_drand_control_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteStatusRequest.ProtoReflect.Descriptor instead.
+func (*RemoteStatusRequest) Descriptor() ([]byte, []int) {
+	return file_drand_control_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RemoteStatusRequest) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *RemoteStatusRequest) GetAddresses() []*Address {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+// RemoteStatusResponse contains the statuses reponses of all nodes given in the
+// requests. If a node did not reply, then the address key is absent from the
+// map
+type RemoteStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Statuses map[string]*StatusResponse `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *RemoteStatusResponse) Reset() {
+	*x = RemoteStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drand_control_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoteStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteStatusResponse) ProtoMessage() {}
+
+func (x *RemoteStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_drand_control_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteStatusResponse.ProtoReflect.Descriptor instead.
+func (*RemoteStatusResponse) Descriptor() ([]byte, []int) {
+	return file_drand_control_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RemoteStatusResponse) GetStatuses() map[string]*StatusResponse {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
 }
 
-func (*GroupInfo_Path) isGroupInfo_Location() {}
-
-func (*GroupInfo_Url) isGroupInfo_Location() {}
-
-// ShareRequest requests the private share of a drand node
-type ShareRequest struct {
+// ParticipationRequest asks for the participation records of the last rounds
+type ParticipationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Metadata *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// rounds is the number of rounds to return, all the rounds kept if 0
+	Rounds uint32 `protobuf:"varint,2,opt,name=rounds,proto3" json:"rounds,omitempty"`
 }
 
-func (x *ShareRequest) Reset() {
-	*x = ShareRequest{}
+func (x *ParticipationRequest) Reset() {
+	*x = ParticipationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[6]
+		mi := &file_drand_control_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ShareRequest) String() string {
+func (x *ParticipationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShareRequest) ProtoMessage() {}
+func (*ParticipationRequest) ProtoMessage() {}
 
-func (x *ShareRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[6]
+func (x *ParticipationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_drand_control_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -593,46 +1412,54 @@ func (x *ShareRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShareRequest.ProtoReflect.Descriptor instead.
-func (*ShareRequest) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ParticipationRequest.ProtoReflect.Descriptor instead.
+func (*ParticipationRequest) Descriptor() ([]byte, []int) {
+	return file_drand_control_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ShareRequest) GetMetadata() *common.Metadata {
+func (x *ParticipationRequest) GetMetadata() *common.Metadata {
 	if x != nil {
 		return x.Metadata
 	}
 	return nil
 }
 
-// ShareResponse holds the private share of a drand node
-type ShareResponse struct {
+func (x *ParticipationRequest) GetRounds() uint32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+// PartialArrival records a valid partial beacon received for a round
+type PartialArrival struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Index    uint32           `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
-	Share    []byte           `protobuf:"bytes,3,opt,name=share,proto3" json:"share,omitempty"`
-	Metadata *common.Metadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// latency is the delay in nanoseconds between the time of the round and
+	// the arrival of the partial beacon
+	Latency int64 `protobuf:"varint,2,opt,name=latency,proto3" json:"latency,omitempty"`
 }
 
-func (x *ShareResponse) Reset() {
-	*x = ShareResponse{}
+func (x *PartialArrival) Reset() {
+	*x = PartialArrival{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[7]
+		mi := &file_drand_control_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ShareResponse) String() string {
+func (x *PartialArrival) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShareResponse) ProtoMessage() {}
+func (*PartialArrival) ProtoMessage() {}
 
-func (x *ShareResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[7]
+func (x *PartialArrival) ProtoReflect() protoreflect.Message {
+	mi := &file_drand_control_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -643,57 +1470,58 @@ func (x *ShareResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShareResponse.ProtoReflect.Descriptor instead.
-func (*ShareResponse) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use PartialArrival.ProtoReflect.Descriptor instead.
+func (*PartialArrival) Descriptor() ([]byte, []int) {
+	return file_drand_control_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ShareResponse) GetIndex() uint32 {
+func (x *PartialArrival) GetIndex() uint32 {
 	if x != nil {
 		return x.Index
 	}
 	return 0
 }
 
-func (x *ShareResponse) GetShare() []byte {
-	if x != nil {
-		return x.Share
-	}
-	return nil
-}
-
-func (x *ShareResponse) GetMetadata() *common.Metadata {
+func (x *PartialArrival) GetLatency() int64 {
 	if x != nil {
-		return x.Metadata
+		return x.Latency
 	}
-	return nil
+	return 0
 }
 
-type Ping struct {
+// RoundParticipation records the partial beacons received for a round
+type RoundParticipation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Metadata *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Round    uint64            `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Partials []*PartialArrival `protobuf:"bytes,2,rep,name=partials,proto3" json:"partials,omitempty"`
+	// invalid is the number of partial beacons received that didn't verify.
+	// They are not attributed to a node, since the index they claim is not
+	// authenticated.
+	Invalid uint32 `protobuf:"varint,3,opt,name=invalid,proto3" json:"invalid,omitempty"`
+	// aggregated is true if this node recovered the beacon from the partials
+	Aggregated bool `protobuf:"varint,4,opt,name=aggregated,proto3" json:"aggregated,omitempty"`
 }
 
-func (x *Ping) Reset() {
-	*x = Ping{}
+func (x *RoundParticipation) Reset() {
+	*x = RoundParticipation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[8]
+		mi := &file_drand_control_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Ping) String() string {
+func (x *RoundParticipation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Ping) ProtoMessage() {}
+func (*RoundParticipation) ProtoMessage() {}
 
-func (x *Ping) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[8]
+func (x *RoundParticipation) ProtoReflect() protoreflect.Message {
+	mi := &file_drand_control_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -704,43 +1532,71 @@ func (x *Ping) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Ping.ProtoReflect.Descriptor instead.
-func (*Ping) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use RoundParticipation.ProtoReflect.Descriptor instead.
+func (*RoundParticipation) Descriptor() ([]byte, []int) {
+	return file_drand_control_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *Ping) GetMetadata() *common.Metadata {
+func (x *RoundParticipation) GetRound() uint64 {
 	if x != nil {
-		return x.Metadata
+		return x.Round
+	}
+	return 0
+}
+
+func (x *RoundParticipation) GetPartials() []*PartialArrival {
+	if x != nil {
+		return x.Partials
 	}
 	return nil
 }
 
-type Pong struct {
+func (x *RoundParticipation) GetInvalid() uint32 {
+	if x != nil {
+		return x.Invalid
+	}
+	return 0
+}
+
+func (x *RoundParticipation) GetAggregated() bool {
+	if x != nil {
+		return x.Aggregated
+	}
+	return false
+}
+
+// ParticipationResponse holds the participation records of the last rounds,
+// sorted by round
+type ParticipationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Metadata *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Rounds    []*RoundParticipation `protobuf:"bytes,1,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	GroupSize uint32                `protobuf:"varint,2,opt,name=group_size,json=groupSize,proto3" json:"group_size,omitempty"`
+	Threshold uint32                `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Metadata  *common.Metadata      `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// indexes lists the index of the nodes of the group
+	Indexes []uint32 `protobuf:"varint,5,rep,packed,name=indexes,proto3" json:"indexes,omitempty"`
 }
 
-func (x *Pong) Reset() {
-	*x = Pong{}
+func (x *ParticipationResponse) Reset() {
+	*x = ParticipationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[9]
+		mi := &file_drand_control_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Pong) String() string {
+func (x *ParticipationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pong) ProtoMessage() {}
+func (*ParticipationResponse) ProtoMessage() {}
 
-func (x *Pong) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[9]
+func (x *ParticipationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_drand_control_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -751,46 +1607,73 @@ func (x *Pong) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pong.ProtoReflect.Descriptor instead.
-func (*Pong) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ParticipationResponse.ProtoReflect.Descriptor instead.
+func (*ParticipationResponse) Descriptor() ([]byte, []int) {
+	return file_drand_control_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *Pong) GetMetadata() *common.Metadata {
+func (x *ParticipationResponse) GetRounds() []*RoundParticipation {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
+}
+
+func (x *ParticipationResponse) GetGroupSize() uint32 {
+	if x != nil {
+		return x.GroupSize
+	}
+	return 0
+}
+
+func (x *ParticipationResponse) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *ParticipationResponse) GetMetadata() *common.Metadata {
 	if x != nil {
 		return x.Metadata
 	}
 	return nil
 }
 
-// RemoteStatusRequest contains the list of addresses that the local drand node
-// process should ask the status to.
-type RemoteStatusRequest struct {
+func (x *ParticipationResponse) GetIndexes() []uint32 {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+type ScheduleHaltRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Metadata  *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Addresses []*Address       `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// the last round of the chain
+	Round    uint64           `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Metadata *common.Metadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (x *RemoteStatusRequest) Reset() {
-	*x = RemoteStatusRequest{}
+func (x *ScheduleHaltRequest) Reset() {
+	*x = ScheduleHaltRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[10]
+		mi := &file_drand_control_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoteStatusRequest) String() string {
+func (x *ScheduleHaltRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoteStatusRequest) ProtoMessage() {}
+func (*ScheduleHaltRequest) ProtoMessage() {}
 
-func (x *RemoteStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[10]
+func (x *ScheduleHaltRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_drand_control_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -801,53 +1684,56 @@ func (x *RemoteStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoteStatusRequest.ProtoReflect.Descriptor instead.
-func (*RemoteStatusRequest) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ScheduleHaltRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleHaltRequest) Descriptor() ([]byte, []int) {
+	return file_drand_control_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *RemoteStatusRequest) GetMetadata() *common.Metadata {
+func (x *ScheduleHaltRequest) GetRound() uint64 {
 	if x != nil {
-		return x.Metadata
+		return x.Round
 	}
-	return nil
+	return 0
 }
 
-func (x *RemoteStatusRequest) GetAddresses() []*Address {
+func (x *ScheduleHaltRequest) GetMetadata() *common.Metadata {
 	if x != nil {
-		return x.Addresses
+		return x.Metadata
 	}
 	return nil
 }
 
-// RemoteStatusResponse contains the statuses reponses of all nodes given in the
-// requests. If a node did not reply, then the address key is absent from the
-// map
-type RemoteStatusResponse struct {
+type ScheduleHaltResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Statuses map[string]*StatusResponse `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Round uint64 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	// indexes of the nodes that voted for ending the chain at that round
+	Votes     []uint32 `protobuf:"varint,2,rep,packed,name=votes,proto3" json:"votes,omitempty"`
+	Threshold uint32   `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// addresses of the nodes the vote couldn't be sent to
+	Unreachable []string         `protobuf:"bytes,4,rep,name=unreachable,proto3" json:"unreachable,omitempty"`
+	Metadata    *common.Metadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (x *RemoteStatusResponse) Reset() {
-	*x = RemoteStatusResponse{}
+func (x *ScheduleHaltResponse) Reset() {
+	*x = ScheduleHaltResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[11]
+		mi := &file_drand_control_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoteStatusResponse) String() string {
+func (x *ScheduleHaltResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoteStatusResponse) ProtoMessage() {}
+func (*ScheduleHaltResponse) ProtoMessage() {}
 
-func (x *RemoteStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[11]
+func (x *ScheduleHaltResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_drand_control_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -858,14 +1744,42 @@ func (x *RemoteStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoteStatusResponse.ProtoReflect.Descriptor instead.
-func (*RemoteStatusResponse) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ScheduleHaltResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleHaltResponse) Descriptor() ([]byte, []int) {
+	return file_drand_control_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *RemoteStatusResponse) GetStatuses() map[string]*StatusResponse {
+func (x *ScheduleHaltResponse) GetRound() uint64 {
 	if x != nil {
-		return x.Statuses
+		return x.Round
+	}
+	return 0
+}
+
+func (x *ScheduleHaltResponse) GetVotes() []uint32 {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+func (x *ScheduleHaltResponse) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *ScheduleHaltResponse) GetUnreachable() []string {
+	if x != nil {
+		return x.Unreachable
+	}
+	return nil
+}
+
+func (x *ScheduleHaltResponse) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
 	}
 	return nil
 }
@@ -881,7 +1795,7 @@ type ListSchemesRequest struct {
 func (x *ListSchemesRequest) Reset() {
 	*x = ListSchemesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[12]
+		mi := &file_drand_control_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -894,7 +1808,7 @@ func (x *ListSchemesRequest) String() string {
 func (*ListSchemesRequest) ProtoMessage() {}
 
 func (x *ListSchemesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[12]
+	mi := &file_drand_control_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -907,7 +1821,7 @@ func (x *ListSchemesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSchemesRequest.ProtoReflect.Descriptor instead.
 func (*ListSchemesRequest) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{12}
+	return file_drand_control_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ListSchemesRequest) GetMetadata() *common.Metadata {
@@ -929,7 +1843,7 @@ type ListSchemesResponse struct {
 func (x *ListSchemesResponse) Reset() {
 	*x = ListSchemesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[13]
+		mi := &file_drand_control_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -942,7 +1856,7 @@ func (x *ListSchemesResponse) String() string {
 func (*ListSchemesResponse) ProtoMessage() {}
 
 func (x *ListSchemesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[13]
+	mi := &file_drand_control_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -955,7 +1869,7 @@ func (x *ListSchemesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSchemesResponse.ProtoReflect.Descriptor instead.
 func (*ListSchemesResponse) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{13}
+	return file_drand_control_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ListSchemesResponse) GetIds() []string {
@@ -983,7 +1897,7 @@ type ListBeaconIDsRequest struct {
 func (x *ListBeaconIDsRequest) Reset() {
 	*x = ListBeaconIDsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[14]
+		mi := &file_drand_control_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -996,7 +1910,7 @@ func (x *ListBeaconIDsRequest) String() string {
 func (*ListBeaconIDsRequest) ProtoMessage() {}
 
 func (x *ListBeaconIDsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[14]
+	mi := &file_drand_control_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1009,7 +1923,7 @@ func (x *ListBeaconIDsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBeaconIDsRequest.ProtoReflect.Descriptor instead.
 func (*ListBeaconIDsRequest) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{14}
+	return file_drand_control_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ListBeaconIDsRequest) GetMetadata() *common.Metadata {
@@ -1031,7 +1945,7 @@ type ListBeaconIDsResponse struct {
 func (x *ListBeaconIDsResponse) Reset() {
 	*x = ListBeaconIDsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[15]
+		mi := &file_drand_control_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1044,7 +1958,7 @@ func (x *ListBeaconIDsResponse) String() string {
 func (*ListBeaconIDsResponse) ProtoMessage() {}
 
 func (x *ListBeaconIDsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[15]
+	mi := &file_drand_control_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1057,7 +1971,7 @@ func (x *ListBeaconIDsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBeaconIDsResponse.ProtoReflect.Descriptor instead.
 func (*ListBeaconIDsResponse) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{15}
+	return file_drand_control_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ListBeaconIDsResponse) GetIds() []string {
@@ -1086,7 +2000,7 @@ type PublicKeyRequest struct {
 func (x *PublicKeyRequest) Reset() {
 	*x = PublicKeyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[16]
+		mi := &file_drand_control_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1099,7 +2013,7 @@ func (x *PublicKeyRequest) String() string {
 func (*PublicKeyRequest) ProtoMessage() {}
 
 func (x *PublicKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[16]
+	mi := &file_drand_control_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1112,7 +2026,7 @@ func (x *PublicKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublicKeyRequest.ProtoReflect.Descriptor instead.
 func (*PublicKeyRequest) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{16}
+	return file_drand_control_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *PublicKeyRequest) GetMetadata() *common.Metadata {
@@ -1135,7 +2049,7 @@ type PublicKeyResponse struct {
 func (x *PublicKeyResponse) Reset() {
 	*x = PublicKeyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[17]
+		mi := &file_drand_control_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1148,7 +2062,7 @@ func (x *PublicKeyResponse) String() string {
 func (*PublicKeyResponse) ProtoMessage() {}
 
 func (x *PublicKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[17]
+	mi := &file_drand_control_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1161,7 +2075,7 @@ func (x *PublicKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublicKeyResponse.ProtoReflect.Descriptor instead.
 func (*PublicKeyResponse) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{17}
+	return file_drand_control_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *PublicKeyResponse) GetPubKey() []byte {
@@ -1190,7 +2104,7 @@ type PrivateKeyRequest struct {
 func (x *PrivateKeyRequest) Reset() {
 	*x = PrivateKeyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[18]
+		mi := &file_drand_control_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1203,7 +2117,7 @@ func (x *PrivateKeyRequest) String() string {
 func (*PrivateKeyRequest) ProtoMessage() {}
 
 func (x *PrivateKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[18]
+	mi := &file_drand_control_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1216,7 +2130,7 @@ func (x *PrivateKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrivateKeyRequest.ProtoReflect.Descriptor instead.
 func (*PrivateKeyRequest) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{18}
+	return file_drand_control_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *PrivateKeyRequest) GetMetadata() *common.Metadata {
@@ -1239,7 +2153,7 @@ type PrivateKeyResponse struct {
 func (x *PrivateKeyResponse) Reset() {
 	*x = PrivateKeyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[19]
+		mi := &file_drand_control_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1252,7 +2166,7 @@ func (x *PrivateKeyResponse) String() string {
 func (*PrivateKeyResponse) ProtoMessage() {}
 
 func (x *PrivateKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[19]
+	mi := &file_drand_control_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1265,7 +2179,7 @@ func (x *PrivateKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrivateKeyResponse.ProtoReflect.Descriptor instead.
 func (*PrivateKeyResponse) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{19}
+	return file_drand_control_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *PrivateKeyResponse) GetPriKey() []byte {
@@ -1294,7 +2208,7 @@ type CokeyRequest struct {
 func (x *CokeyRequest) Reset() {
 	*x = CokeyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[20]
+		mi := &file_drand_control_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1307,7 +2221,7 @@ func (x *CokeyRequest) String() string {
 func (*CokeyRequest) ProtoMessage() {}
 
 func (x *CokeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[20]
+	mi := &file_drand_control_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1320,7 +2234,7 @@ func (x *CokeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CokeyRequest.ProtoReflect.Descriptor instead.
 func (*CokeyRequest) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{20}
+	return file_drand_control_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *CokeyRequest) GetMetadata() *common.Metadata {
@@ -1343,7 +2257,7 @@ type CokeyResponse struct {
 func (x *CokeyResponse) Reset() {
 	*x = CokeyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[21]
+		mi := &file_drand_control_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1356,7 +2270,7 @@ func (x *CokeyResponse) String() string {
 func (*CokeyResponse) ProtoMessage() {}
 
 func (x *CokeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[21]
+	mi := &file_drand_control_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1369,7 +2283,7 @@ func (x *CokeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CokeyResponse.ProtoReflect.Descriptor instead.
 func (*CokeyResponse) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{21}
+	return file_drand_control_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *CokeyResponse) GetCoKey() []byte {
@@ -1399,7 +2313,7 @@ type GroupTOMLResponse struct {
 func (x *GroupTOMLResponse) Reset() {
 	*x = GroupTOMLResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[22]
+		mi := &file_drand_control_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1412,7 +2326,7 @@ func (x *GroupTOMLResponse) String() string {
 func (*GroupTOMLResponse) ProtoMessage() {}
 
 func (x *GroupTOMLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[22]
+	mi := &file_drand_control_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1425,7 +2339,7 @@ func (x *GroupTOMLResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupTOMLResponse.ProtoReflect.Descriptor instead.
 func (*GroupTOMLResponse) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{22}
+	return file_drand_control_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GroupTOMLResponse) GetGroupToml() string {
@@ -1453,7 +2367,7 @@ type ShutdownRequest struct {
 func (x *ShutdownRequest) Reset() {
 	*x = ShutdownRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[23]
+		mi := &file_drand_control_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1466,7 +2380,7 @@ func (x *ShutdownRequest) String() string {
 func (*ShutdownRequest) ProtoMessage() {}
 
 func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[23]
+	mi := &file_drand_control_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1479,7 +2393,7 @@ func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShutdownRequest.ProtoReflect.Descriptor instead.
 func (*ShutdownRequest) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{23}
+	return file_drand_control_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ShutdownRequest) GetMetadata() *common.Metadata {
@@ -1500,7 +2414,7 @@ type ShutdownResponse struct {
 func (x *ShutdownResponse) Reset() {
 	*x = ShutdownResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[24]
+		mi := &file_drand_control_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1513,7 +2427,7 @@ func (x *ShutdownResponse) String() string {
 func (*ShutdownResponse) ProtoMessage() {}
 
 func (x *ShutdownResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[24]
+	mi := &file_drand_control_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1526,7 +2440,7 @@ func (x *ShutdownResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShutdownResponse.ProtoReflect.Descriptor instead.
 func (*ShutdownResponse) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{24}
+	return file_drand_control_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ShutdownResponse) GetMetadata() *common.Metadata {
@@ -1547,7 +2461,7 @@ type LoadBeaconRequest struct {
 func (x *LoadBeaconRequest) Reset() {
 	*x = LoadBeaconRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[25]
+		mi := &file_drand_control_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1560,7 +2474,7 @@ func (x *LoadBeaconRequest) String() string {
 func (*LoadBeaconRequest) ProtoMessage() {}
 
 func (x *LoadBeaconRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[25]
+	mi := &file_drand_control_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1573,7 +2487,7 @@ func (x *LoadBeaconRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoadBeaconRequest.ProtoReflect.Descriptor instead.
 func (*LoadBeaconRequest) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{25}
+	return file_drand_control_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *LoadBeaconRequest) GetMetadata() *common.Metadata {
@@ -1594,7 +2508,7 @@ type LoadBeaconResponse struct {
 func (x *LoadBeaconResponse) Reset() {
 	*x = LoadBeaconResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[26]
+		mi := &file_drand_control_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1607,7 +2521,7 @@ func (x *LoadBeaconResponse) String() string {
 func (*LoadBeaconResponse) ProtoMessage() {}
 
 func (x *LoadBeaconResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[26]
+	mi := &file_drand_control_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1620,7 +2534,7 @@ func (x *LoadBeaconResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoadBeaconResponse.ProtoReflect.Descriptor instead.
 func (*LoadBeaconResponse) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{26}
+	return file_drand_control_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *LoadBeaconResponse) GetMetadata() *common.Metadata {
@@ -1655,7 +2569,7 @@ type StartSyncRequest struct {
 func (x *StartSyncRequest) Reset() {
 	*x = StartSyncRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[27]
+		mi := &file_drand_control_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1668,7 +2582,7 @@ func (x *StartSyncRequest) String() string {
 func (*StartSyncRequest) ProtoMessage() {}
 
 func (x *StartSyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[27]
+	mi := &file_drand_control_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1681,7 +2595,7 @@ func (x *StartSyncRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartSyncRequest.ProtoReflect.Descriptor instead.
 func (*StartSyncRequest) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{27}
+	return file_drand_control_proto_rawDescGZIP(), []int{39}
 }
 
 // Deprecated: Do not use.
@@ -1733,7 +2647,7 @@ type SyncProgress struct {
 func (x *SyncProgress) Reset() {
 	*x = SyncProgress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[28]
+		mi := &file_drand_control_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1746,7 +2660,7 @@ func (x *SyncProgress) String() string {
 func (*SyncProgress) ProtoMessage() {}
 
 func (x *SyncProgress) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[28]
+	mi := &file_drand_control_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1759,7 +2673,7 @@ func (x *SyncProgress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncProgress.ProtoReflect.Descriptor instead.
 func (*SyncProgress) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{28}
+	return file_drand_control_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *SyncProgress) GetCurrent() uint64 {
@@ -1795,7 +2709,7 @@ type BackupDBRequest struct {
 func (x *BackupDBRequest) Reset() {
 	*x = BackupDBRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[29]
+		mi := &file_drand_control_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1808,7 +2722,7 @@ func (x *BackupDBRequest) String() string {
 func (*BackupDBRequest) ProtoMessage() {}
 
 func (x *BackupDBRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[29]
+	mi := &file_drand_control_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1821,7 +2735,7 @@ func (x *BackupDBRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BackupDBRequest.ProtoReflect.Descriptor instead.
 func (*BackupDBRequest) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{29}
+	return file_drand_control_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *BackupDBRequest) GetOutputFile() string {
@@ -1849,7 +2763,7 @@ type BackupDBResponse struct {
 func (x *BackupDBResponse) Reset() {
 	*x = BackupDBResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_control_proto_msgTypes[30]
+		mi := &file_drand_control_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1862,7 +2776,7 @@ func (x *BackupDBResponse) String() string {
 func (*BackupDBResponse) ProtoMessage() {}
 
 func (x *BackupDBResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_control_proto_msgTypes[30]
+	mi := &file_drand_control_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1875,7 +2789,7 @@ func (x *BackupDBResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BackupDBResponse.ProtoReflect.Descriptor instead.
 func (*BackupDBResponse) Descriptor() ([]byte, []int) {
-	return file_drand_control_proto_rawDescGZIP(), []int{30}
+	return file_drand_control_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *BackupDBResponse) GetMetadata() *common.Metadata {
@@ -1914,7 +2828,7 @@ var file_drand_control_proto_rawDesc = []byte{
 	0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
 	0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
 	0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xff, 0x01, 0x0a, 0x0d, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x4b,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd5, 0x02, 0x0a, 0x0d, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x4b,
 	0x47, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x53, 0x65,
 	0x74, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x04, 0x69,
@@ -1930,244 +2844,383 @@ var file_drand_control_proto_rawDesc = []byte{
 	0x08, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74,
 	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f,
 	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x45, 0x0a, 0x15, 0x49, 0x6e, 0x69, 0x74, 0x44,
-	0x4b, 0x47, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6f,
-	0x0a, 0x0b, 0x45, 0x6e, 0x74, 0x72, 0x6f, 0x70, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x6c,
-	0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x6c,
-	0x79, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4d,
+	0x73, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x61, 0x74, 0x63, 0x68, 0x75, 0x70, 0x5f, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x5f, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x63, 0x61,
+	0x74, 0x63, 0x68, 0x75, 0x70, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4d, 0x73, 0x22, 0x87, 0x01,
+	0x0a, 0x0b, 0x44, 0x4b, 0x47, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x28, 0x0a,
+	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64,
+	0x72, 0x61, 0x6e, 0x64, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0xc9, 0x01, 0x0a, 0x17, 0x49, 0x6e, 0x69, 0x74,
+	0x44, 0x4b, 0x47, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x6c, 0x65, 0x73, 0x73, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x44, 0x4b,
+	0x47, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x12, 0x2c, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x6f, 0x70, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x45, 0x6e, 0x74,
+	0x72, 0x6f, 0x70, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x6f, 0x70,
+	0x79, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x22, 0x0a, 0x03, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x64,
+	0x72, 0x61, 0x6e, 0x64, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x03,
+	0x6f, 0x6c, 0x64, 0x22, 0x45, 0x0a, 0x15, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x4b, 0x47, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6f, 0x0a, 0x0b, 0x45, 0x6e,
+	0x74, 0x72, 0x6f, 0x70, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x2c, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x84, 0x02, 0x0a, 0x11,
+	0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x12, 0x22, 0x0a, 0x03, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x03, 0x6f, 0x6c, 0x64, 0x12, 0x2a, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x75,
+	0x70, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x04, 0x69, 0x6e, 0x66,
+	0x6f, 0x12, 0x34, 0x0a, 0x16, 0x63, 0x61, 0x74, 0x63, 0x68, 0x75, 0x70, 0x5f, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x14, 0x63, 0x61, 0x74, 0x63, 0x68, 0x75, 0x70, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x74, 0x63, 0x68,
+	0x75, 0x70, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0d, 0x63, 0x61, 0x74, 0x63, 0x68, 0x75, 0x70, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2c,
+	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x76, 0x69, 0x63, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x69,
+	0x63, 0x74, 0x22, 0x56, 0x0a, 0x10, 0x44, 0x4b, 0x47, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x61, 0x74, 0x63, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x77, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2c, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xf2, 0x02, 0x0a, 0x0b, 0x44,
+	0x4b, 0x47, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x68,
+	0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x70, 0x68, 0x61, 0x73, 0x65, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x2f, 0x0a, 0x07, 0x64, 0x65, 0x61, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x44, 0x4b, 0x47, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x07, 0x64, 0x65, 0x61, 0x6c, 0x65,
+	0x72, 0x73, 0x12, 0x3a, 0x0a, 0x0d, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x68, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x72, 0x61, 0x6e,
+	0x64, 0x2e, 0x44, 0x4b, 0x47, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x52, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x33,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x44, 0x4b, 0x47, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69,
+	0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x71, 0x75, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0d, 0x52, 0x04, 0x71, 0x75, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74,
 	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22,
-	0xee, 0x01, 0x0a, 0x11, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x50,
-	0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x03, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x03, 0x6f, 0x6c, 0x64, 0x12, 0x2a, 0x0a, 0x04, 0x69, 0x6e, 0x66,
-	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e,
-	0x53, 0x65, 0x74, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52,
-	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x34, 0x0a, 0x16, 0x63, 0x61, 0x74, 0x63, 0x68, 0x75, 0x70,
-	0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x63, 0x61, 0x74, 0x63, 0x68, 0x75, 0x70, 0x50, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63,
-	0x61, 0x74, 0x63, 0x68, 0x75, 0x70, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x61, 0x74, 0x63, 0x68, 0x75, 0x70, 0x50, 0x65, 0x72, 0x69,
-	0x6f, 0x64, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05,
+	0x96, 0x01, 0x0a, 0x0e, 0x44, 0x4b, 0x47, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x64, 0x65, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x49, 0x0a, 0x0c, 0x44, 0x4b, 0x47, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x5f, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x65, 0x61, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x64, 0x65, 0x61,
+	0x6c, 0x65, 0x72, 0x22, 0x41, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x14, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x42, 0x0a, 0x0a, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3c, 0x0a, 0x0c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0x69, 0x0a, 0x0d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x34, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x34, 0x0a, 0x04, 0x50, 0x6f, 0x6e, 0x67, 0x12, 0x2c, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x71, 0x0a, 0x13, 0x52,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65,
 	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0x41, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x42, 0x0a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x3c, 0x0a, 0x0c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0x69, 0x0a, 0x0d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x12, 0x2c,
-	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x34, 0x0a, 0x04,
-	0x50, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x22, 0x34, 0x0a, 0x04, 0x50, 0x6f, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65,
+	0x12, 0x2c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0xb1,
+	0x01, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x72, 0x61, 0x6e,
+	0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x1a, 0x52,
+	0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x5c, 0x0a, 0x14, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65,
 	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63,
 	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x71, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f,
-	0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73,
+	0x22, 0x40, 0x0a, 0x0e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x72, 0x72, 0x69, 0x76,
+	0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x22, 0x97, 0x01, 0x0a, 0x12, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+	0x31, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61,
+	0x6c, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x22, 0xcf, 0x01, 0x0a,
+	0x15, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x22, 0x59,
+	0x0a, 0x13, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x48, 0x61, 0x6c, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2c, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xb0, 0x01, 0x0a, 0x14, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x48, 0x61, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x75, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2c,
+	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x42, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x55, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x44, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2c, 0x0a,
-	0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x14,
-	0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x52,
-	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x1a, 0x52, 0x0a, 0x0d, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0x42, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x57, 0x0a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x40, 0x0a, 0x10, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x59, 0x0a, 0x11, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70,
+	0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
 	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x22, 0x55, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x44, 0x0a, 0x14, 0x4c, 0x69,
-	0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x61, 0x74, 0x61, 0x22, 0x41, 0x0a, 0x11, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x5a, 0x0a, 0x12, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x72, 0x69, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x72,
+	0x69, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x3c, 0x0a, 0x0c, 0x43, 0x6f, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65,
 	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0x57, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x44,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x40, 0x0a, 0x10, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x59, 0x0a, 0x11, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x41, 0x0a, 0x11, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x5a, 0x0a, 0x12, 0x50, 0x72, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x06, 0x70, 0x72, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x22, 0x53, 0x0a, 0x0d, 0x43, 0x6f, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x63, 0x6f, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
 	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
 	0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3c, 0x0a, 0x0c, 0x43, 0x6f, 0x6b, 0x65, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x22, 0x53, 0x0a, 0x0d, 0x43, 0x6f, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x6f, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x60, 0x0a, 0x11, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x4f,
+	0x4d, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x74, 0x6f, 0x6d, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x6f, 0x6d, 0x6c, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3f, 0x0a, 0x0f, 0x53, 0x68, 0x75, 0x74, 0x64,
+	0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63,
 	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x60, 0x0a, 0x11, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x54, 0x4f, 0x4d, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
-	0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x74, 0x6f, 0x6d, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x6f, 0x6d, 0x6c, 0x12, 0x2c, 0x0a, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x40, 0x0a, 0x10, 0x53, 0x68, 0x75, 0x74,
+	0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
 	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3f, 0x0a, 0x0f, 0x53, 0x68,
-	0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x40, 0x0a, 0x10, 0x53,
-	0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x41, 0x0a, 0x11, 0x4c, 0x6f,
+	0x61, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x41, 0x0a,
-	0x11, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0x42, 0x0a, 0x12, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0xa3, 0x01, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x79,
-	0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x09, 0x69, 0x6e, 0x66,
-	0x6f, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01,
-	0x52, 0x08, 0x69, 0x6e, 0x66, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
-	0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73,
-	0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x74, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x69, 0x73, 0x54, 0x6c, 0x73, 0x12, 0x13, 0x0a, 0x05, 0x75, 0x70, 0x5f, 0x74, 0x6f,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x75, 0x70, 0x54, 0x6f, 0x12, 0x2c, 0x0a, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6e, 0x0a, 0x0c, 0x53, 0x79,
-	0x6e, 0x63, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x60, 0x0a, 0x0f, 0x42, 0x61,
-	0x63, 0x6b, 0x75, 0x70, 0x44, 0x42, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x2c,
-	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x40, 0x0a, 0x10,
-	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x42, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x32, 0xca,
-	0x08, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x26, 0x0a, 0x08, 0x50, 0x69,
-	0x6e, 0x67, 0x50, 0x6f, 0x6e, 0x67, 0x12, 0x0b, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x50,
-	0x69, 0x6e, 0x67, 0x1a, 0x0b, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x50, 0x6f, 0x6e, 0x67,
-	0x22, 0x00, 0x12, 0x37, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x2e, 0x64,
-	0x72, 0x61, 0x6e, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x4c,
-	0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x64, 0x72, 0x61,
-	0x6e, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f,
-	0x6e, 0x49, 0x44, 0x73, 0x12, 0x1b, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1c, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65,
-	0x61, 0x63, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x35, 0x0a, 0x07, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x4b, 0x47, 0x12, 0x14, 0x2e, 0x64,
-	0x72, 0x61, 0x6e, 0x64, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x4b, 0x47, 0x50, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x1a, 0x12, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0b, 0x49, 0x6e, 0x69, 0x74,
-	0x52, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x12, 0x18, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e,
-	0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x1a, 0x12, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50,
-	0x61, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x05, 0x53, 0x68, 0x61, 0x72, 0x65,
-	0x12, 0x13, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x53, 0x68,
-	0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a,
-	0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x2e, 0x64, 0x72, 0x61,
-	0x6e, 0x64, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x43, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x2e,
+	0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x42, 0x0a,
+	0x12, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0xa3, 0x01, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x09, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x69,
+	0x6e, 0x66, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x15, 0x0a,
+	0x06, 0x69, 0x73, 0x5f, 0x74, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69,
+	0x73, 0x54, 0x6c, 0x73, 0x12, 0x13, 0x0a, 0x05, 0x75, 0x70, 0x5f, 0x74, 0x6f, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x75, 0x70, 0x54, 0x6f, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6e, 0x0a, 0x0c, 0x53, 0x79, 0x6e, 0x63, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x60, 0x0a, 0x0f, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x44, 0x42, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x40, 0x0a, 0x10, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x44, 0x42, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x32, 0xec, 0x0a, 0x0a, 0x07,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x26, 0x0a, 0x08, 0x50, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x6e, 0x67, 0x12, 0x0b, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x50, 0x69, 0x6e, 0x67,
+	0x1a, 0x0b, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x50, 0x6f, 0x6e, 0x67, 0x22, 0x00, 0x12,
+	0x37, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x2e, 0x64, 0x72, 0x61, 0x6e,
+	0x64, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x15, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4c, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x44,
+	0x73, 0x12, 0x1b, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x35,
+	0x0a, 0x07, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x4b, 0x47, 0x12, 0x14, 0x2e, 0x64, 0x72, 0x61, 0x6e,
+	0x64, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x4b, 0x47, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x1a,
+	0x12, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x11, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x4b, 0x47,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x6c, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x2e, 0x64, 0x72, 0x61,
+	0x6e, 0x64, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x4b, 0x47, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x6c, 0x65, 0x73, 0x73, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x1a, 0x12, 0x2e, 0x64, 0x72, 0x61,
+	0x6e, 0x64, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x00,
+	0x12, 0x3d, 0x0a, 0x0b, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x12,
+	0x18, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x1a, 0x12, 0x2e, 0x64, 0x72, 0x61, 0x6e,
+	0x64, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x00, 0x12,
+	0x3c, 0x0a, 0x09, 0x44, 0x4b, 0x47, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x2e, 0x64,
+	0x72, 0x61, 0x6e, 0x64, 0x2e, 0x44, 0x4b, 0x47, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x44, 0x4b,
+	0x47, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x00, 0x30, 0x01, 0x12, 0x34, 0x0a,
+	0x05, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x13, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x72,
+	0x61, 0x6e, 0x64, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x12, 0x17, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x64, 0x72, 0x61, 0x6e,
+	0x64, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x4b, 0x65, 0x79, 0x12, 0x18, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x50, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
 	0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e,
-	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x17, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x64, 0x72, 0x61,
-	0x6e, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x69, 0x6c,
-	0x65, 0x12, 0x13, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08,
-	0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x16, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64,
-	0x2e, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x17, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x4c,
-	0x6f, 0x61, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x64, 0x72, 0x61, 0x6e,
-	0x64, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x4c, 0x6f, 0x61, 0x64,
-	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x44, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x72, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x43,
-	0x68, 0x61, 0x69, 0x6e, 0x12, 0x17, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
-	0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
-	0x73, 0x73, 0x22, 0x00, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x17, 0x2e, 0x64, 0x72, 0x61, 0x6e,
-	0x64, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x50,
-	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x00, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x0e, 0x42,
-	0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x16, 0x2e,
-	0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x42, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x42, 0x61,
-	0x63, 0x6b, 0x75, 0x70, 0x44, 0x42, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x49, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x1a, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x64,
-	0x72, 0x61, 0x6e, 0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x27, 0x5a, 0x25, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2f,
-	0x64, 0x72, 0x61, 0x6e, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64,
-	0x72, 0x61, 0x6e, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x09, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x09, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x13, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x64,
+	0x72, 0x61, 0x6e, 0x64, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x16,
+	0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x53,
+	0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x43, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12,
+	0x18, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x64, 0x72, 0x61, 0x6e,
+	0x64, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x72, 0x74, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x17, 0x2e, 0x64, 0x72, 0x61,
+	0x6e, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x53, 0x79, 0x6e, 0x63,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x00, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x0f,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12,
+	0x17, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x79, 0x6e,
+	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64,
+	0x2e, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x43, 0x0a, 0x0e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x12, 0x16, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x44, 0x42, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x64, 0x72,
+	0x61, 0x6e, 0x64, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x42, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4c, 0x0a, 0x0d, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x49, 0x0a, 0x0c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x48, 0x61, 0x6c, 0x74, 0x12,
+	0x1a, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x48, 0x61, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x64, 0x72,
+	0x61, 0x6e, 0x64, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x48, 0x61, 0x6c, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2f, 0x64,
+	0x72, 0x61, 0x6e, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x72,
+	0x61, 0x6e, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2182,125 +3235,161 @@ func file_drand_control_proto_rawDescGZIP() []byte {
 	return file_drand_control_proto_rawDescData
 }
 
-var file_drand_control_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_drand_control_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
 var file_drand_control_proto_goTypes = []interface{}{
-	(*SetupInfoPacket)(nil),       // 0: drand.SetupInfoPacket
-	(*InitDKGPacket)(nil),         // 1: drand.InitDKGPacket
-	(*InitDKGPacketResponse)(nil), // 2: drand.InitDKGPacketResponse
-	(*EntropyInfo)(nil),           // 3: drand.EntropyInfo
-	(*InitResharePacket)(nil),     // 4: drand.InitResharePacket
-	(*GroupInfo)(nil),             // 5: drand.GroupInfo
-	(*ShareRequest)(nil),          // 6: drand.ShareRequest
-	(*ShareResponse)(nil),         // 7: drand.ShareResponse
-	(*Ping)(nil),                  // 8: drand.Ping
-	(*Pong)(nil),                  // 9: drand.Pong
-	(*RemoteStatusRequest)(nil),   // 10: drand.RemoteStatusRequest
-	(*RemoteStatusResponse)(nil),  // 11: drand.RemoteStatusResponse
-	(*ListSchemesRequest)(nil),    // 12: drand.ListSchemesRequest
-	(*ListSchemesResponse)(nil),   // 13: drand.ListSchemesResponse
-	(*ListBeaconIDsRequest)(nil),  // 14: drand.ListBeaconIDsRequest
-	(*ListBeaconIDsResponse)(nil), // 15: drand.ListBeaconIDsResponse
-	(*PublicKeyRequest)(nil),      // 16: drand.PublicKeyRequest
-	(*PublicKeyResponse)(nil),     // 17: drand.PublicKeyResponse
-	(*PrivateKeyRequest)(nil),     // 18: drand.PrivateKeyRequest
-	(*PrivateKeyResponse)(nil),    // 19: drand.PrivateKeyResponse
-	(*CokeyRequest)(nil),          // 20: drand.CokeyRequest
-	(*CokeyResponse)(nil),         // 21: drand.CokeyResponse
-	(*GroupTOMLResponse)(nil),     // 22: drand.GroupTOMLResponse
-	(*ShutdownRequest)(nil),       // 23: drand.ShutdownRequest
-	(*ShutdownResponse)(nil),      // 24: drand.ShutdownResponse
-	(*LoadBeaconRequest)(nil),     // 25: drand.LoadBeaconRequest
-	(*LoadBeaconResponse)(nil),    // 26: drand.LoadBeaconResponse
-	(*StartSyncRequest)(nil),      // 27: drand.StartSyncRequest
-	(*SyncProgress)(nil),          // 28: drand.SyncProgress
-	(*BackupDBRequest)(nil),       // 29: drand.BackupDBRequest
-	(*BackupDBResponse)(nil),      // 30: drand.BackupDBResponse
-	nil,                           // 31: drand.RemoteStatusResponse.StatusesEntry
-	(*common.Metadata)(nil),       // 32: common.Metadata
-	(*Address)(nil),               // 33: drand.Address
-	(*StatusResponse)(nil),        // 34: drand.StatusResponse
-	(*StatusRequest)(nil),         // 35: drand.StatusRequest
-	(*ChainInfoRequest)(nil),      // 36: drand.ChainInfoRequest
-	(*GroupRequest)(nil),          // 37: drand.GroupRequest
-	(*GroupPacket)(nil),           // 38: drand.GroupPacket
-	(*ChainInfoPacket)(nil),       // 39: drand.ChainInfoPacket
+	(*SetupInfoPacket)(nil),         // 0: drand.SetupInfoPacket
+	(*InitDKGPacket)(nil),           // 1: drand.InitDKGPacket
+	(*DKGProposal)(nil),             // 2: drand.DKGProposal
+	(*InitDKGLeaderlessPacket)(nil), // 3: drand.InitDKGLeaderlessPacket
+	(*InitDKGPacketResponse)(nil),   // 4: drand.InitDKGPacketResponse
+	(*EntropyInfo)(nil),             // 5: drand.EntropyInfo
+	(*InitResharePacket)(nil),       // 6: drand.InitResharePacket
+	(*DKGStatusRequest)(nil),        // 7: drand.DKGStatusRequest
+	(*DKGProgress)(nil),             // 8: drand.DKGProgress
+	(*DKGParticipant)(nil),          // 9: drand.DKGParticipant
+	(*DKGComplaint)(nil),            // 10: drand.DKGComplaint
+	(*GroupInfo)(nil),               // 11: drand.GroupInfo
+	(*ShareRequest)(nil),            // 12: drand.ShareRequest
+	(*ShareResponse)(nil),           // 13: drand.ShareResponse
+	(*Ping)(nil),                    // 14: drand.Ping
+	(*Pong)(nil),                    // 15: drand.Pong
+	(*RemoteStatusRequest)(nil),     // 16: drand.RemoteStatusRequest
+	(*RemoteStatusResponse)(nil),    // 17: drand.RemoteStatusResponse
+	(*ParticipationRequest)(nil),    // 18: drand.ParticipationRequest
+	(*PartialArrival)(nil),          // 19: drand.PartialArrival
+	(*RoundParticipation)(nil),      // 20: drand.RoundParticipation
+	(*ParticipationResponse)(nil),   // 21: drand.ParticipationResponse
+	(*ScheduleHaltRequest)(nil),     // 22: drand.ScheduleHaltRequest
+	(*ScheduleHaltResponse)(nil),    // 23: drand.ScheduleHaltResponse
+	(*ListSchemesRequest)(nil),      // 24: drand.ListSchemesRequest
+	(*ListSchemesResponse)(nil),     // 25: drand.ListSchemesResponse
+	(*ListBeaconIDsRequest)(nil),    // 26: drand.ListBeaconIDsRequest
+	(*ListBeaconIDsResponse)(nil),   // 27: drand.ListBeaconIDsResponse
+	(*PublicKeyRequest)(nil),        // 28: drand.PublicKeyRequest
+	(*PublicKeyResponse)(nil),       // 29: drand.PublicKeyResponse
+	(*PrivateKeyRequest)(nil),       // 30: drand.PrivateKeyRequest
+	(*PrivateKeyResponse)(nil),      // 31: drand.PrivateKeyResponse
+	(*CokeyRequest)(nil),            // 32: drand.CokeyRequest
+	(*CokeyResponse)(nil),           // 33: drand.CokeyResponse
+	(*GroupTOMLResponse)(nil),       // 34: drand.GroupTOMLResponse
+	(*ShutdownRequest)(nil),         // 35: drand.ShutdownRequest
+	(*ShutdownResponse)(nil),        // 36: drand.ShutdownResponse
+	(*LoadBeaconRequest)(nil),       // 37: drand.LoadBeaconRequest
+	(*LoadBeaconResponse)(nil),      // 38: drand.LoadBeaconResponse
+	(*StartSyncRequest)(nil),        // 39: drand.StartSyncRequest
+	(*SyncProgress)(nil),            // 40: drand.SyncProgress
+	(*BackupDBRequest)(nil),         // 41: drand.BackupDBRequest
+	(*BackupDBResponse)(nil),        // 42: drand.BackupDBResponse
+	nil,                             // 43: drand.RemoteStatusResponse.StatusesEntry
+	(*common.Metadata)(nil),         // 44: common.Metadata
+	(*GroupPacket)(nil),             // 45: drand.GroupPacket
+	(*Address)(nil),                 // 46: drand.Address
+	(*StatusResponse)(nil),          // 47: drand.StatusResponse
+	(*StatusRequest)(nil),           // 48: drand.StatusRequest
+	(*ChainInfoRequest)(nil),        // 49: drand.ChainInfoRequest
+	(*GroupRequest)(nil),            // 50: drand.GroupRequest
+	(*ChainInfoPacket)(nil),         // 51: drand.ChainInfoPacket
 }
 var file_drand_control_proto_depIdxs = []int32{
-	32, // 0: drand.SetupInfoPacket.metadata:type_name -> common.Metadata
+	44, // 0: drand.SetupInfoPacket.metadata:type_name -> common.Metadata
 	0,  // 1: drand.InitDKGPacket.info:type_name -> drand.SetupInfoPacket
-	3,  // 2: drand.InitDKGPacket.entropy:type_name -> drand.EntropyInfo
-	32, // 3: drand.InitDKGPacket.metadata:type_name -> common.Metadata
-	32, // 4: drand.InitDKGPacketResponse.metadata:type_name -> common.Metadata
-	32, // 5: drand.EntropyInfo.metadata:type_name -> common.Metadata
-	5,  // 6: drand.InitResharePacket.old:type_name -> drand.GroupInfo
-	0,  // 7: drand.InitResharePacket.info:type_name -> drand.SetupInfoPacket
-	32, // 8: drand.InitResharePacket.metadata:type_name -> common.Metadata
-	32, // 9: drand.ShareRequest.metadata:type_name -> common.Metadata
-	32, // 10: drand.ShareResponse.metadata:type_name -> common.Metadata
-	32, // 11: drand.Ping.metadata:type_name -> common.Metadata
-	32, // 12: drand.Pong.metadata:type_name -> common.Metadata
-	32, // 13: drand.RemoteStatusRequest.metadata:type_name -> common.Metadata
-	33, // 14: drand.RemoteStatusRequest.addresses:type_name -> drand.Address
-	31, // 15: drand.RemoteStatusResponse.statuses:type_name -> drand.RemoteStatusResponse.StatusesEntry
-	32, // 16: drand.ListSchemesRequest.metadata:type_name -> common.Metadata
-	32, // 17: drand.ListSchemesResponse.metadata:type_name -> common.Metadata
-	32, // 18: drand.ListBeaconIDsRequest.metadata:type_name -> common.Metadata
-	32, // 19: drand.ListBeaconIDsResponse.metadata:type_name -> common.Metadata
-	32, // 20: drand.PublicKeyRequest.metadata:type_name -> common.Metadata
-	32, // 21: drand.PublicKeyResponse.metadata:type_name -> common.Metadata
-	32, // 22: drand.PrivateKeyRequest.metadata:type_name -> common.Metadata
-	32, // 23: drand.PrivateKeyResponse.metadata:type_name -> common.Metadata
-	32, // 24: drand.CokeyRequest.metadata:type_name -> common.Metadata
-	32, // 25: drand.CokeyResponse.metadata:type_name -> common.Metadata
-	32, // 26: drand.GroupTOMLResponse.metadata:type_name -> common.Metadata
-	32, // 27: drand.ShutdownRequest.metadata:type_name -> common.Metadata
-	32, // 28: drand.ShutdownResponse.metadata:type_name -> common.Metadata
-	32, // 29: drand.LoadBeaconRequest.metadata:type_name -> common.Metadata
-	32, // 30: drand.LoadBeaconResponse.metadata:type_name -> common.Metadata
-	32, // 31: drand.StartSyncRequest.metadata:type_name -> common.Metadata
-	32, // 32: drand.SyncProgress.metadata:type_name -> common.Metadata
-	32, // 33: drand.BackupDBRequest.metadata:type_name -> common.Metadata
-	32, // 34: drand.BackupDBResponse.metadata:type_name -> common.Metadata
-	34, // 35: drand.RemoteStatusResponse.StatusesEntry.value:type_name -> drand.StatusResponse
-	8,  // 36: drand.Control.PingPong:input_type -> drand.Ping
-	35, // 37: drand.Control.Status:input_type -> drand.StatusRequest
-	12, // 38: drand.Control.ListSchemes:input_type -> drand.ListSchemesRequest
-	14, // 39: drand.Control.ListBeaconIDs:input_type -> drand.ListBeaconIDsRequest
-	1,  // 40: drand.Control.InitDKG:input_type -> drand.InitDKGPacket
-	4,  // 41: drand.Control.InitReshare:input_type -> drand.InitResharePacket
-	6,  // 42: drand.Control.Share:input_type -> drand.ShareRequest
-	16, // 43: drand.Control.PublicKey:input_type -> drand.PublicKeyRequest
-	18, // 44: drand.Control.PrivateKey:input_type -> drand.PrivateKeyRequest
-	36, // 45: drand.Control.ChainInfo:input_type -> drand.ChainInfoRequest
-	37, // 46: drand.Control.GroupFile:input_type -> drand.GroupRequest
-	23, // 47: drand.Control.Shutdown:input_type -> drand.ShutdownRequest
-	25, // 48: drand.Control.LoadBeacon:input_type -> drand.LoadBeaconRequest
-	27, // 49: drand.Control.StartFollowChain:input_type -> drand.StartSyncRequest
-	27, // 50: drand.Control.StartCheckChain:input_type -> drand.StartSyncRequest
-	29, // 51: drand.Control.BackupDatabase:input_type -> drand.BackupDBRequest
-	10, // 52: drand.Control.RemoteStatus:input_type -> drand.RemoteStatusRequest
-	9,  // 53: drand.Control.PingPong:output_type -> drand.Pong
-	34, // 54: drand.Control.Status:output_type -> drand.StatusResponse
-	13, // 55: drand.Control.ListSchemes:output_type -> drand.ListSchemesResponse
-	15, // 56: drand.Control.ListBeaconIDs:output_type -> drand.ListBeaconIDsResponse
-	38, // 57: drand.Control.InitDKG:output_type -> drand.GroupPacket
-	38, // 58: drand.Control.InitReshare:output_type -> drand.GroupPacket
-	7,  // 59: drand.Control.Share:output_type -> drand.ShareResponse
-	17, // 60: drand.Control.PublicKey:output_type -> drand.PublicKeyResponse
-	19, // 61: drand.Control.PrivateKey:output_type -> drand.PrivateKeyResponse
-	39, // 62: drand.Control.ChainInfo:output_type -> drand.ChainInfoPacket
-	38, // 63: drand.Control.GroupFile:output_type -> drand.GroupPacket
-	24, // 64: drand.Control.Shutdown:output_type -> drand.ShutdownResponse
-	26, // 65: drand.Control.LoadBeacon:output_type -> drand.LoadBeaconResponse
-	28, // 66: drand.Control.StartFollowChain:output_type -> drand.SyncProgress
-	28, // 67: drand.Control.StartCheckChain:output_type -> drand.SyncProgress
-	30, // 68: drand.Control.BackupDatabase:output_type -> drand.BackupDBResponse
-	11, // 69: drand.Control.RemoteStatus:output_type -> drand.RemoteStatusResponse
-	53, // [53:70] is the sub-list for method output_type
-	36, // [36:53] is the sub-list for method input_type
-	36, // [36:36] is the sub-list for extension type_name
-	36, // [36:36] is the sub-list for extension extendee
-	0,  // [0:36] is the sub-list for field type_name
+	5,  // 2: drand.InitDKGPacket.entropy:type_name -> drand.EntropyInfo
+	44, // 3: drand.InitDKGPacket.metadata:type_name -> common.Metadata
+	45, // 4: drand.DKGProposal.group:type_name -> drand.GroupPacket
+	2,  // 5: drand.InitDKGLeaderlessPacket.proposal:type_name -> drand.DKGProposal
+	5,  // 6: drand.InitDKGLeaderlessPacket.entropy:type_name -> drand.EntropyInfo
+	44, // 7: drand.InitDKGLeaderlessPacket.metadata:type_name -> common.Metadata
+	11, // 8: drand.InitDKGLeaderlessPacket.old:type_name -> drand.GroupInfo
+	44, // 9: drand.InitDKGPacketResponse.metadata:type_name -> common.Metadata
+	44, // 10: drand.EntropyInfo.metadata:type_name -> common.Metadata
+	11, // 11: drand.InitResharePacket.old:type_name -> drand.GroupInfo
+	0,  // 12: drand.InitResharePacket.info:type_name -> drand.SetupInfoPacket
+	44, // 13: drand.InitResharePacket.metadata:type_name -> common.Metadata
+	44, // 14: drand.DKGStatusRequest.metadata:type_name -> common.Metadata
+	9,  // 15: drand.DKGProgress.dealers:type_name -> drand.DKGParticipant
+	9,  // 16: drand.DKGProgress.share_holders:type_name -> drand.DKGParticipant
+	10, // 17: drand.DKGProgress.complaints:type_name -> drand.DKGComplaint
+	44, // 18: drand.DKGProgress.metadata:type_name -> common.Metadata
+	44, // 19: drand.ShareRequest.metadata:type_name -> common.Metadata
+	44, // 20: drand.ShareResponse.metadata:type_name -> common.Metadata
+	44, // 21: drand.Ping.metadata:type_name -> common.Metadata
+	44, // 22: drand.Pong.metadata:type_name -> common.Metadata
+	44, // 23: drand.RemoteStatusRequest.metadata:type_name -> common.Metadata
+	46, // 24: drand.RemoteStatusRequest.addresses:type_name -> drand.Address
+	43, // 25: drand.RemoteStatusResponse.statuses:type_name -> drand.RemoteStatusResponse.StatusesEntry
+	44, // 26: drand.ParticipationRequest.metadata:type_name -> common.Metadata
+	19, // 27: drand.RoundParticipation.partials:type_name -> drand.PartialArrival
+	20, // 28: drand.ParticipationResponse.rounds:type_name -> drand.RoundParticipation
+	44, // 29: drand.ParticipationResponse.metadata:type_name -> common.Metadata
+	44, // 30: drand.ScheduleHaltRequest.metadata:type_name -> common.Metadata
+	44, // 31: drand.ScheduleHaltResponse.metadata:type_name -> common.Metadata
+	44, // 32: drand.ListSchemesRequest.metadata:type_name -> common.Metadata
+	44, // 33: drand.ListSchemesResponse.metadata:type_name -> common.Metadata
+	44, // 34: drand.ListBeaconIDsRequest.metadata:type_name -> common.Metadata
+	44, // 35: drand.ListBeaconIDsResponse.metadata:type_name -> common.Metadata
+	44, // 36: drand.PublicKeyRequest.metadata:type_name -> common.Metadata
+	44, // 37: drand.PublicKeyResponse.metadata:type_name -> common.Metadata
+	44, // 38: drand.PrivateKeyRequest.metadata:type_name -> common.Metadata
+	44, // 39: drand.PrivateKeyResponse.metadata:type_name -> common.Metadata
+	44, // 40: drand.CokeyRequest.metadata:type_name -> common.Metadata
+	44, // 41: drand.CokeyResponse.metadata:type_name -> common.Metadata
+	44, // 42: drand.GroupTOMLResponse.metadata:type_name -> common.Metadata
+	44, // 43: drand.ShutdownRequest.metadata:type_name -> common.Metadata
+	44, // 44: drand.ShutdownResponse.metadata:type_name -> common.Metadata
+	44, // 45: drand.LoadBeaconRequest.metadata:type_name -> common.Metadata
+	44, // 46: drand.LoadBeaconResponse.metadata:type_name -> common.Metadata
+	44, // 47: drand.StartSyncRequest.metadata:type_name -> common.Metadata
+	44, // 48: drand.SyncProgress.metadata:type_name -> common.Metadata
+	44, // 49: drand.BackupDBRequest.metadata:type_name -> common.Metadata
+	44, // 50: drand.BackupDBResponse.metadata:type_name -> common.Metadata
+	47, // 51: drand.RemoteStatusResponse.StatusesEntry.value:type_name -> drand.StatusResponse
+	14, // 52: drand.Control.PingPong:input_type -> drand.Ping
+	48, // 53: drand.Control.Status:input_type -> drand.StatusRequest
+	24, // 54: drand.Control.ListSchemes:input_type -> drand.ListSchemesRequest
+	26, // 55: drand.Control.ListBeaconIDs:input_type -> drand.ListBeaconIDsRequest
+	1,  // 56: drand.Control.InitDKG:input_type -> drand.InitDKGPacket
+	3,  // 57: drand.Control.InitDKGLeaderless:input_type -> drand.InitDKGLeaderlessPacket
+	6,  // 58: drand.Control.InitReshare:input_type -> drand.InitResharePacket
+	7,  // 59: drand.Control.DKGStatus:input_type -> drand.DKGStatusRequest
+	12, // 60: drand.Control.Share:input_type -> drand.ShareRequest
+	28, // 61: drand.Control.PublicKey:input_type -> drand.PublicKeyRequest
+	30, // 62: drand.Control.PrivateKey:input_type -> drand.PrivateKeyRequest
+	49, // 63: drand.Control.ChainInfo:input_type -> drand.ChainInfoRequest
+	50, // 64: drand.Control.GroupFile:input_type -> drand.GroupRequest
+	35, // 65: drand.Control.Shutdown:input_type -> drand.ShutdownRequest
+	37, // 66: drand.Control.LoadBeacon:input_type -> drand.LoadBeaconRequest
+	39, // 67: drand.Control.StartFollowChain:input_type -> drand.StartSyncRequest
+	39, // 68: drand.Control.StartCheckChain:input_type -> drand.StartSyncRequest
+	41, // 69: drand.Control.BackupDatabase:input_type -> drand.BackupDBRequest
+	16, // 70: drand.Control.RemoteStatus:input_type -> drand.RemoteStatusRequest
+	18, // 71: drand.Control.Participation:input_type -> drand.ParticipationRequest
+	22, // 72: drand.Control.ScheduleHalt:input_type -> drand.ScheduleHaltRequest
+	15, // 73: drand.Control.PingPong:output_type -> drand.Pong
+	47, // 74: drand.Control.Status:output_type -> drand.StatusResponse
+	25, // 75: drand.Control.ListSchemes:output_type -> drand.ListSchemesResponse
+	27, // 76: drand.Control.ListBeaconIDs:output_type -> drand.ListBeaconIDsResponse
+	45, // 77: drand.Control.InitDKG:output_type -> drand.GroupPacket
+	45, // 78: drand.Control.InitDKGLeaderless:output_type -> drand.GroupPacket
+	45, // 79: drand.Control.InitReshare:output_type -> drand.GroupPacket
+	8,  // 80: drand.Control.DKGStatus:output_type -> drand.DKGProgress
+	13, // 81: drand.Control.Share:output_type -> drand.ShareResponse
+	29, // 82: drand.Control.PublicKey:output_type -> drand.PublicKeyResponse
+	31, // 83: drand.Control.PrivateKey:output_type -> drand.PrivateKeyResponse
+	51, // 84: drand.Control.ChainInfo:output_type -> drand.ChainInfoPacket
+	45, // 85: drand.Control.GroupFile:output_type -> drand.GroupPacket
+	36, // 86: drand.Control.Shutdown:output_type -> drand.ShutdownResponse
+	38, // 87: drand.Control.LoadBeacon:output_type -> drand.LoadBeaconResponse
+	40, // 88: drand.Control.StartFollowChain:output_type -> drand.SyncProgress
+	40, // 89: drand.Control.StartCheckChain:output_type -> drand.SyncProgress
+	42, // 90: drand.Control.BackupDatabase:output_type -> drand.BackupDBResponse
+	17, // 91: drand.Control.RemoteStatus:output_type -> drand.RemoteStatusResponse
+	21, // 92: drand.Control.Participation:output_type -> drand.ParticipationResponse
+	23, // 93: drand.Control.ScheduleHalt:output_type -> drand.ScheduleHaltResponse
+	73, // [73:94] is the sub-list for method output_type
+	52, // [52:73] is the sub-list for method input_type
+	52, // [52:52] is the sub-list for extension type_name
+	52, // [52:52] is the sub-list for extension extendee
+	0,  // [0:52] is the sub-list for field type_name
 }
 
 func init() { file_drand_control_proto_init() }
@@ -2335,7 +3424,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitDKGPacketResponse); i {
+			switch v := v.(*DKGProposal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2347,7 +3436,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EntropyInfo); i {
+			switch v := v.(*InitDKGLeaderlessPacket); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2359,7 +3448,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitResharePacket); i {
+			switch v := v.(*InitDKGPacketResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2371,7 +3460,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupInfo); i {
+			switch v := v.(*EntropyInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2383,7 +3472,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShareRequest); i {
+			switch v := v.(*InitResharePacket); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2395,7 +3484,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShareResponse); i {
+			switch v := v.(*DKGStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2407,7 +3496,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Ping); i {
+			switch v := v.(*DKGProgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2419,7 +3508,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pong); i {
+			switch v := v.(*DKGParticipant); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2431,7 +3520,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoteStatusRequest); i {
+			switch v := v.(*DKGComplaint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2443,7 +3532,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoteStatusResponse); i {
+			switch v := v.(*GroupInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2455,7 +3544,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSchemesRequest); i {
+			switch v := v.(*ShareRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2467,7 +3556,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSchemesResponse); i {
+			switch v := v.(*ShareResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2479,7 +3568,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBeaconIDsRequest); i {
+			switch v := v.(*Ping); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2491,7 +3580,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBeaconIDsResponse); i {
+			switch v := v.(*Pong); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2503,7 +3592,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublicKeyRequest); i {
+			switch v := v.(*RemoteStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2515,7 +3604,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublicKeyResponse); i {
+			switch v := v.(*RemoteStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2527,7 +3616,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrivateKeyRequest); i {
+			switch v := v.(*ParticipationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2539,7 +3628,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrivateKeyResponse); i {
+			switch v := v.(*PartialArrival); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2551,7 +3640,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CokeyRequest); i {
+			switch v := v.(*RoundParticipation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2563,7 +3652,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CokeyResponse); i {
+			switch v := v.(*ParticipationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2575,7 +3664,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupTOMLResponse); i {
+			switch v := v.(*ScheduleHaltRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2587,7 +3676,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShutdownRequest); i {
+			switch v := v.(*ScheduleHaltResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2599,7 +3688,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShutdownResponse); i {
+			switch v := v.(*ListSchemesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2611,7 +3700,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoadBeaconRequest); i {
+			switch v := v.(*ListSchemesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2623,7 +3712,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoadBeaconResponse); i {
+			switch v := v.(*ListBeaconIDsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2635,7 +3724,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartSyncRequest); i {
+			switch v := v.(*ListBeaconIDsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2647,7 +3736,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncProgress); i {
+			switch v := v.(*PublicKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2659,7 +3748,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BackupDBRequest); i {
+			switch v := v.(*PublicKeyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2671,6 +3760,150 @@ func file_drand_control_proto_init() {
 			}
 		}
 		file_drand_control_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrivateKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drand_control_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrivateKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drand_control_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CokeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drand_control_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CokeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drand_control_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupTOMLResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drand_control_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShutdownRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drand_control_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShutdownResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drand_control_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadBeaconRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drand_control_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadBeaconResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drand_control_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartSyncRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drand_control_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drand_control_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupDBRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drand_control_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BackupDBResponse); i {
 			case 0:
 				return &v.state
@@ -2683,7 +3916,7 @@ func file_drand_control_proto_init() {
 			}
 		}
 	}
-	file_drand_control_proto_msgTypes[5].OneofWrappers = []interface{}{
+	file_drand_control_proto_msgTypes[11].OneofWrappers = []interface{}{
 		(*GroupInfo_Path)(nil),
 		(*GroupInfo_Url)(nil),
 	}
@@ -2693,7 +3926,7 @@ func file_drand_control_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_drand_control_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   32,
+			NumMessages:   44,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -32,9 +32,16 @@ type ControlClient interface {
 	ListBeaconIDs(ctx context.Context, in *ListBeaconIDsRequest, opts ...grpc.CallOption) (*ListBeaconIDsResponse, error)
 	// InitDKG sends information to daemon to start a fresh DKG protocol
 	InitDKG(ctx context.Context, in *InitDKGPacket, opts ...grpc.CallOption) (*GroupPacket, error)
+	// InitDKGLeaderless runs a fresh DKG or a resharing protocol from a
+	// proposal signed by all its participants, without a leader
+	InitDKGLeaderless(ctx context.Context, in *InitDKGLeaderlessPacket, opts ...grpc.CallOption) (*GroupPacket, error)
 	// InitReshares sends all informations so that the drand node knows how to
 	// proceeed during the next resharing protocol.
 	InitReshare(ctx context.Context, in *InitResharePacket, opts ...grpc.CallOption) (*GroupPacket, error)
+	// DKGStatus returns the progress of the DKG or resharing in progress, or
+	// of the last one, and streams every change of it until it finishes if
+	// asked to watch it
+	DKGStatus(ctx context.Context, in *DKGStatusRequest, opts ...grpc.CallOption) (Control_DKGStatusClient, error)
 	// Share returns the current private share used by the node
 	Share(ctx context.Context, in *ShareRequest, opts ...grpc.CallOption) (*ShareResponse, error)
 	// PublicKey returns the longterm public key of the drand node
@@ -54,6 +61,13 @@ type ControlClient interface {
 	BackupDatabase(ctx context.Context, in *BackupDBRequest, opts ...grpc.CallOption) (*BackupDBResponse, error)
 	// RemoteStatus request the status of some remote drand nodes
 	RemoteStatus(ctx context.Context, in *RemoteStatusRequest, opts ...grpc.CallOption) (*RemoteStatusResponse, error)
+	// Participation returns which nodes sent partial beacons for the last
+	// rounds, as seen by this node
+	Participation(ctx context.Context, in *ParticipationRequest, opts ...grpc.CallOption) (*ParticipationResponse, error)
+	// ScheduleHalt votes for ending the chain at the given round and sends
+	// the vote to the other nodes of the group. The chain ends once a
+	// threshold of nodes voted for the same round.
+	ScheduleHalt(ctx context.Context, in *ScheduleHaltRequest, opts ...grpc.CallOption) (*ScheduleHaltResponse, error)
 }
 
 type controlClient struct {
@@ -109,6 +123,15 @@ func (c *controlClient) InitDKG(ctx context.Context, in *InitDKGPacket, opts ...
 	return out, nil
 }
 
+func (c *controlClient) InitDKGLeaderless(ctx context.Context, in *InitDKGLeaderlessPacket, opts ...grpc.CallOption) (*GroupPacket, error) {
+	out := new(GroupPacket)
+	err := c.cc.Invoke(ctx, "/drand.Control/InitDKGLeaderless", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controlClient) InitReshare(ctx context.Context, in *InitResharePacket, opts ...grpc.CallOption) (*GroupPacket, error) {
 	out := new(GroupPacket)
 	err := c.cc.Invoke(ctx, "/drand.Control/InitReshare", in, out, opts...)
@@ -118,6 +141,38 @@ func (c *controlClient) InitReshare(ctx context.Context, in *InitResharePacket,
 	return out, nil
 }
 
+func (c *controlClient) DKGStatus(ctx context.Context, in *DKGStatusRequest, opts ...grpc.CallOption) (Control_DKGStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Control_ServiceDesc.Streams[0], "/drand.Control/DKGStatus", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &controlDKGStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Control_DKGStatusClient interface {
+	Recv() (*DKGProgress, error)
+	grpc.ClientStream
+}
+
+type controlDKGStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *controlDKGStatusClient) Recv() (*DKGProgress, error) {
+	m := new(DKGProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *controlClient) Share(ctx context.Context, in *ShareRequest, opts ...grpc.CallOption) (*ShareResponse, error) {
 	out := new(ShareResponse)
 	err := c.cc.Invoke(ctx, "/drand.Control/Share", in, out, opts...)
@@ -182,7 +237,7 @@ func (c *controlClient) LoadBeacon(ctx context.Context, in *LoadBeaconRequest, o
 }
 
 func (c *controlClient) StartFollowChain(ctx context.Context, in *StartSyncRequest, opts ...grpc.CallOption) (Control_StartFollowChainClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Control_ServiceDesc.Streams[0], "/drand.Control/StartFollowChain", opts...)
+	stream, err := c.cc.NewStream(ctx, &Control_ServiceDesc.Streams[1], "/drand.Control/StartFollowChain", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -214,7 +269,7 @@ func (x *controlStartFollowChainClient) Recv() (*SyncProgress, error) {
 }
 
 func (c *controlClient) StartCheckChain(ctx context.Context, in *StartSyncRequest, opts ...grpc.CallOption) (Control_StartCheckChainClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Control_ServiceDesc.Streams[1], "/drand.Control/StartCheckChain", opts...)
+	stream, err := c.cc.NewStream(ctx, &Control_ServiceDesc.Streams[2], "/drand.Control/StartCheckChain", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -263,6 +318,24 @@ func (c *controlClient) RemoteStatus(ctx context.Context, in *RemoteStatusReques
 	return out, nil
 }
 
+func (c *controlClient) Participation(ctx context.Context, in *ParticipationRequest, opts ...grpc.CallOption) (*ParticipationResponse, error) {
+	out := new(ParticipationResponse)
+	err := c.cc.Invoke(ctx, "/drand.Control/Participation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) ScheduleHalt(ctx context.Context, in *ScheduleHaltRequest, opts ...grpc.CallOption) (*ScheduleHaltResponse, error) {
+	out := new(ScheduleHaltResponse)
+	err := c.cc.Invoke(ctx, "/drand.Control/ScheduleHalt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ControlServer is the server API for Control service.
 // All implementations should embed UnimplementedControlServer
 // for forward compatibility
@@ -277,9 +350,16 @@ type ControlServer interface {
 	ListBeaconIDs(context.Context, *ListBeaconIDsRequest) (*ListBeaconIDsResponse, error)
 	// InitDKG sends information to daemon to start a fresh DKG protocol
 	InitDKG(context.Context, *InitDKGPacket) (*GroupPacket, error)
+	// InitDKGLeaderless runs a fresh DKG or a resharing protocol from a
+	// proposal signed by all its participants, without a leader
+	InitDKGLeaderless(context.Context, *InitDKGLeaderlessPacket) (*GroupPacket, error)
 	// InitReshares sends all informations so that the drand node knows how to
 	// proceeed during the next resharing protocol.
 	InitReshare(context.Context, *InitResharePacket) (*GroupPacket, error)
+	// DKGStatus returns the progress of the DKG or resharing in progress, or
+	// of the last one, and streams every change of it until it finishes if
+	// asked to watch it
+	DKGStatus(*DKGStatusRequest, Control_DKGStatusServer) error
 	// Share returns the current private share used by the node
 	Share(context.Context, *ShareRequest) (*ShareResponse, error)
 	// PublicKey returns the longterm public key of the drand node
@@ -299,6 +379,13 @@ type ControlServer interface {
 	BackupDatabase(context.Context, *BackupDBRequest) (*BackupDBResponse, error)
 	// RemoteStatus request the status of some remote drand nodes
 	RemoteStatus(context.Context, *RemoteStatusRequest) (*RemoteStatusResponse, error)
+	// Participation returns which nodes sent partial beacons for the last
+	// rounds, as seen by this node
+	Participation(context.Context, *ParticipationRequest) (*ParticipationResponse, error)
+	// ScheduleHalt votes for ending the chain at the given round and sends
+	// the vote to the other nodes of the group. The chain ends once a
+	// threshold of nodes voted for the same round.
+	ScheduleHalt(context.Context, *ScheduleHaltRequest) (*ScheduleHaltResponse, error)
 }
 
 // UnimplementedControlServer should be embedded to have forward compatible implementations.
@@ -320,9 +407,15 @@ func (UnimplementedControlServer) ListBeaconIDs(context.Context, *ListBeaconIDsR
 func (UnimplementedControlServer) InitDKG(context.Context, *InitDKGPacket) (*GroupPacket, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InitDKG not implemented")
 }
+func (UnimplementedControlServer) InitDKGLeaderless(context.Context, *InitDKGLeaderlessPacket) (*GroupPacket, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitDKGLeaderless not implemented")
+}
 func (UnimplementedControlServer) InitReshare(context.Context, *InitResharePacket) (*GroupPacket, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InitReshare not implemented")
 }
+func (UnimplementedControlServer) DKGStatus(*DKGStatusRequest, Control_DKGStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method DKGStatus not implemented")
+}
 func (UnimplementedControlServer) Share(context.Context, *ShareRequest) (*ShareResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Share not implemented")
 }
@@ -356,6 +449,12 @@ func (UnimplementedControlServer) BackupDatabase(context.Context, *BackupDBReque
 func (UnimplementedControlServer) RemoteStatus(context.Context, *RemoteStatusRequest) (*RemoteStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoteStatus not implemented")
 }
+func (UnimplementedControlServer) Participation(context.Context, *ParticipationRequest) (*ParticipationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Participation not implemented")
+}
+func (UnimplementedControlServer) ScheduleHalt(context.Context, *ScheduleHaltRequest) (*ScheduleHaltResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleHalt not implemented")
+}
 
 // UnsafeControlServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ControlServer will
@@ -458,6 +557,24 @@ func _Control_InitDKG_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Control_InitDKGLeaderless_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitDKGLeaderlessPacket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).InitDKGLeaderless(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/drand.Control/InitDKGLeaderless",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).InitDKGLeaderless(ctx, req.(*InitDKGLeaderlessPacket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Control_InitReshare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InitResharePacket)
 	if err := dec(in); err != nil {
@@ -476,6 +593,27 @@ func _Control_InitReshare_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Control_DKGStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DKGStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ControlServer).DKGStatus(m, &controlDKGStatusServer{stream})
+}
+
+type Control_DKGStatusServer interface {
+	Send(*DKGProgress) error
+	grpc.ServerStream
+}
+
+type controlDKGStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *controlDKGStatusServer) Send(m *DKGProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Control_Share_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ShareRequest)
 	if err := dec(in); err != nil {
@@ -680,6 +818,42 @@ func _Control_RemoteStatus_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Control_Participation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParticipationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).Participation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/drand.Control/Participation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).Participation(ctx, req.(*ParticipationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_ScheduleHalt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleHaltRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).ScheduleHalt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/drand.Control/ScheduleHalt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).ScheduleHalt(ctx, req.(*ScheduleHaltRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Control_ServiceDesc is the grpc.ServiceDesc for Control service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -707,6 +881,10 @@ var Control_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InitDKG",
 			Handler:    _Control_InitDKG_Handler,
 		},
+		{
+			MethodName: "InitDKGLeaderless",
+			Handler:    _Control_InitDKGLeaderless_Handler,
+		},
 		{
 			MethodName: "InitReshare",
 			Handler:    _Control_InitReshare_Handler,
@@ -747,8 +925,21 @@ var Control_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoteStatus",
 			Handler:    _Control_RemoteStatus_Handler,
 		},
+		{
+			MethodName: "Participation",
+			Handler:    _Control_Participation_Handler,
+		},
+		{
+			MethodName: "ScheduleHalt",
+			Handler:    _Control_ScheduleHalt_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DKGStatus",
+			Handler:       _Control_DKGStatus_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "StartFollowChain",
 			Handler:       _Control_StartFollowChain_Handler,
@@ -397,6 +397,148 @@ func (x *PartialBeaconPacket) GetMetadata() *common.Metadata {
 	return nil
 }
 
+// PartialBeaconAck acknowledges a partial beacon received over a stream
+type PartialBeaconAck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Round uint64 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	// error is set if the partial beacon was refused
+	Error    string           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Metadata *common.Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *PartialBeaconAck) Reset() {
+	*x = PartialBeaconAck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drand_protocol_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PartialBeaconAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartialBeaconAck) ProtoMessage() {}
+
+func (x *PartialBeaconAck) ProtoReflect() protoreflect.Message {
+	mi := &file_drand_protocol_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartialBeaconAck.ProtoReflect.Descriptor instead.
+func (*PartialBeaconAck) Descriptor() ([]byte, []int) {
+	return file_drand_protocol_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PartialBeaconAck) GetRound() uint64 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *PartialBeaconAck) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *PartialBeaconAck) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// HaltPacket is the vote of a node of the group for ending the chain at a
+// round. The chain ends once a threshold of nodes voted for the same round.
+type HaltPacket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the last round of the chain
+	Round uint64 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	// index of the node in the group
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// signature of the node with its long-term key over the chain hash and
+	// the round
+	Signature []byte           `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Metadata  *common.Metadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *HaltPacket) Reset() {
+	*x = HaltPacket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drand_protocol_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HaltPacket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HaltPacket) ProtoMessage() {}
+
+func (x *HaltPacket) ProtoReflect() protoreflect.Message {
+	mi := &file_drand_protocol_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HaltPacket.ProtoReflect.Descriptor instead.
+func (*HaltPacket) Descriptor() ([]byte, []int) {
+	return file_drand_protocol_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *HaltPacket) GetRound() uint64 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *HaltPacket) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *HaltPacket) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *HaltPacket) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
 // DKGPacket is the packet that nodes send to others nodes as part of the
 // broadcasting protocol.
 type DKGPacket struct {
@@ -412,7 +554,7 @@ type DKGPacket struct {
 func (x *DKGPacket) Reset() {
 	*x = DKGPacket{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_protocol_proto_msgTypes[5]
+		mi := &file_drand_protocol_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -425,7 +567,7 @@ func (x *DKGPacket) String() string {
 func (*DKGPacket) ProtoMessage() {}
 
 func (x *DKGPacket) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_protocol_proto_msgTypes[5]
+	mi := &file_drand_protocol_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -438,7 +580,7 @@ func (x *DKGPacket) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DKGPacket.ProtoReflect.Descriptor instead.
 func (*DKGPacket) Descriptor() ([]byte, []int) {
-	return file_drand_protocol_proto_rawDescGZIP(), []int{5}
+	return file_drand_protocol_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DKGPacket) GetDkg() *dkg.Packet {
@@ -470,7 +612,7 @@ type SyncRequest struct {
 func (x *SyncRequest) Reset() {
 	*x = SyncRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_protocol_proto_msgTypes[6]
+		mi := &file_drand_protocol_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -483,7 +625,7 @@ func (x *SyncRequest) String() string {
 func (*SyncRequest) ProtoMessage() {}
 
 func (x *SyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_protocol_proto_msgTypes[6]
+	mi := &file_drand_protocol_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -496,7 +638,7 @@ func (x *SyncRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncRequest.ProtoReflect.Descriptor instead.
 func (*SyncRequest) Descriptor() ([]byte, []int) {
-	return file_drand_protocol_proto_rawDescGZIP(), []int{6}
+	return file_drand_protocol_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SyncRequest) GetFromRound() uint64 {
@@ -527,7 +669,7 @@ type BeaconPacket struct {
 func (x *BeaconPacket) Reset() {
 	*x = BeaconPacket{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_drand_protocol_proto_msgTypes[7]
+		mi := &file_drand_protocol_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -540,7 +682,7 @@ func (x *BeaconPacket) String() string {
 func (*BeaconPacket) ProtoMessage() {}
 
 func (x *BeaconPacket) ProtoReflect() protoreflect.Message {
-	mi := &file_drand_protocol_proto_msgTypes[7]
+	mi := &file_drand_protocol_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -553,7 +695,7 @@ func (x *BeaconPacket) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BeaconPacket.ProtoReflect.Descriptor instead.
 func (*BeaconPacket) Descriptor() ([]byte, []int) {
-	return file_drand_protocol_proto_rawDescGZIP(), []int{7}
+	return file_drand_protocol_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *BeaconPacket) GetPreviousSig() []byte {
@@ -641,56 +783,79 @@ var file_drand_protocol_proto_rawDesc = []byte{
 	0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x12, 0x2c, 0x0a, 0x08, 0x6d,
 	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
 	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x58, 0x0a, 0x09, 0x44, 0x4b, 0x47,
-	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x03, 0x64, 0x6b, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x64, 0x6b, 0x67, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x52, 0x03, 0x64, 0x6b, 0x67, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x22, 0x5a, 0x0a, 0x0b, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x6f, 0x75, 0x6e,
-	0x64, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22,
-	0x93, 0x01, 0x0a, 0x0c, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x69, 0x67,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
-	0x53, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x32, 0x97, 0x03, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x12, 0x3e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x12, 0x16, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x64, 0x72, 0x61, 0x6e,
-	0x64, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3c, 0x0a, 0x14, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x44, 0x4b, 0x47, 0x50,
-	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x64, 0x72, 0x61,
-	0x6e, 0x64, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x44, 0x4b, 0x47, 0x50, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x1a, 0x0c, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x31, 0x0a, 0x0b, 0x50, 0x75, 0x73, 0x68, 0x44, 0x4b, 0x47, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x14, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x44, 0x4b, 0x47, 0x49, 0x6e, 0x66, 0x6f, 0x50,
-	0x61, 0x63, 0x6b, 0x65, 0x74, 0x1a, 0x0c, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x0c, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74,
-	0x44, 0x4b, 0x47, 0x12, 0x10, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x44, 0x4b, 0x47, 0x50,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6c, 0x0a, 0x10, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x61, 0x6c, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x84, 0x01, 0x0a, 0x0a, 0x48, 0x61, 0x6c, 0x74,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x58,
+	0x0a, 0x09, 0x44, 0x4b, 0x47, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x03, 0x64,
+	0x6b, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x64, 0x6b, 0x67, 0x2e, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x03, 0x64, 0x6b, 0x67, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x5a, 0x0a, 0x0b, 0x53, 0x79, 0x6e, 0x63,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x6f,
+	0x6d, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0x93, 0x01, 0x0a, 0x0c, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x5f, 0x73, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2c, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x32, 0x90, 0x04, 0x0a, 0x08, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x3e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x16, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x14, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x44, 0x4b, 0x47, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12,
+	0x16, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x44, 0x4b,
+	0x47, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x1a, 0x0c, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x31, 0x0a, 0x0b, 0x50, 0x75, 0x73, 0x68, 0x44, 0x4b, 0x47,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x44, 0x4b, 0x47,
+	0x49, 0x6e, 0x66, 0x6f, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x1a, 0x0c, 0x2e, 0x64, 0x72, 0x61,
+	0x6e, 0x64, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x0c, 0x42, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x44, 0x4b, 0x47, 0x12, 0x10, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64,
+	0x2e, 0x44, 0x4b, 0x47, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x1a, 0x0c, 0x2e, 0x64, 0x72, 0x61,
+	0x6e, 0x64, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0d, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x61, 0x6c, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x64, 0x72, 0x61, 0x6e,
+	0x64, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x50,
 	0x61, 0x63, 0x6b, 0x65, 0x74, 0x1a, 0x0c, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0d, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x42, 0x65,
-	0x61, 0x63, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x61, 0x6c, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x1a, 0x0c, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36,
-	0x0a, 0x09, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x2e, 0x64, 0x72,
-	0x61, 0x6e, 0x64, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x13, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x50, 0x61,
-	0x63, 0x6b, 0x65, 0x74, 0x30, 0x01, 0x12, 0x37, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x14, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
-	0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x72,
-	0x61, 0x6e, 0x64, 0x2f, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2f, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x74, 0x79, 0x12, 0x4e, 0x0a, 0x13, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1a, 0x2e, 0x64, 0x72, 0x61,
+	0x6e, 0x64, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x1a, 0x17, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x28,
+	0x01, 0x30, 0x01, 0x12, 0x27, 0x0a, 0x04, 0x48, 0x61, 0x6c, 0x74, 0x12, 0x11, 0x2e, 0x64, 0x72,
+	0x61, 0x6e, 0x64, 0x2e, 0x48, 0x61, 0x6c, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x1a, 0x0c,
+	0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x09,
+	0x53, 0x79, 0x6e, 0x63, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x2e, 0x64, 0x72, 0x61, 0x6e,
+	0x64, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
+	0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x30, 0x01, 0x12, 0x37, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14,
+	0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x27, 0x5a,
+	0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x72, 0x61, 0x6e,
+	0x64, 0x2f, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -705,55 +870,63 @@ func file_drand_protocol_proto_rawDescGZIP() []byte {
 	return file_drand_protocol_proto_rawDescData
 }
 
-var file_drand_protocol_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_drand_protocol_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_drand_protocol_proto_goTypes = []interface{}{
 	(*IdentityRequest)(nil),     // 0: drand.IdentityRequest
 	(*IdentityResponse)(nil),    // 1: drand.IdentityResponse
 	(*SignalDKGPacket)(nil),     // 2: drand.SignalDKGPacket
 	(*DKGInfoPacket)(nil),       // 3: drand.DKGInfoPacket
 	(*PartialBeaconPacket)(nil), // 4: drand.PartialBeaconPacket
-	(*DKGPacket)(nil),           // 5: drand.DKGPacket
-	(*SyncRequest)(nil),         // 6: drand.SyncRequest
-	(*BeaconPacket)(nil),        // 7: drand.BeaconPacket
-	(*common.Metadata)(nil),     // 8: common.Metadata
-	(*Identity)(nil),            // 9: drand.Identity
-	(*GroupPacket)(nil),         // 10: drand.GroupPacket
-	(*dkg.Packet)(nil),          // 11: dkg.Packet
-	(*StatusRequest)(nil),       // 12: drand.StatusRequest
-	(*Empty)(nil),               // 13: drand.Empty
-	(*StatusResponse)(nil),      // 14: drand.StatusResponse
+	(*PartialBeaconAck)(nil),    // 5: drand.PartialBeaconAck
+	(*HaltPacket)(nil),          // 6: drand.HaltPacket
+	(*DKGPacket)(nil),           // 7: drand.DKGPacket
+	(*SyncRequest)(nil),         // 8: drand.SyncRequest
+	(*BeaconPacket)(nil),        // 9: drand.BeaconPacket
+	(*common.Metadata)(nil),     // 10: common.Metadata
+	(*Identity)(nil),            // 11: drand.Identity
+	(*GroupPacket)(nil),         // 12: drand.GroupPacket
+	(*dkg.Packet)(nil),          // 13: dkg.Packet
+	(*StatusRequest)(nil),       // 14: drand.StatusRequest
+	(*Empty)(nil),               // 15: drand.Empty
+	(*StatusResponse)(nil),      // 16: drand.StatusResponse
 }
 var file_drand_protocol_proto_depIdxs = []int32{
-	8,  // 0: drand.IdentityRequest.metadata:type_name -> common.Metadata
-	8,  // 1: drand.IdentityResponse.metadata:type_name -> common.Metadata
-	9,  // 2: drand.SignalDKGPacket.node:type_name -> drand.Identity
-	8,  // 3: drand.SignalDKGPacket.metadata:type_name -> common.Metadata
-	10, // 4: drand.DKGInfoPacket.new_group:type_name -> drand.GroupPacket
-	8,  // 5: drand.DKGInfoPacket.metadata:type_name -> common.Metadata
-	8,  // 6: drand.PartialBeaconPacket.metadata:type_name -> common.Metadata
-	11, // 7: drand.DKGPacket.dkg:type_name -> dkg.Packet
-	8,  // 8: drand.DKGPacket.metadata:type_name -> common.Metadata
-	8,  // 9: drand.SyncRequest.metadata:type_name -> common.Metadata
-	8,  // 10: drand.BeaconPacket.metadata:type_name -> common.Metadata
-	0,  // 11: drand.Protocol.GetIdentity:input_type -> drand.IdentityRequest
-	2,  // 12: drand.Protocol.SignalDKGParticipant:input_type -> drand.SignalDKGPacket
-	3,  // 13: drand.Protocol.PushDKGInfo:input_type -> drand.DKGInfoPacket
-	5,  // 14: drand.Protocol.BroadcastDKG:input_type -> drand.DKGPacket
-	4,  // 15: drand.Protocol.PartialBeacon:input_type -> drand.PartialBeaconPacket
-	6,  // 16: drand.Protocol.SyncChain:input_type -> drand.SyncRequest
-	12, // 17: drand.Protocol.Status:input_type -> drand.StatusRequest
-	1,  // 18: drand.Protocol.GetIdentity:output_type -> drand.IdentityResponse
-	13, // 19: drand.Protocol.SignalDKGParticipant:output_type -> drand.Empty
-	13, // 20: drand.Protocol.PushDKGInfo:output_type -> drand.Empty
-	13, // 21: drand.Protocol.BroadcastDKG:output_type -> drand.Empty
-	13, // 22: drand.Protocol.PartialBeacon:output_type -> drand.Empty
-	7,  // 23: drand.Protocol.SyncChain:output_type -> drand.BeaconPacket
-	14, // 24: drand.Protocol.Status:output_type -> drand.StatusResponse
-	18, // [18:25] is the sub-list for method output_type
-	11, // [11:18] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	10, // 0: drand.IdentityRequest.metadata:type_name -> common.Metadata
+	10, // 1: drand.IdentityResponse.metadata:type_name -> common.Metadata
+	11, // 2: drand.SignalDKGPacket.node:type_name -> drand.Identity
+	10, // 3: drand.SignalDKGPacket.metadata:type_name -> common.Metadata
+	12, // 4: drand.DKGInfoPacket.new_group:type_name -> drand.GroupPacket
+	10, // 5: drand.DKGInfoPacket.metadata:type_name -> common.Metadata
+	10, // 6: drand.PartialBeaconPacket.metadata:type_name -> common.Metadata
+	10, // 7: drand.PartialBeaconAck.metadata:type_name -> common.Metadata
+	10, // 8: drand.HaltPacket.metadata:type_name -> common.Metadata
+	13, // 9: drand.DKGPacket.dkg:type_name -> dkg.Packet
+	10, // 10: drand.DKGPacket.metadata:type_name -> common.Metadata
+	10, // 11: drand.SyncRequest.metadata:type_name -> common.Metadata
+	10, // 12: drand.BeaconPacket.metadata:type_name -> common.Metadata
+	0,  // 13: drand.Protocol.GetIdentity:input_type -> drand.IdentityRequest
+	2,  // 14: drand.Protocol.SignalDKGParticipant:input_type -> drand.SignalDKGPacket
+	3,  // 15: drand.Protocol.PushDKGInfo:input_type -> drand.DKGInfoPacket
+	7,  // 16: drand.Protocol.BroadcastDKG:input_type -> drand.DKGPacket
+	4,  // 17: drand.Protocol.PartialBeacon:input_type -> drand.PartialBeaconPacket
+	4,  // 18: drand.Protocol.PartialBeaconStream:input_type -> drand.PartialBeaconPacket
+	6,  // 19: drand.Protocol.Halt:input_type -> drand.HaltPacket
+	8,  // 20: drand.Protocol.SyncChain:input_type -> drand.SyncRequest
+	14, // 21: drand.Protocol.Status:input_type -> drand.StatusRequest
+	1,  // 22: drand.Protocol.GetIdentity:output_type -> drand.IdentityResponse
+	15, // 23: drand.Protocol.SignalDKGParticipant:output_type -> drand.Empty
+	15, // 24: drand.Protocol.PushDKGInfo:output_type -> drand.Empty
+	15, // 25: drand.Protocol.BroadcastDKG:output_type -> drand.Empty
+	15, // 26: drand.Protocol.PartialBeacon:output_type -> drand.Empty
+	5,  // 27: drand.Protocol.PartialBeaconStream:output_type -> drand.PartialBeaconAck
+	15, // 28: drand.Protocol.Halt:output_type -> drand.Empty
+	9,  // 29: drand.Protocol.SyncChain:output_type -> drand.BeaconPacket
+	16, // 30: drand.Protocol.Status:output_type -> drand.StatusResponse
+	22, // [22:31] is the sub-list for method output_type
+	13, // [13:22] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_drand_protocol_proto_init() }
@@ -824,7 +997,7 @@ func file_drand_protocol_proto_init() {
 			}
 		}
 		file_drand_protocol_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DKGPacket); i {
+			switch v := v.(*PartialBeaconAck); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -836,7 +1009,7 @@ func file_drand_protocol_proto_init() {
 			}
 		}
 		file_drand_protocol_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncRequest); i {
+			switch v := v.(*HaltPacket); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -848,6 +1021,30 @@ func file_drand_protocol_proto_init() {
 			}
 		}
 		file_drand_protocol_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DKGPacket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drand_protocol_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drand_protocol_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BeaconPacket); i {
 			case 0:
 				return &v.state
@@ -866,7 +1063,7 @@ func file_drand_protocol_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_drand_protocol_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -36,6 +36,11 @@ type ProtocolClient interface {
 	BroadcastDKG(ctx context.Context, in *DKGPacket, opts ...grpc.CallOption) (*Empty, error)
 	// PartialBeacon sends its partial beacon to another node
 	PartialBeacon(ctx context.Context, in *PartialBeaconPacket, opts ...grpc.CallOption) (*Empty, error)
+	// PartialBeaconStream sends the partial beacons of the following rounds
+	// over a single stream. Each partial beacon is acknowledged in order.
+	PartialBeaconStream(ctx context.Context, opts ...grpc.CallOption) (Protocol_PartialBeaconStreamClient, error)
+	// Halt sends the vote of a node for ending the chain at a round
+	Halt(ctx context.Context, in *HaltPacket, opts ...grpc.CallOption) (*Empty, error)
 	// SyncRequest forces a daemon to sync up its chain with other nodes
 	SyncChain(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (Protocol_SyncChainClient, error)
 	// Status responds with the actual status of drand process
@@ -95,8 +100,48 @@ func (c *protocolClient) PartialBeacon(ctx context.Context, in *PartialBeaconPac
 	return out, nil
 }
 
+func (c *protocolClient) PartialBeaconStream(ctx context.Context, opts ...grpc.CallOption) (Protocol_PartialBeaconStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Protocol_ServiceDesc.Streams[0], "/drand.Protocol/PartialBeaconStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &protocolPartialBeaconStreamClient{stream}
+	return x, nil
+}
+
+type Protocol_PartialBeaconStreamClient interface {
+	Send(*PartialBeaconPacket) error
+	Recv() (*PartialBeaconAck, error)
+	grpc.ClientStream
+}
+
+type protocolPartialBeaconStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *protocolPartialBeaconStreamClient) Send(m *PartialBeaconPacket) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *protocolPartialBeaconStreamClient) Recv() (*PartialBeaconAck, error) {
+	m := new(PartialBeaconAck)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *protocolClient) Halt(ctx context.Context, in *HaltPacket, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/drand.Protocol/Halt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *protocolClient) SyncChain(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (Protocol_SyncChainClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Protocol_ServiceDesc.Streams[0], "/drand.Protocol/SyncChain", opts...)
+	stream, err := c.cc.NewStream(ctx, &Protocol_ServiceDesc.Streams[1], "/drand.Protocol/SyncChain", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -154,6 +199,11 @@ type ProtocolServer interface {
 	BroadcastDKG(context.Context, *DKGPacket) (*Empty, error)
 	// PartialBeacon sends its partial beacon to another node
 	PartialBeacon(context.Context, *PartialBeaconPacket) (*Empty, error)
+	// PartialBeaconStream sends the partial beacons of the following rounds
+	// over a single stream. Each partial beacon is acknowledged in order.
+	PartialBeaconStream(Protocol_PartialBeaconStreamServer) error
+	// Halt sends the vote of a node for ending the chain at a round
+	Halt(context.Context, *HaltPacket) (*Empty, error)
 	// SyncRequest forces a daemon to sync up its chain with other nodes
 	SyncChain(*SyncRequest, Protocol_SyncChainServer) error
 	// Status responds with the actual status of drand process
@@ -179,6 +229,12 @@ func (UnimplementedProtocolServer) BroadcastDKG(context.Context, *DKGPacket) (*E
 func (UnimplementedProtocolServer) PartialBeacon(context.Context, *PartialBeaconPacket) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PartialBeacon not implemented")
 }
+func (UnimplementedProtocolServer) PartialBeaconStream(Protocol_PartialBeaconStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method PartialBeaconStream not implemented")
+}
+func (UnimplementedProtocolServer) Halt(context.Context, *HaltPacket) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Halt not implemented")
+}
 func (UnimplementedProtocolServer) SyncChain(*SyncRequest, Protocol_SyncChainServer) error {
 	return status.Errorf(codes.Unimplemented, "method SyncChain not implemented")
 }
@@ -287,6 +343,50 @@ func _Protocol_PartialBeacon_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Protocol_PartialBeaconStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ProtocolServer).PartialBeaconStream(&protocolPartialBeaconStreamServer{stream})
+}
+
+type Protocol_PartialBeaconStreamServer interface {
+	Send(*PartialBeaconAck) error
+	Recv() (*PartialBeaconPacket, error)
+	grpc.ServerStream
+}
+
+type protocolPartialBeaconStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *protocolPartialBeaconStreamServer) Send(m *PartialBeaconAck) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *protocolPartialBeaconStreamServer) Recv() (*PartialBeaconPacket, error) {
+	m := new(PartialBeaconPacket)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Protocol_Halt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HaltPacket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtocolServer).Halt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/drand.Protocol/Halt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtocolServer).Halt(ctx, req.(*HaltPacket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Protocol_SyncChain_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SyncRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -353,12 +453,22 @@ var Protocol_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PartialBeacon",
 			Handler:    _Protocol_PartialBeacon_Handler,
 		},
+		{
+			MethodName: "Halt",
+			Handler:    _Protocol_Halt_Handler,
+		},
 		{
 			MethodName: "Status",
 			Handler:    _Protocol_Status_Handler,
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PartialBeaconStream",
+			Handler:       _Protocol_PartialBeaconStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "SyncChain",
 			Handler:       _Protocol_SyncChain_Handler,
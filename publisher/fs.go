@@ -0,0 +1,103 @@
+package publisher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	dirPerm  = 0o755
+	filePerm = 0o644
+)
+
+// FileSink stores objects as files in a local directory tree, e.g. to be served
+// by a regular web server.
+type FileSink struct {
+	root string
+}
+
+// NewFileSink returns a sink writing to the directory at root, creating it if
+// needed.
+func NewFileSink(root string) (*FileSink, error) {
+	if err := os.MkdirAll(root, dirPerm); err != nil {
+		return nil, fmt.Errorf("creating %s: %w", root, err)
+	}
+	return &FileSink{root: root}, nil
+}
+
+func (s *FileSink) String() string {
+	return "file://" + s.root
+}
+
+// Put writes the object to its file, atomically replacing any existing one.
+func (s *FileSink) Put(_ context.Context, obj *Object) (string, error) {
+	dest := s.path(obj.Key)
+	if err := os.MkdirAll(filepath.Dir(dest), dirPerm); err != nil {
+		return "", err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(dest), "."+filepath.Base(dest)+".*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(obj.Data); err != nil {
+		tmp.Close()
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		return "", err
+	}
+	if err := os.Chmod(tmp.Name(), filePerm); err != nil {
+		return "", err
+	}
+	if err := os.Rename(tmp.Name(), dest); err != nil {
+		return "", err
+	}
+
+	return dest, nil
+}
+
+// path returns the path of the file storing the object with the given key.
+func (s *FileSink) path(key string) string {
+	return filepath.Join(s.root, filepath.FromSlash(path.Clean("/"+key)))
+}
+
+// List returns the keys of the files under root starting with prefix.
+func (s *FileSink) List(_ context.Context, prefix string) ([]string, error) {
+	// only walk the deepest directory containing all the matching keys
+	dir := s.root
+	if i := strings.LastIndex(prefix, "/"); i >= 0 {
+		dir = filepath.Join(s.root, filepath.FromSlash(prefix[:i]))
+	}
+
+	var keys []string
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || strings.HasPrefix(d.Name(), ".") {
+			return nil
+		}
+		rel, err := filepath.Rel(s.root, p)
+		if err != nil {
+			return err
+		}
+		if key := filepath.ToSlash(rel); strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+
+	return keys, err
+}
@@ -0,0 +1,169 @@
+package publisher
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"os"
+	"strings"
+
+	json "github.com/nikkolasg/hexjson"
+)
+
+// DefaultGCSEndpoint is the endpoint of the Google Cloud Storage JSON API.
+const DefaultGCSEndpoint = "https://storage.googleapis.com"
+
+// gcsEmulatorHostEnv is the environment variable used by the Google Cloud
+// Storage tooling to point to a local emulator.
+const gcsEmulatorHostEnv = "STORAGE_EMULATOR_HOST"
+
+// GCSSink stores objects in a bucket through the Google Cloud Storage JSON API,
+// which is also implemented by local emulators.
+type GCSSink struct {
+	endpoint string
+	bucket   string
+	token    string
+	client   *http.Client
+}
+
+// NewGCSSink returns a sink writing to the given bucket. An empty endpoint
+// defaults to the emulator set in STORAGE_EMULATOR_HOST if any, and to
+// DefaultGCSEndpoint otherwise. The token, if any, is sent as an OAuth2 bearer
+// token.
+func NewGCSSink(endpoint, bucket, token string) *GCSSink {
+	if endpoint == "" {
+		endpoint = DefaultGCSEndpoint
+		if host := os.Getenv(gcsEmulatorHostEnv); host != "" {
+			endpoint = host
+			if !strings.Contains(host, "://") {
+				endpoint = "http://" + host
+			}
+		}
+	}
+
+	return &GCSSink{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		bucket:   bucket,
+		token:    token,
+		client:   http.DefaultClient,
+	}
+}
+
+func (s *GCSSink) String() string {
+	return "gs://" + s.bucket
+}
+
+type gcsObject struct {
+	Name         string `json:"name"`
+	ContentType  string `json:"contentType,omitempty"`
+	CacheControl string `json:"cacheControl,omitempty"`
+}
+
+type gcsObjectList struct {
+	Items         []gcsObject `json:"items"`
+	NextPageToken string      `json:"nextPageToken"`
+}
+
+// Put uploads the object and its metadata in a single multipart request.
+func (s *GCSSink) Put(ctx context.Context, obj *Object) (string, error) {
+	metadata, err := json.Marshal(&gcsObject{
+		Name:         obj.Key,
+		ContentType:  obj.ContentType,
+		CacheControl: obj.CacheControl,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	for _, part := range []struct {
+		contentType string
+		data        []byte
+	}{
+		{"application/json; charset=UTF-8", metadata},
+		{obj.ContentType, obj.Data},
+	} {
+		pw, err := mw.CreatePart(textproto.MIMEHeader{"Content-Type": {part.contentType}})
+		if err != nil {
+			return "", err
+		}
+		if _, err := pw.Write(part.data); err != nil {
+			return "", err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return "", err
+	}
+
+	u := fmt.Sprintf("%s/upload/storage/v1/b/%s/o?uploadType=multipart", s.endpoint, url.PathEscape(s.bucket))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, &body)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "multipart/related; boundary="+mw.Boundary())
+
+	if err := s.do(req, nil); err != nil {
+		return "", fmt.Errorf("uploading %s: %w", obj.Key, err)
+	}
+
+	return fmt.Sprintf("gs://%s/%s", s.bucket, obj.Key), nil
+}
+
+// List returns the keys of the objects of the bucket starting with prefix.
+func (s *GCSSink) List(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	pageToken := ""
+	for {
+		query := url.Values{"prefix": {prefix}}
+		if pageToken != "" {
+			query.Set("pageToken", pageToken)
+		}
+		u := fmt.Sprintf("%s/storage/v1/b/%s/o?%s", s.endpoint, url.PathEscape(s.bucket), query.Encode())
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
+		if err != nil {
+			return nil, err
+		}
+
+		var page gcsObjectList
+		if err := s.do(req, &page); err != nil {
+			return nil, fmt.Errorf("listing objects: %w", err)
+		}
+		for _, obj := range page.Items {
+			keys = append(keys, obj.Name)
+		}
+
+		if page.NextPageToken == "" {
+			return keys, nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
+
+// do sends the request and decodes the JSON response into out, if not nil.
+func (s *GCSSink) do(req *http.Request, out interface{}) error {
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
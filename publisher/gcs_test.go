@@ -0,0 +1,102 @@
+package publisher
+
+import (
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	json "github.com/nikkolasg/hexjson"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeGCS implements the subset of the Google Cloud Storage JSON API used by
+// GCSSink, returning object lists one object per page.
+type fakeGCS struct {
+	sync.Mutex
+	objects map[string]gcsObject
+	data    map[string][]byte
+}
+
+func (f *fakeGCS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/upload/storage/v1/b/bucket/o":
+		_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil || r.URL.Query().Get("uploadType") != "multipart" {
+			http.Error(w, "bad upload", http.StatusBadRequest)
+			return
+		}
+		mr := multipart.NewReader(r.Body, params["boundary"])
+		part, _ := mr.NextPart()
+		var obj gcsObject
+		if err := json.NewDecoder(part).Decode(&obj); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		part, _ = mr.NextPart()
+		data, _ := io.ReadAll(part)
+		f.objects[obj.Name] = obj
+		f.data[obj.Name] = data
+		_ = json.NewEncoder(w).Encode(obj)
+	case r.Method == http.MethodGet && r.URL.Path == "/storage/v1/b/bucket/o":
+		var names []string
+		for name := range f.objects {
+			if strings.HasPrefix(name, r.URL.Query().Get("prefix")) && name > r.URL.Query().Get("pageToken") {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		var list gcsObjectList
+		if len(names) > 0 {
+			list.Items = []gcsObject{f.objects[names[0]]}
+		}
+		if len(names) > 1 {
+			list.NextPageToken = names[0]
+		}
+		_ = json.NewEncoder(w).Encode(list)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestGCSSink(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeGCS{objects: make(map[string]gcsObject), data: make(map[string][]byte)}
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+
+	t.Setenv(gcsEmulatorHostEnv, strings.TrimPrefix(srv.URL, "http://"))
+	sink := NewGCSSink("", "bucket", "")
+
+	for _, key := range []string{"public/1", "public/2", "info"} {
+		location, err := sink.Put(ctx, &Object{
+			Key:          key,
+			Data:         []byte(key),
+			ContentType:  jsonContentType,
+			CacheControl: immutableCacheControl,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "gs://bucket/"+key, location)
+	}
+
+	fake.Lock()
+	require.Equal(t, []byte("public/2"), fake.data["public/2"])
+	require.Equal(t, immutableCacheControl, fake.objects["public/2"].CacheControl)
+	fake.Unlock()
+
+	keys, err := sink.List(ctx, "public/")
+	require.NoError(t, err)
+	require.Equal(t, []string{"public/1", "public/2"}, keys)
+
+	_, err = NewGCSSink(srv.URL, "missing", "").List(ctx, "")
+	require.Error(t, err)
+}
@@ -0,0 +1,277 @@
+package publisher
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	json "github.com/nikkolasg/hexjson"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/client"
+	"github.com/drand/drand/log"
+)
+
+const (
+	jsonContentType = "application/json"
+	// Headers per recommendation for static assets at
+	// https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Cache-Control
+	immutableCacheControl = "public, max-age=604800, immutable"
+
+	infoKey   = "info"
+	publicKey = "public"
+	latestKey = "latest"
+
+	watchRetryBackoff = time.Second
+)
+
+// Publisher writes the chain info and the beacons of a chain to a sink, under
+// the same paths as the HTTP API: "info", "public/latest" and "public/{round}".
+type Publisher struct {
+	sink   Sink
+	prefix string
+	log    log.Logger
+
+	latestLk sync.Mutex
+	latest   uint64
+}
+
+// New returns a publisher writing to sink. All the keys are prefixed with
+// prefix, which can be set to the chain hash to serve several chains from the
+// same sink like the HTTP API does.
+func New(sink Sink, prefix string, l log.Logger) *Publisher {
+	if l == nil {
+		l = log.DefaultLogger()
+	}
+	return &Publisher{
+		sink:   sink,
+		prefix: strings.Trim(prefix, "/"),
+		log:    l,
+	}
+}
+
+func (p *Publisher) key(elem ...string) string {
+	return path.Join(append([]string{p.prefix}, elem...)...)
+}
+
+// PublishInfo writes the chain info.
+func (p *Publisher) PublishInfo(ctx context.Context, info *chain.Info) error {
+	var buff bytes.Buffer
+	if err := info.ToJSON(&buff, nil); err != nil {
+		return fmt.Errorf("failed to marshal chain info: %w", err)
+	}
+
+	_, err := p.sink.Put(ctx, &Object{
+		Key:          p.key(infoKey),
+		Data:         buff.Bytes(),
+		ContentType:  jsonContentType,
+		CacheControl: immutableCacheControl,
+	})
+	return err
+}
+
+// PublishRound writes the beacon of a round, and returns its location.
+func (p *Publisher) PublishRound(ctx context.Context, res client.Result) (string, error) {
+	data, err := json.Marshal(asRandomData(res))
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal randomness: %w", err)
+	}
+
+	return p.sink.Put(ctx, &Object{
+		Key:          p.key(publicKey, strconv.FormatUint(res.Round(), 10)),
+		Data:         data,
+		ContentType:  jsonContentType,
+		CacheControl: immutableCacheControl,
+	})
+}
+
+// PublishLatest writes the beacon as the latest one, unless a more recent one
+// has already been published. It is cached until the next round is due.
+func (p *Publisher) PublishLatest(ctx context.Context, info *chain.Info, res client.Result) error {
+	p.latestLk.Lock()
+	defer p.latestLk.Unlock()
+
+	if res.Round() <= p.latest {
+		return nil
+	}
+
+	data, err := json.Marshal(asRandomData(res))
+	if err != nil {
+		return fmt.Errorf("failed to marshal randomness: %w", err)
+	}
+
+	next := chain.RoundTime(info.Period, info.GenesisTime, res.Round()+1)
+	maxAge := int(math.Ceil(time.Until(next).Seconds()))
+	// max-age has a resolution of a second, which goes beyond the next round
+	// of a sub-second period
+	if maxAge < 0 || info.Period < time.Second {
+		maxAge = 0
+	}
+
+	_, err = p.sink.Put(ctx, &Object{
+		Key:          p.key(publicKey, latestKey),
+		Data:         data,
+		ContentType:  jsonContentType,
+		CacheControl: fmt.Sprintf("public, max-age=%d", maxAge),
+	})
+	if err != nil {
+		return err
+	}
+
+	p.latest = res.Round()
+	return nil
+}
+
+// Watch publishes the chain info, then every new beacon of the chain until ctx
+// is done.
+func (p *Publisher) Watch(ctx context.Context, c client.Client) error {
+	info, err := c.Info(ctx)
+	if err != nil {
+		return fmt.Errorf("fetching chain info: %w", err)
+	}
+	if err := p.PublishInfo(ctx, info); err != nil {
+		return fmt.Errorf("publishing chain info: %w", err)
+	}
+
+	for {
+		ch := c.Watch(ctx)
+	INNER:
+		for {
+			select {
+			case res, ok := <-ch:
+				if !ok {
+					p.log.Warnw("", "publisher", "watch channel closed")
+					t := time.NewTimer(watchRetryBackoff)
+					select {
+					case <-t.C:
+						break INNER
+					case <-ctx.Done():
+						return nil
+					}
+				}
+				p.log.Infow("", "publisher", "got randomness", "round", res.Round())
+				go p.publish(ctx, info, res)
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	}
+}
+
+func (p *Publisher) publish(ctx context.Context, info *chain.Info, res client.Result) {
+	location, err := p.PublishRound(ctx, res)
+	if err != nil {
+		p.log.Errorw("", "publisher", "failed to publish randomness", "round", res.Round(), "err", err)
+		return
+	}
+	if err := p.PublishLatest(ctx, info, res); err != nil {
+		p.log.Errorw("", "publisher", "failed to publish latest randomness", "round", res.Round(), "err", err)
+	}
+	p.log.Infow("", "publisher", "published randomness", "round", res.Round(), "location", location)
+}
+
+// Sync publishes all the rounds of the chain from begin up to the current one
+// that are not in the sink yet, as well as the chain info if it is missing and
+// the latest beacon. Since the objects already in the sink are skipped, an
+// interrupted sync can simply be started again. It returns the number of rounds
+// published.
+func (p *Publisher) Sync(ctx context.Context, c client.Client, begin uint64) (int, error) {
+	info, err := c.Info(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fetching chain info: %w", err)
+	}
+
+	existing, err := p.sink.List(ctx, p.key(publicKey)+"/")
+	if err != nil {
+		return 0, fmt.Errorf("listing existing rounds: %w", err)
+	}
+	present := make(map[uint64]bool, len(existing))
+	for _, key := range existing {
+		if rnd, err := strconv.ParseUint(path.Base(key), 10, 64); err == nil {
+			present[rnd] = true
+		}
+	}
+
+	infos, err := p.sink.List(ctx, p.key(infoKey))
+	if err != nil {
+		return 0, fmt.Errorf("listing chain info: %w", err)
+	}
+	if !contains(infos, p.key(infoKey)) {
+		if err := p.PublishInfo(ctx, info); err != nil {
+			return 0, fmt.Errorf("publishing chain info: %w", err)
+		}
+	}
+
+	published := 0
+	var last uint64
+	for rnd := begin; rnd <= c.RoundAt(time.Now()); rnd++ {
+		if ctx.Err() != nil {
+			return published, ctx.Err()
+		}
+		last = rnd
+		if present[rnd] {
+			continue
+		}
+
+		r, err := c.Get(ctx, rnd)
+		if err != nil {
+			p.log.Errorw("", "publisher_sync", "failed to get randomness", "round", rnd, "err", err)
+			continue
+		}
+		location, err := p.PublishRound(ctx, r)
+		if err != nil {
+			p.log.Errorw("", "publisher_sync", "failed to publish randomness", "round", rnd, "err", err)
+			continue
+		}
+		published++
+		p.log.Infow("", "publisher_sync", "published randomness", "round", r.Round(), "location", location)
+	}
+
+	if last > 0 {
+		r, err := c.Get(ctx, last)
+		if err != nil {
+			return published, fmt.Errorf("fetching latest round: %w", err)
+		}
+		if err := p.PublishLatest(ctx, info, r); err != nil {
+			return published, fmt.Errorf("publishing latest round: %w", err)
+		}
+	}
+
+	return published, nil
+}
+
+func contains(keys []string, key string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+type resultWithPreviousSignature interface {
+	PreviousSignature() []byte
+}
+
+func asRandomData(r client.Result) *client.RandomData {
+	rd, ok := r.(*client.RandomData)
+	if ok {
+		return rd
+	}
+	rd = &client.RandomData{
+		Rnd:    r.Round(),
+		Random: r.Randomness(),
+		Sig:    r.Signature(),
+	}
+	if rp, ok := r.(resultWithPreviousSignature); ok {
+		rd.PreviousSignature = rp.PreviousSignature()
+	}
+
+	return rd
+}
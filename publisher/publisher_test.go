@@ -0,0 +1,168 @@
+package publisher
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	json "github.com/nikkolasg/hexjson"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/client"
+	"github.com/drand/drand/common/scheme"
+	"github.com/drand/drand/test"
+)
+
+// chainClient serves rounds up to its current round, counting the rounds
+// fetched through Get.
+type chainClient struct {
+	info    *chain.Info
+	current uint64
+
+	sync.Mutex
+	gets map[uint64]int
+}
+
+func newChainClient(t *testing.T, current uint64) *chainClient {
+	t.Helper()
+	return &chainClient{
+		info: &chain.Info{
+			PublicKey:   test.GenerateIDs(1)[0].Public.Key,
+			Period:      time.Second,
+			GenesisTime: time.Now().Unix() - int64(current),
+			Scheme:      scheme.GetSchemeFromEnv(),
+		},
+		current: current,
+		gets:    make(map[uint64]int),
+	}
+}
+
+func (c *chainClient) Get(_ context.Context, round uint64) (client.Result, error) {
+	c.Lock()
+	defer c.Unlock()
+	if round == 0 {
+		round = c.current
+	}
+	c.gets[round]++
+	return &client.RandomData{Rnd: round, Random: []byte{byte(round)}, Sig: []byte{byte(round)}}, nil
+}
+
+func (c *chainClient) Watch(ctx context.Context) <-chan client.Result {
+	ch := make(chan client.Result)
+	go func() {
+		<-ctx.Done()
+		close(ch)
+	}()
+	return ch
+}
+
+func (c *chainClient) Info(context.Context) (*chain.Info, error) {
+	return c.info, nil
+}
+
+func (c *chainClient) RoundAt(time.Time) uint64 {
+	return c.current
+}
+
+func (c *chainClient) Close() error {
+	return nil
+}
+
+func TestPublisherSync(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	sink, err := NewFileSink(dir)
+	require.NoError(t, err)
+
+	c := newChainClient(t, 5)
+	p := New(sink, "abcd", nil)
+
+	n, err := p.Sync(ctx, c, 1)
+	require.NoError(t, err)
+	require.Equal(t, 5, n)
+
+	keys, err := sink.List(ctx, "abcd/")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{
+		"abcd/info", "abcd/public/latest",
+		"abcd/public/1", "abcd/public/2", "abcd/public/3", "abcd/public/4", "abcd/public/5",
+	}, keys)
+
+	data, err := os.ReadFile(filepath.Join(dir, "abcd", "public", "latest"))
+	require.NoError(t, err)
+	latest := new(client.RandomData)
+	require.NoError(t, json.Unmarshal(data, latest))
+	require.Equal(t, uint64(5), latest.Round())
+
+	data, err = os.ReadFile(filepath.Join(dir, "abcd", "info"))
+	require.NoError(t, err)
+	info, err := chain.InfoFromJSON(bytes.NewReader(data))
+	require.NoError(t, err)
+	require.True(t, info.Equal(c.info))
+
+	// syncing again only publishes the missing rounds
+	require.NoError(t, os.Remove(filepath.Join(dir, "abcd", "public", "3")))
+	c.current = 7
+	n, err = New(sink, "abcd", nil).Sync(ctx, c, 1)
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+
+	c.Lock()
+	defer c.Unlock()
+	require.Equal(t, 1, c.gets[1])
+	require.Equal(t, 2, c.gets[3])
+	require.Equal(t, 1, c.gets[6])
+}
+
+func TestPublisherLatestNeverRegresses(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	sink, err := NewFileSink(dir)
+	require.NoError(t, err)
+
+	c := newChainClient(t, 10)
+	p := New(sink, "", nil)
+
+	require.NoError(t, p.PublishLatest(ctx, c.info, &client.RandomData{Rnd: 9}))
+	require.NoError(t, p.PublishLatest(ctx, c.info, &client.RandomData{Rnd: 8}))
+
+	data, err := os.ReadFile(filepath.Join(dir, "public", "latest"))
+	require.NoError(t, err)
+	latest := new(client.RandomData)
+	require.NoError(t, json.Unmarshal(data, latest))
+	require.Equal(t, uint64(9), latest.Round())
+}
+
+// cacheSink records the cache control of the objects put
+type cacheSink struct {
+	Sink
+	controls map[string]string
+}
+
+func (s *cacheSink) Put(ctx context.Context, obj *Object) (string, error) {
+	s.controls[obj.Key] = obj.CacheControl
+	return s.Sink.Put(ctx, obj)
+}
+
+func TestPublisherLatestMaxAge(t *testing.T) {
+	ctx := context.Background()
+	fs, err := NewFileSink(t.TempDir())
+	require.NoError(t, err)
+	sink := &cacheSink{Sink: fs, controls: make(map[string]string)}
+
+	c := newChainClient(t, 10)
+	c.info.Period = time.Minute
+	c.info.GenesisTime = time.Now().Unix()
+	require.NoError(t, New(sink, "", nil).PublishLatest(ctx, c.info, &client.RandomData{Rnd: 1}))
+	require.Regexp(t, `^public, max-age=(59|60)$`, sink.controls["public/latest"])
+
+	// the latest beacon of a sub-second period can't be cached
+	c.info.Period = 500 * time.Millisecond
+	require.NoError(t, New(sink, "", nil).PublishLatest(ctx, c.info, &client.RandomData{Rnd: 1}))
+	require.Equal(t, "public, max-age=0", sink.controls["public/latest"])
+}
@@ -0,0 +1,66 @@
+package publisher
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+// S3Sink stores objects in an S3-compatible bucket.
+type S3Sink struct {
+	bucket string
+	api    *s3.S3
+	upr    *s3manager.Uploader
+}
+
+// NewS3Sink returns a sink writing to the given bucket using the session's
+// configuration. Objects are uploaded with a public-read ACL.
+func NewS3Sink(sess *session.Session, bucket string) *S3Sink {
+	return &S3Sink{
+		bucket: bucket,
+		api:    s3.New(sess),
+		upr:    s3manager.NewUploader(sess),
+	}
+}
+
+func (s *S3Sink) String() string {
+	return "s3://" + s.bucket
+}
+
+// Put uploads the object to the bucket.
+func (s *S3Sink) Put(ctx context.Context, obj *Object) (string, error) {
+	r, err := s.upr.UploadWithContext(ctx, &s3manager.UploadInput{
+		ACL:          aws.String("public-read"),
+		Bucket:       aws.String(s.bucket),
+		Key:          aws.String(obj.Key),
+		Body:         bytes.NewReader(obj.Data),
+		ContentType:  aws.String(obj.ContentType),
+		CacheControl: aws.String(obj.CacheControl),
+	})
+	if err != nil {
+		return "", err
+	}
+	return r.Location, nil
+}
+
+// List returns the keys of the objects of the bucket starting with prefix.
+func (s *S3Sink) List(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	err := s.api.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, obj := range page.Contents {
+			keys = append(keys, aws.StringValue(obj.Key))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
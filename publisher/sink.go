@@ -0,0 +1,26 @@
+// Package publisher writes a drand chain as static objects to a storage sink
+// (a local directory, an S3-compatible bucket or a GCS-compatible bucket), using
+// the same layout as the HTTP API so that the sink can be served as is.
+package publisher
+
+import (
+	"context"
+)
+
+// Object is a static file to be written to a sink.
+type Object struct {
+	// Key is the slash separated path of the object, e.g. "public/42".
+	Key          string
+	Data         []byte
+	ContentType  string
+	CacheControl string
+}
+
+// Sink stores static objects.
+type Sink interface {
+	// Put stores the object, replacing any existing object with the same key,
+	// and returns the location it was written to.
+	Put(ctx context.Context, obj *Object) (string, error)
+	// List returns the keys of all the stored objects starting with prefix.
+	List(ctx context.Context, prefix string) ([]string, error)
+}
@@ -0,0 +1,443 @@
+package publisher
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	json "github.com/nikkolasg/hexjson"
+
+	"github.com/drand/drand/chain"
+	"github.com/drand/drand/client"
+	"github.com/drand/drand/key"
+	"github.com/drand/drand/log"
+)
+
+const (
+	// ChecksumsPerFile is the number of rounds whose checksums are listed in
+	// each checksum file of a snapshot with split checksums: the checksum of
+	// round R is in "{hash}/SHA256SUMS.{R/ChecksumsPerFile}".
+	ChecksumsPerFile = 1000
+
+	// ManifestKey is the path of the manifest at the root of a snapshot.
+	ManifestKey = "manifest.json"
+	// ManifestSignatureKey is the path of the signature of the manifest, if
+	// the snapshot is signed.
+	ManifestSignatureKey = "manifest.json.sig"
+	// ChecksumsKey is the name of the checksum files of a snapshot, in the
+	// format of the sha256sum tool.
+	ChecksumsKey = "SHA256SUMS"
+
+	chainsKey       = "chains"
+	manifestVersion = 1
+)
+
+// Manifest is the index of a snapshot, listing the chains it contains.
+type Manifest struct {
+	Version int `json:"version"`
+	// ChecksumsPerFile is the number of rounds per checksum file, or 0 if the
+	// checksums of all the rounds are in the checksum file of the chain.
+	ChecksumsPerFile uint64          `json:"checksums_per_file,omitempty"`
+	Chains           []ManifestChain `json:"chains"`
+}
+
+// ManifestChain describes a chain in a snapshot.
+type ManifestChain struct {
+	Hash string `json:"hash"`
+	// Latest is the most recent round of the chain in the snapshot. All the
+	// rounds from 1 to Latest are present.
+	Latest uint64 `json:"latest"`
+	// Checksums is the SHA-256 of the "{hash}/SHA256SUMS" file, which lists
+	// the checksums of the chain info and of all the rounds, through the
+	// checksum files of the rounds if the checksums are split.
+	Checksums string `json:"checksums"`
+}
+
+// ManifestSignature is the signature of a manifest by the long-term key of a
+// drand node.
+type ManifestSignature struct {
+	PublicKey []byte `json:"public_key"`
+	Signature []byte `json:"signature"`
+}
+
+// Verify checks the signature of the given manifest.
+func (s *ManifestSignature) Verify(manifest []byte) error {
+	pub := key.KeyGroup.Point()
+	if err := pub.UnmarshalBinary(s.PublicKey); err != nil {
+		return fmt.Errorf("invalid public key: %w", err)
+	}
+	return key.AuthScheme.Verify(pub, manifest, s.Signature)
+}
+
+// SnapshotSource provides the beacons of a chain to render in a snapshot.
+type SnapshotSource interface {
+	Info(ctx context.Context) (*chain.Info, error)
+	// LastRound returns the most recent round available.
+	LastRound(ctx context.Context) (uint64, error)
+	Get(ctx context.Context, round uint64) (client.Result, error)
+}
+
+type storeSource struct {
+	info  *chain.Info
+	store chain.Store
+}
+
+// NewStoreSource returns a source reading the beacons of the chain described
+// by info from a beacon database.
+func NewStoreSource(info *chain.Info, store chain.Store) SnapshotSource {
+	return &storeSource{info: info, store: store}
+}
+
+func (s *storeSource) Info(context.Context) (*chain.Info, error) {
+	return s.info, nil
+}
+
+func (s *storeSource) LastRound(context.Context) (uint64, error) {
+	b, err := s.store.Last()
+	if err != nil {
+		return 0, err
+	}
+	return b.Round, nil
+}
+
+func (s *storeSource) Get(_ context.Context, round uint64) (client.Result, error) {
+	b, err := s.store.Get(round)
+	if err != nil {
+		return nil, err
+	}
+	return &client.RandomData{
+		Rnd:               b.Round,
+		Random:            b.Randomness(),
+		Sig:               b.Signature,
+		PreviousSignature: b.PreviousSig,
+	}, nil
+}
+
+type clientSource struct {
+	client.Client
+}
+
+// NewClientSource returns a source fetching the beacons of a chain through a
+// client.
+func NewClientSource(c client.Client) SnapshotSource {
+	return &clientSource{Client: c}
+}
+
+func (s *clientSource) LastRound(ctx context.Context) (uint64, error) {
+	r, err := s.Client.Get(ctx, 0)
+	if err != nil {
+		return 0, err
+	}
+	return r.Round(), nil
+}
+
+// Snapshot renders chains into a directory following the paths of the HTTP API
+// ("chains", "{hash}/info", "{hash}/public/latest" and "{hash}/public/{round}"),
+// so that it can be served by a plain file server or a CDN. The integrity of
+// the files can be checked against the manifest at the root of the directory,
+// which can be signed.
+//
+// Rendering is incremental: the rounds already in the snapshot are not
+// rendered again.
+type Snapshot struct {
+	sink           *FileSink
+	splitChecksums bool
+	signer         *key.Pair
+	log            log.Logger
+	manifest       *Manifest
+}
+
+// NewSnapshot opens the snapshot in the directory dir, creating it if needed.
+// The checksums of the rounds are split into files of ChecksumsPerFile rounds if
+// splitChecksums is set, which must match the layout of the existing snapshot
+// if any. The rounds are always stored at the paths of the HTTP API. The
+// manifest is signed with signer, unless it is nil.
+func NewSnapshot(dir string, splitChecksums bool, signer *key.Pair, l log.Logger) (*Snapshot, error) {
+	if l == nil {
+		l = log.DefaultLogger()
+	}
+
+	sink, err := NewFileSink(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{Version: manifestVersion}
+	data, err := os.ReadFile(sink.path(ManifestKey))
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+	case err != nil:
+		return nil, err
+	default:
+		if err := json.Unmarshal(data, manifest); err != nil {
+			return nil, fmt.Errorf("invalid manifest: %w", err)
+		}
+		if manifest.Version != manifestVersion {
+			return nil, fmt.Errorf("unsupported manifest version %d", manifest.Version)
+		}
+		if len(manifest.Chains) > 0 && (manifest.ChecksumsPerFile != 0) != splitChecksums {
+			return nil, fmt.Errorf("snapshot in %s has a different checksums setting", dir)
+		}
+	}
+	if splitChecksums {
+		manifest.ChecksumsPerFile = ChecksumsPerFile
+	}
+
+	return &Snapshot{
+		sink:           sink,
+		splitChecksums: splitChecksums,
+		signer:         signer,
+		log:            l,
+		manifest:       manifest,
+	}, nil
+}
+
+// Manifest returns the current manifest of the snapshot.
+func (s *Snapshot) Manifest() *Manifest {
+	return s.manifest
+}
+
+// Render adds the rounds of the chain that are not in the snapshot yet, up to
+// the last round of the source, and updates the manifest. It returns the number
+// of rounds rendered. The rounds rendered before an error are kept.
+func (s *Snapshot) Render(ctx context.Context, src SnapshotSource) (int, error) {
+	info, err := src.Info(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fetching chain info: %w", err)
+	}
+	last, err := src.LastRound(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fetching last round: %w", err)
+	}
+
+	hash := info.HashString()
+	entry := s.chain(hash)
+
+	chainSums, err := s.loadChecksums(path.Join(hash, ChecksumsKey))
+	if err != nil {
+		return 0, err
+	}
+
+	var buff bytes.Buffer
+	if err := info.ToJSON(&buff, nil); err != nil {
+		return 0, fmt.Errorf("failed to marshal chain info: %w", err)
+	}
+	if err := s.put(ctx, path.Join(hash, infoKey), buff.Bytes()); err != nil {
+		return 0, err
+	}
+	chainSums.set(infoKey, checksum(buff.Bytes()))
+
+	roundSums := make(map[uint64]*checksums)
+	rendered := 0
+	var latest []byte
+	var renderErr error
+	for rnd := entry.Latest + 1; rnd <= last; rnd++ {
+		if renderErr = ctx.Err(); renderErr != nil {
+			break
+		}
+
+		var data []byte
+		data, renderErr = s.renderRound(ctx, src, hash, rnd)
+		if renderErr != nil {
+			renderErr = fmt.Errorf("rendering round %d: %w", rnd, renderErr)
+			break
+		}
+
+		roundKey := path.Join(publicKey, strconv.FormatUint(rnd, 10))
+		if s.splitChecksums {
+			part := rnd / ChecksumsPerFile
+			sums, ok := roundSums[part]
+			if !ok {
+				sums, renderErr = s.loadChecksums(path.Join(hash, roundChecksumsKey(part)))
+				if renderErr != nil {
+					break
+				}
+				roundSums[part] = sums
+			}
+			sums.set(roundKey, checksum(data))
+		} else {
+			chainSums.set(roundKey, checksum(data))
+		}
+
+		latest = data
+		entry.Latest = rnd
+		rendered++
+	}
+
+	for part, sums := range roundSums {
+		sumsKey := roundChecksumsKey(part)
+		data := sums.bytes()
+		if err := s.put(ctx, path.Join(hash, sumsKey), data); err != nil {
+			return rendered, err
+		}
+		chainSums.set(sumsKey, checksum(data))
+	}
+
+	if latest != nil {
+		if err := s.put(ctx, path.Join(hash, publicKey, latestKey), latest); err != nil {
+			return rendered, err
+		}
+	}
+
+	data := chainSums.bytes()
+	if err := s.put(ctx, path.Join(hash, ChecksumsKey), data); err != nil {
+		return rendered, err
+	}
+	entry.Checksums = checksum(data)
+
+	if err := s.writeManifest(ctx); err != nil {
+		return rendered, err
+	}
+
+	s.log.Infow("", "snapshot", "chain rendered", "chain-hash", hash, "rendered", rendered, "latest", entry.Latest)
+	return rendered, renderErr
+}
+
+func (s *Snapshot) renderRound(ctx context.Context, src SnapshotSource, hash string, rnd uint64) ([]byte, error) {
+	r, err := src.Get(ctx, rnd)
+	if err != nil {
+		return nil, err
+	}
+	if r.Round() != rnd {
+		return nil, fmt.Errorf("source returned round %d", r.Round())
+	}
+
+	data, err := json.Marshal(asRandomData(r))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal randomness: %w", err)
+	}
+
+	return data, s.put(ctx, path.Join(hash, publicKey, strconv.FormatUint(rnd, 10)), data)
+}
+
+// roundChecksumsKey returns the path of the checksum file of the given part of
+// the rounds, relative to the directory of the chain, so that it lists the
+// rounds with the same paths as the checksum file of the chain.
+func roundChecksumsKey(part uint64) string {
+	return ChecksumsKey + "." + strconv.FormatUint(part, 10)
+}
+
+// chain returns the manifest entry of the chain, adding it if needed.
+func (s *Snapshot) chain(hash string) *ManifestChain {
+	for i := range s.manifest.Chains {
+		if s.manifest.Chains[i].Hash == hash {
+			return &s.manifest.Chains[i]
+		}
+	}
+	s.manifest.Chains = append(s.manifest.Chains, ManifestChain{Hash: hash})
+	sort.Slice(s.manifest.Chains, func(i, j int) bool {
+		return s.manifest.Chains[i].Hash < s.manifest.Chains[j].Hash
+	})
+	return s.chain(hash)
+}
+
+// writeManifest writes the list of chains and the manifest, and signs the
+// latter if needed.
+func (s *Snapshot) writeManifest(ctx context.Context) error {
+	hashes := make([]string, 0, len(s.manifest.Chains))
+	for _, c := range s.manifest.Chains {
+		hashes = append(hashes, c.Hash)
+	}
+	data, err := json.Marshal(hashes)
+	if err != nil {
+		return err
+	}
+	if err := s.put(ctx, chainsKey, data); err != nil {
+		return err
+	}
+
+	manifest, err := json.MarshalIndent(s.manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if s.signer != nil {
+		sig, err := key.AuthScheme.Sign(s.signer.Key, manifest)
+		if err != nil {
+			return fmt.Errorf("signing manifest: %w", err)
+		}
+		pub, err := s.signer.Public.Key.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		data, err := json.Marshal(&ManifestSignature{PublicKey: pub, Signature: sig})
+		if err != nil {
+			return err
+		}
+		if err := s.put(ctx, ManifestSignatureKey, data); err != nil {
+			return err
+		}
+	}
+
+	return s.put(ctx, ManifestKey, manifest)
+}
+
+func (s *Snapshot) put(ctx context.Context, key string, data []byte) error {
+	_, err := s.sink.Put(ctx, &Object{Key: key, Data: data, ContentType: jsonContentType})
+	return err
+}
+
+func (s *Snapshot) loadChecksums(key string) (*checksums, error) {
+	data, err := os.ReadFile(s.sink.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return newChecksums(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parseChecksums(data)
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// checksums is the content of a checksum file, listing files in the order they
+// were added.
+type checksums struct {
+	paths []string
+	sums  map[string]string
+}
+
+func newChecksums() *checksums {
+	return &checksums{sums: make(map[string]string)}
+}
+
+func parseChecksums(data []byte) (*checksums, error) {
+	c := newChecksums()
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		sum, p, ok := strings.Cut(scanner.Text(), "  ")
+		if !ok {
+			return nil, fmt.Errorf("invalid checksum line %q", scanner.Text())
+		}
+		c.set(p, sum)
+	}
+	return c, scanner.Err()
+}
+
+func (c *checksums) set(p, sum string) {
+	if _, exists := c.sums[p]; !exists {
+		c.paths = append(c.paths, p)
+	}
+	c.sums[p] = sum
+}
+
+func (c *checksums) bytes() []byte {
+	var buff bytes.Buffer
+	for _, p := range c.paths {
+		fmt.Fprintf(&buff, "%s  %s\n", c.sums[p], p)
+	}
+	return buff.Bytes()
+}
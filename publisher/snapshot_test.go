@@ -0,0 +1,136 @@
+package publisher
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	json "github.com/nikkolasg/hexjson"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/client"
+	"github.com/drand/drand/key"
+)
+
+// requireValidChecksums checks all the files listed in the checksum file at
+// path, recursing into the checksum files it lists.
+func requireValidChecksums(t *testing.T, path string) int {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	sums, err := parseChecksums(data)
+	require.NoError(t, err)
+
+	files := 0
+	for _, p := range sums.paths {
+		file := filepath.Join(filepath.Dir(path), filepath.FromSlash(p))
+		content, err := os.ReadFile(file)
+		require.NoError(t, err)
+		require.Equal(t, sums.sums[p], checksum(content), p)
+		if strings.HasPrefix(filepath.Base(p), ChecksumsKey) {
+			files += requireValidChecksums(t, file)
+		} else {
+			files++
+		}
+	}
+	return files
+}
+
+func TestSnapshotRender(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	c := newChainClient(t, 5)
+	hash := c.info.HashString()
+
+	snap, err := NewSnapshot(dir, false, nil, nil)
+	require.NoError(t, err)
+	n, err := snap.Render(ctx, NewClientSource(c))
+	require.NoError(t, err)
+	require.Equal(t, 5, n)
+
+	for _, p := range []string{"chains", "manifest.json", "info", "public/latest", "public/1", "public/5"} {
+		if p != "chains" && p != "manifest.json" {
+			p = filepath.Join(hash, p)
+		}
+		require.FileExists(t, filepath.Join(dir, p))
+	}
+	_, err = os.Stat(filepath.Join(dir, ManifestSignatureKey))
+	require.True(t, os.IsNotExist(err))
+
+	data, err := os.ReadFile(filepath.Join(dir, "chains"))
+	require.NoError(t, err)
+	var chains []string
+	require.NoError(t, json.Unmarshal(data, &chains))
+	require.Equal(t, []string{hash}, chains)
+
+	// info and the 5 rounds
+	require.Equal(t, 6, requireValidChecksums(t, filepath.Join(dir, hash, ChecksumsKey)))
+
+	// rendering again only adds the new rounds
+	c.current = 8
+	snap, err = NewSnapshot(dir, false, nil, nil)
+	require.NoError(t, err)
+	n, err = snap.Render(ctx, NewClientSource(c))
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+	require.Equal(t, 9, requireValidChecksums(t, filepath.Join(dir, hash, ChecksumsKey)))
+	c.Lock()
+	require.Equal(t, 1, c.gets[3])
+	c.Unlock()
+
+	data, err = os.ReadFile(filepath.Join(dir, ManifestKey))
+	require.NoError(t, err)
+	manifest := new(Manifest)
+	require.NoError(t, json.Unmarshal(data, manifest))
+	require.Len(t, manifest.Chains, 1)
+	require.Equal(t, uint64(8), manifest.Chains[0].Latest)
+	sums, err := os.ReadFile(filepath.Join(dir, hash, ChecksumsKey))
+	require.NoError(t, err)
+	require.Equal(t, checksum(sums), manifest.Chains[0].Checksums)
+
+	latest := new(client.RandomData)
+	data, err = os.ReadFile(filepath.Join(dir, hash, "public", "latest"))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, latest))
+	require.Equal(t, uint64(8), latest.Round())
+
+	_, err = NewSnapshot(dir, true, nil, nil)
+	require.Error(t, err, "checksums setting can't change")
+}
+
+func TestSnapshotSplitChecksumsAndSigned(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	c := newChainClient(t, ChecksumsPerFile+2)
+	hash := c.info.HashString()
+	pair := key.NewKeyPair("127.0.0.1:8080")
+
+	snap, err := NewSnapshot(dir, true, pair, nil)
+	require.NoError(t, err)
+	n, err := snap.Render(ctx, NewClientSource(c))
+	require.NoError(t, err)
+	require.Equal(t, ChecksumsPerFile+2, n)
+
+	// the rounds keep the paths of the HTTP API, only their checksums are
+	// split
+	require.FileExists(t, filepath.Join(dir, hash, "public", "999"))
+	require.FileExists(t, filepath.Join(dir, hash, "public", "1000"))
+	require.FileExists(t, filepath.Join(dir, hash, ChecksumsKey+".0"))
+	require.FileExists(t, filepath.Join(dir, hash, ChecksumsKey+".1"))
+	require.Equal(t, ChecksumsPerFile+3, requireValidChecksums(t, filepath.Join(dir, hash, ChecksumsKey)))
+	sums, err := os.ReadFile(filepath.Join(dir, hash, ChecksumsKey+".1"))
+	require.NoError(t, err)
+	require.Contains(t, string(sums), "  public/1000\n")
+
+	manifest, err := os.ReadFile(filepath.Join(dir, ManifestKey))
+	require.NoError(t, err)
+	data, err := os.ReadFile(filepath.Join(dir, ManifestSignatureKey))
+	require.NoError(t, err)
+	sig := new(ManifestSignature)
+	require.NoError(t, json.Unmarshal(data, sig))
+	require.NoError(t, sig.Verify(manifest))
+	require.Error(t, sig.Verify(bytes.ToUpper(manifest)))
+}
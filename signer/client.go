@@ -0,0 +1,55 @@
+package signer
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/drand/drand/chain/beacon"
+	"github.com/drand/drand/protobuf/drand"
+	"github.com/drand/kyber"
+)
+
+// Client is a PartialSigner forwarding the signing requests to a signer
+// server. The connection is not encrypted: the server must listen on a Unix
+// socket or on a trusted network.
+type Client struct {
+	conn   *grpc.ClientConn
+	client drand.SignerClient
+}
+
+var _ beacon.PartialSigner = (*Client)(nil)
+
+// NewClient returns a client of the signer at the given address, either the
+// path of a Unix socket prefixed by "unix://" or a TCP address. It connects
+// to the signer when it's first used.
+func NewClient(addr string) (*Client, error) {
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return &Client{conn: conn, client: drand.NewSignerClient(conn)}, nil
+}
+
+// SignPartial implements the beacon.PartialSigner interface
+func (c *Client) SignPartial(ctx context.Context, round uint64, msg []byte, sharePub kyber.Point) ([]byte, error) {
+	pub, err := sharePub.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.SignPartial(ctx, &drand.SignPartialRequest{
+		Round:       round,
+		Message:     msg,
+		SharePublic: pub,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetPartialSig(), nil
+}
+
+// Close closes the connection to the signer.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,89 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc"
+
+	"github.com/drand/drand/chain/beacon"
+	"github.com/drand/drand/key"
+	"github.com/drand/drand/log"
+	"github.com/drand/drand/protobuf/drand"
+)
+
+// unixScheme prefixes the addresses of Unix sockets
+const unixScheme = "unix://"
+
+// Server serves a PartialSigner over gRPC
+type Server struct {
+	signer beacon.PartialSigner
+	srv    *grpc.Server
+	l      log.Logger
+}
+
+// NewServer returns a server answering the signing requests with the given
+// signer.
+func NewServer(signer beacon.PartialSigner, l log.Logger) *Server {
+	s := &Server{
+		signer: signer,
+		srv:    grpc.NewServer(),
+		l:      l,
+	}
+	drand.RegisterSignerServer(s.srv, s)
+	return s
+}
+
+// Listen listens on the given address, either the path of a Unix socket
+// prefixed by "unix://" or a TCP address. A Unix socket is only accessible to
+// the user running the signer.
+func Listen(addr string) (net.Listener, error) {
+	if !strings.HasPrefix(addr, unixScheme) {
+		return net.Listen("tcp", addr)
+	}
+	path := strings.TrimPrefix(addr, unixScheme)
+	// remove the socket left by a signer which didn't stop cleanly
+	if fi, err := os.Stat(path); err == nil && fi.Mode()&os.ModeSocket != 0 {
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+	}
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, 0o600); err != nil {
+		l.Close()
+		return nil, err
+	}
+	return l, nil
+}
+
+// Serve answers the requests received on the listener until the server is
+// stopped.
+func (s *Server) Serve(l net.Listener) error {
+	return s.srv.Serve(l)
+}
+
+// Stop stops the server once the requests in progress are answered.
+func (s *Server) Stop() {
+	s.srv.GracefulStop()
+}
+
+// SignPartial implements the drand.SignerServer interface
+func (s *Server) SignPartial(ctx context.Context, req *drand.SignPartialRequest) (*drand.SignPartialResponse, error) {
+	pub := key.KeyGroup.Point()
+	if err := pub.UnmarshalBinary(req.GetSharePublic()); err != nil {
+		return nil, fmt.Errorf("invalid public key of share: %w", err)
+	}
+	sig, err := s.signer.SignPartial(ctx, req.GetRound(), req.GetMessage(), pub)
+	if err != nil {
+		s.l.Errorw("", "signer", "refused", "round", req.GetRound(), "err", err)
+		return nil, err
+	}
+	s.l.Debugw("", "signer", "signed", "round", req.GetRound())
+	return &drand.SignPartialResponse{PartialSig: sig}, nil
+}
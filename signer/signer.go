@@ -0,0 +1,63 @@
+// Package signer implements signers holding the shares of drand nodes outside
+// of the daemon, and a gRPC server and client to reach them, e.g. over a Unix
+// socket.
+package signer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/drand/drand/chain/beacon"
+	"github.com/drand/drand/key"
+	"github.com/drand/kyber"
+)
+
+// Signer signs partial beacons with the shares it holds, refusing to sign
+// conflicting messages with the same share. It can hold several shares, to
+// serve nodes of different beacons or to keep serving a node through a
+// resharing.
+type Signer struct {
+	shares map[string]*heldShare
+}
+
+var _ beacon.PartialSigner = (*Signer)(nil)
+
+type heldShare struct {
+	share      *key.Share
+	protection *beacon.Protection
+}
+
+// NewSigner returns a signer holding the given shares.
+func NewSigner(shares ...*key.Share) (*Signer, error) {
+	if len(shares) == 0 {
+		return nil, errors.New("signer: no share given")
+	}
+	s := &Signer{shares: make(map[string]*heldShare, len(shares))}
+	for _, ks := range shares {
+		pub := SharePublic(ks)
+		if _, ok := s.shares[pub.String()]; ok {
+			return nil, fmt.Errorf("signer: share with public key %s given twice", pub)
+		}
+		s.shares[pub.String()] = &heldShare{share: ks, protection: beacon.NewProtection()}
+	}
+	return s, nil
+}
+
+// SharePublic returns the public key of the share, which signing requests
+// refer to.
+func SharePublic(ks *key.Share) kyber.Point {
+	return ks.PubPoly().Eval(ks.Share.I).V
+}
+
+// SignPartial implements the beacon.PartialSigner interface
+func (s *Signer) SignPartial(_ context.Context, round uint64, msg []byte, sharePub kyber.Point) ([]byte, error) {
+	held, ok := s.shares[sharePub.String()]
+	if !ok {
+		return nil, fmt.Errorf("signer: no share with public key %s", sharePub)
+	}
+	if err := held.protection.Check(round, msg); err != nil {
+		return nil, fmt.Errorf("signer: %w", err)
+	}
+	return key.Scheme.Sign(held.share.PrivateShare(), msg)
+}
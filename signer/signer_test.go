@@ -0,0 +1,61 @@
+package signer
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/key"
+	"github.com/drand/drand/log"
+	"github.com/drand/kyber/share"
+	"github.com/drand/kyber/util/random"
+)
+
+func testShares(n, thr int) ([]*key.Share, *share.PubPoly) {
+	pri := share.NewPriPoly(key.KeyGroup, thr, key.KeyGroup.Scalar().Pick(random.New()), random.New())
+	pub := pri.Commit(key.KeyGroup.Point().Base())
+	_, commits := pub.Info()
+	shares := make([]*key.Share, n)
+	for i, s := range pri.Shares(n) {
+		shares[i] = &key.Share{Commits: commits, Share: s}
+	}
+	return shares, pub
+}
+
+func TestSignerOverSocket(t *testing.T) {
+	shares, pub := testShares(3, 2)
+	s, err := NewSigner(shares[0], shares[1])
+	require.NoError(t, err)
+	_, err = NewSigner(shares[0], shares[0])
+	require.Error(t, err)
+
+	addr := "unix://" + path.Join(t.TempDir(), "signer.sock")
+	l, err := Listen(addr)
+	require.NoError(t, err)
+	server := NewServer(s, log.DefaultLogger())
+	go func() { _ = server.Serve(l) }()
+	defer server.Stop()
+
+	client, err := NewClient(addr)
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx := context.Background()
+	msg := []byte("round 1")
+	for _, ks := range shares[:2] {
+		sig, err := client.SignPartial(ctx, 1, msg, SharePublic(ks))
+		require.NoError(t, err)
+		require.NoError(t, key.Scheme.VerifyPartial(pub, msg, sig))
+		idx, err := key.Scheme.IndexOf(sig)
+		require.NoError(t, err)
+		require.Equal(t, ks.Share.I, idx)
+	}
+
+	// each share is protected on its own
+	_, err = client.SignPartial(ctx, 1, []byte("other"), SharePublic(shares[0]))
+	require.ErrorContains(t, err, "conflicting message")
+	_, err = client.SignPartial(ctx, 2, msg, SharePublic(shares[2]))
+	require.ErrorContains(t, err, "no share")
+}
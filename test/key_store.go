@@ -3,10 +3,11 @@ package test
 import "github.com/drand/drand/key"
 
 type KeyStore struct {
-	priv  *key.Pair
-	share *key.Share
-	group *key.Group
-	dist  *key.DistPublic
+	priv     *key.Pair
+	archived []*key.Pair
+	share    *key.Share
+	group    *key.Group
+	dist     *key.DistPublic
 }
 
 func NewKeyStore() key.Store {
@@ -22,6 +23,15 @@ func (k *KeyStore) LoadKeyPair() (*key.Pair, error) {
 	return k.priv, nil
 }
 
+func (k *KeyStore) ArchiveKeyPair(p *key.Pair) error {
+	k.archived = append(k.archived, p)
+	return nil
+}
+
+func (k *KeyStore) LoadArchivedKeyPairs() ([]*key.Pair, error) {
+	return k.archived, nil
+}
+
 func (k *KeyStore) SaveShare(share *key.Share) error {
 	k.share = share
 	return nil
@@ -11,6 +11,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/drand/drand/common"
 	"github.com/drand/drand/common/scheme"
 	"github.com/drand/drand/key"
 	"github.com/drand/drand/net"
@@ -40,11 +41,13 @@ type Server struct {
 }
 
 func newMockServer(d *Data) *Server {
+	period, periodMs := common.PeriodToProto(d.Period)
 	return &Server{
 		EmptyServer: new(testnet.EmptyServer),
 		d:           d,
 		chainInfo: &drand.ChainInfoPacket{
-			Period:      uint32(d.Period.Seconds()),
+			Period:      period,
+			PeriodMs:    periodMs,
 			GenesisTime: int64(d.Genesis),
 			PublicKey:   d.Public,
 			SchemeID:    d.Scheme.ID,
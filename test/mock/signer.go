@@ -0,0 +1,49 @@
+package mock
+
+import (
+	"context"
+	"sync"
+
+	"github.com/drand/drand/key"
+	"github.com/drand/kyber"
+)
+
+// Signer is a partial signer signing with a share in memory. It records the
+// rounds it is asked to sign, and can be made to fail.
+type Signer struct {
+	sync.Mutex
+	share  *key.Share
+	rounds []uint64
+	err    error
+}
+
+// NewSigner returns a mock signer signing with the given share
+func NewSigner(share *key.Share) *Signer {
+	return &Signer{share: share}
+}
+
+// SignPartial implements the beacon.PartialSigner interface
+func (s *Signer) SignPartial(_ context.Context, round uint64, msg []byte, _ kyber.Point) ([]byte, error) {
+	s.Lock()
+	defer s.Unlock()
+	s.rounds = append(s.rounds, round)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return key.Scheme.Sign(s.share.PrivateShare(), msg)
+}
+
+// Rounds returns the rounds the signer was asked to sign, in order
+func (s *Signer) Rounds() []uint64 {
+	s.Lock()
+	defer s.Unlock()
+	return append([]uint64(nil), s.rounds...)
+}
+
+// SetError makes the signer fail with the given error, or succeed again if
+// it is nil
+func (s *Signer) SetError(err error) {
+	s.Lock()
+	defer s.Unlock()
+	s.err = err
+}
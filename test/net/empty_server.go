@@ -71,6 +71,11 @@ func (s *EmptyServer) StartCheckChain(*drand.StartSyncRequest, drand.Control_Sta
 	return nil
 }
 
+// DKGStatus is the control method to stream the progress of a DKG
+func (s *EmptyServer) DKGStatus(*drand.DKGStatusRequest, drand.Control_DKGStatusServer) error {
+	return nil
+}
+
 // Status method
 func (s *EmptyServer) Status(context.Context, *drand.StatusRequest) (*drand.StatusResponse, error) {
 	return nil, nil
@@ -101,6 +106,11 @@ func (s *EmptyServer) InitDKG(context.Context, *drand.InitDKGPacket) (*drand.Gro
 	return nil, nil
 }
 
+// InitDKGLeaderless is an empty implementation
+func (s *EmptyServer) InitDKGLeaderless(context.Context, *drand.InitDKGLeaderlessPacket) (*drand.GroupPacket, error) {
+	return nil, nil
+}
+
 // InitReshare is an empty implementation
 func (s *EmptyServer) InitReshare(context.Context, *drand.InitResharePacket) (*drand.GroupPacket, error) {
 	return nil, nil
@@ -146,6 +156,26 @@ func (s *EmptyServer) RemoteStatus(context.Context, *drand.RemoteStatusRequest)
 	return nil, nil
 }
 
+// Participation is an empty implementation
+func (s *EmptyServer) Participation(context.Context, *drand.ParticipationRequest) (*drand.ParticipationResponse, error) {
+	return nil, nil
+}
+
+// ScheduleHalt is an empty implementation
+func (s *EmptyServer) ScheduleHalt(context.Context, *drand.ScheduleHaltRequest) (*drand.ScheduleHaltResponse, error) {
+	return nil, nil
+}
+
+// Halt is an empty implementation
+func (s *EmptyServer) Halt(context.Context, *drand.HaltPacket) (*drand.Empty, error) {
+	return nil, nil
+}
+
+// PartialBeaconStream is an empty implementation
+func (s *EmptyServer) PartialBeaconStream(drand.Protocol_PartialBeaconStreamServer) error {
+	return nil
+}
+
 // BackupDatabase is an empty implementation
 func (s *EmptyServer) BackupDatabase(context.Context, *drand.BackupDBRequest) (*drand.BackupDBResponse, error) {
 	return nil, nil